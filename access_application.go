@@ -17,10 +17,12 @@ type AccessApplicationType string
 
 // These constants represent all valid application types.
 const (
-	SelfHosted AccessApplicationType = "self_hosted"
-	SSH        AccessApplicationType = "ssh"
-	VNC        AccessApplicationType = "vnc"
-	File       AccessApplicationType = "file"
+	SelfHosted  AccessApplicationType = "self_hosted"
+	SSH         AccessApplicationType = "ssh"
+	VNC         AccessApplicationType = "vnc"
+	File        AccessApplicationType = "file"
+	AppLauncher AccessApplicationType = "app_launcher"
+	Warp        AccessApplicationType = "warp"
 )
 
 // AccessApplication represents an Access application.
@@ -39,6 +41,10 @@ type AccessApplication struct {
 	CorsHeaders            *AccessApplicationCorsHeaders `json:"cors_headers,omitempty"`
 	CustomDenyMessage      string                        `json:"custom_deny_message,omitempty"`
 	CustomDenyURL          string                        `json:"custom_deny_url,omitempty"`
+	AppLauncherVisible     *bool                         `json:"app_launcher_visible,omitempty"`
+	LogoURL                string                        `json:"logo_url,omitempty"`
+	CustomPages            []string                      `json:"custom_pages,omitempty"`
+	Tags                   []string                      `json:"tags,omitempty"`
 }
 
 // AccessApplicationCorsHeaders represents the CORS HTTP headers for an Access
@@ -283,3 +289,46 @@ func (api *API) revokeAccessApplicationTokens(ctx context.Context, id string, ap
 
 	return nil
 }
+
+// AccessAppLauncher returns the App Launcher application for an account. The
+// App Launcher is a special Access application of type AppLauncher; an error is
+// returned if it has not been created yet.
+//
+// API reference: https://api.cloudflare.com/#access-applications-list-access-applications
+func (api *API) AccessAppLauncher(ctx context.Context, accountID string) (AccessApplication, error) {
+	return api.accessApplicationByType(ctx, accountID, AppLauncher)
+}
+
+// accessApplicationByType pages through the applications of an account and
+// returns the first application of the given type.
+func (api *API) accessApplicationByType(ctx context.Context, accountID string, appType AccessApplicationType) (AccessApplication, error) {
+	pageOpts := PaginationOptions{Page: 1, PerPage: 50}
+	for {
+		apps, resultInfo, err := api.AccessApplications(ctx, accountID, pageOpts)
+		if err != nil {
+			return AccessApplication{}, err
+		}
+
+		for _, app := range apps {
+			if app.Type == appType {
+				return app, nil
+			}
+		}
+
+		if resultInfo.TotalPages <= pageOpts.Page || len(apps) == 0 {
+			break
+		}
+		pageOpts.Page++
+	}
+
+	return AccessApplication{}, errors.Errorf("access application of type %s could not be found", appType)
+}
+
+// UpdateAccessAppLauncher updates the App Launcher configuration, such as
+// its visibility, session duration and allowed identity providers.
+//
+// API reference: https://api.cloudflare.com/#access-applications-update-access-application
+func (api *API) UpdateAccessAppLauncher(ctx context.Context, accountID string, appLauncher AccessApplication) (AccessApplication, error) {
+	appLauncher.Type = AppLauncher
+	return api.UpdateAccessApplication(ctx, accountID, appLauncher)
+}
@@ -427,3 +427,57 @@ func TestAccessApplicationWithCORS(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestAccessAppLauncher(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+					"name": "Admin Site",
+					"domain": "test.example.com/admin",
+					"type": "self_hosted"
+				},
+				{
+					"id": "b43f3fa1-2e9a-4c8c-8a1f-5e4a8d3c1f2e",
+					"name": "App Launcher",
+					"domain": "example.cloudflareaccess.com",
+					"type": "app_launcher",
+					"session_duration": "24h"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 50,
+				"total_pages": 1,
+				"count": 2,
+				"total_count": 2
+			}
+		}
+		`)
+	}
+
+	want := AccessApplication{
+		ID:              "b43f3fa1-2e9a-4c8c-8a1f-5e4a8d3c1f2e",
+		Name:            "App Launcher",
+		Domain:          "example.cloudflareaccess.com",
+		Type:            AppLauncher,
+		SessionDuration: "24h",
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", handler)
+
+	actual, err := client.AccessAppLauncher(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
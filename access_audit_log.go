@@ -23,6 +23,8 @@ type AccessAuditLogRecord struct {
 	Allowed    bool       `json:"allowed"`
 	CreatedAt  *time.Time `json:"created_at"`
 	RayID      string     `json:"ray_id"`
+	Country    string     `json:"country,omitempty"`
+	UserID     string     `json:"user_id,omitempty"`
 }
 
 // AccessAuditLogListResponse represents the response from the list
@@ -40,26 +42,58 @@ type AccessAuditLogFilterOptions struct {
 	Since     *time.Time
 	Until     *time.Time
 	Limit     int
+	Page      int
+	PerPage   int
 }
 
 // AccessAuditLogs retrieves all audit logs for the Access service.
 //
 // API reference: https://api.cloudflare.com/#access-requests-access-requests-audit
 func (api *API) AccessAuditLogs(ctx context.Context, accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, error) {
+	records, _, err := api.accessAuditLogs(ctx, accountID, opts)
+	return records, err
+}
+
+// AllAccessAuditLogs retrieves every authentication log entry matching the
+// filter options, following pagination until all pages have been fetched.
+// Page in opts is ignored; PerPage controls the size of each request.
+//
+// API reference: https://api.cloudflare.com/#access-requests-access-requests-audit
+func (api *API) AllAccessAuditLogs(ctx context.Context, accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, error) {
+	var records []AccessAuditLogRecord
+
+	opts.Page = 1
+	for {
+		r, resultInfo, err := api.accessAuditLogs(ctx, accountID, opts)
+		if err != nil {
+			return []AccessAuditLogRecord{}, err
+		}
+		records = append(records, r...)
+
+		if len(r) == 0 || resultInfo.TotalPages <= opts.Page {
+			break
+		}
+		opts.Page++
+	}
+
+	return records, nil
+}
+
+func (api *API) accessAuditLogs(ctx context.Context, accountID string, opts AccessAuditLogFilterOptions) ([]AccessAuditLogRecord, ResultInfo, error) {
 	uri := fmt.Sprintf("/accounts/%s/access/logs/access-requests?%s", accountID, opts.Encode())
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
-		return []AccessAuditLogRecord{}, err
+		return []AccessAuditLogRecord{}, ResultInfo{}, err
 	}
 
 	var accessAuditLogListResponse AccessAuditLogListResponse
 	err = json.Unmarshal(res, &accessAuditLogListResponse)
 	if err != nil {
-		return []AccessAuditLogRecord{}, errors.Wrap(err, errUnmarshalError)
+		return []AccessAuditLogRecord{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return accessAuditLogListResponse.Result, nil
+	return accessAuditLogListResponse.Result, accessAuditLogListResponse.ResultInfo, nil
 }
 
 // Encode is a custom method for encoding the filter options into a usable HTTP
@@ -75,6 +109,14 @@ func (a AccessAuditLogFilterOptions) Encode() string {
 		v.Set("limit", strconv.Itoa(a.Limit))
 	}
 
+	if a.Page > 0 {
+		v.Set("page", strconv.Itoa(a.Page))
+	}
+
+	if a.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(a.PerPage))
+	}
+
 	if a.Since != nil {
 		v.Set("since", (*a.Since).Format(time.RFC3339))
 	}
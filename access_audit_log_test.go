@@ -91,3 +91,56 @@ func TestAccessAuditLogsEncodeEmptyValues(t *testing.T) {
 
 	assert.Equal(t, "", opts.Encode())
 }
+
+func TestAccessAuditLogsEncodePagination(t *testing.T) {
+	opts := AccessAuditLogFilterOptions{Page: 2, PerPage: 100}
+
+	assert.Equal(t, "page=2&per_page=100", opts.Encode())
+}
+
+func TestAllAccessAuditLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"user_email": "page%s@example.com",
+					"ip_address": "198.41.129.166",
+					"app_uid": "df7e2w5f-02b7-4d9d-af26-8d1988fca630",
+					"app_domain": "test.example.com/admin",
+					"action": "login",
+					"connection": "saml",
+					"allowed": true,
+					"ray_id": "187d944c61940c77"
+				}
+			],
+			"result_info": {
+				"page": %s,
+				"per_page": 50,
+				"total_pages": 2,
+				"count": 1,
+				"total_count": 2
+			}
+		}
+		`, page, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/logs/access-requests", handler)
+
+	actual, err := client.AllAccessAuditLogs(context.Background(), testAccountID, AccessAuditLogFilterOptions{PerPage: 50})
+
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 2)
+		assert.Equal(t, "page1@example.com", actual[0].UserEmail)
+		assert.Equal(t, "page2@example.com", actual[1].UserEmail)
+	}
+}
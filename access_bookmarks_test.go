@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessBookmarks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z",
+					"name": "Admin Site",
+					"domain": "example.com/admin",
+					"logo_url": "https://www.example.com/example.png",
+					"app_launcher_visible": true
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	appLauncherVisible := true
+
+	want := []AccessBookmark{{
+		ID:                 "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Name:               "Admin Site",
+		Domain:             "example.com/admin",
+		LogoURL:            "https://www.example.com/example.png",
+		AppLauncherVisible: &appLauncherVisible,
+		CreatedAt:          &createdAt,
+		UpdatedAt:          &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/bookmarks", handler)
+
+	actual, _, err := client.AccessBookmarks(context.Background(), testAccountID, PaginationOptions{})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/access/bookmarks", handler)
+
+	actual, _, err = client.ZoneLevelAccessBookmarks(context.Background(), testZoneID, PaginationOptions{})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateAccessBookmark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+				"name": "Admin Site",
+				"domain": "example.com/admin",
+				"app_launcher_visible": false
+			}
+		}
+		`)
+	}
+
+	appLauncherVisible := false
+
+	want := AccessBookmark{
+		ID:                 "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Name:               "Admin Site",
+		Domain:             "example.com/admin",
+		AppLauncherVisible: &appLauncherVisible,
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/bookmarks", handler)
+
+	actual, err := client.CreateAccessBookmark(context.Background(), testAccountID, AccessBookmark{
+		Name:               "Admin Site",
+		Domain:             "example.com/admin",
+		AppLauncherVisible: &appLauncherVisible,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateAccessBookmarkWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateAccessBookmark(context.Background(), testAccountID, AccessBookmark{})
+	assert.EqualError(t, err, "access bookmark ID cannot be empty")
+}
+
+func TestDeleteAccessBookmark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/bookmarks/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", handler)
+
+	err := client.DeleteAccessBookmark(context.Background(), testAccountID, "480f4f69-1a28-4fdd-9240-1ed29f0ac1db")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccessCustomPageType represents the type of an Access custom page.
+type AccessCustomPageType string
+
+// These constants represent all valid Access custom page types.
+const (
+	AccessCustomPageForbidden      AccessCustomPageType = "forbidden"
+	AccessCustomPageIdentityDenied AccessCustomPageType = "identity_denied"
+)
+
+// AccessCustomPage represents an Access custom page.
+type AccessCustomPage struct {
+	UID        string               `json:"uid,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	Type       AccessCustomPageType `json:"type,omitempty"`
+	CustomHTML string               `json:"custom_html,omitempty"`
+	AppCount   int                  `json:"app_count,omitempty"`
+	CreatedAt  *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time           `json:"updated_at,omitempty"`
+}
+
+// AccessCustomPageListResponse represents the response from the list
+// access custom pages endpoint.
+type AccessCustomPageListResponse struct {
+	Result []AccessCustomPage `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// AccessCustomPageDetailResponse is the API response, containing a single
+// access custom page.
+type AccessCustomPageDetailResponse struct {
+	Response
+	Result AccessCustomPage `json:"result"`
+}
+
+// AccessCustomPages returns all Access custom pages within an account.
+//
+// API reference: https://api.cloudflare.com/#access-custom-pages-list-custom-pages
+func (api *API) AccessCustomPages(ctx context.Context, accountID string) ([]AccessCustomPage, error) {
+	uri := fmt.Sprintf("/%s/%s/access/custom_pages", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AccessCustomPage{}, err
+	}
+
+	var customPagesResponse AccessCustomPageListResponse
+	err = json.Unmarshal(res, &customPagesResponse)
+	if err != nil {
+		return []AccessCustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return customPagesResponse.Result, nil
+}
+
+// AccessCustomPage returns a single Access custom page based on the page ID.
+//
+// API reference: https://api.cloudflare.com/#access-custom-pages-get-a-custom-page
+func (api *API) AccessCustomPage(ctx context.Context, accountID, pageID string) (AccessCustomPage, error) {
+	uri := fmt.Sprintf("/%s/%s/access/custom_pages/%s", AccountRouteRoot, accountID, pageID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AccessCustomPage{}, err
+	}
+
+	var customPageResponse AccessCustomPageDetailResponse
+	err = json.Unmarshal(res, &customPageResponse)
+	if err != nil {
+		return AccessCustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return customPageResponse.Result, nil
+}
+
+// CreateAccessCustomPage creates a new Access custom page.
+//
+// API reference: https://api.cloudflare.com/#access-custom-pages-create-a-custom-page
+func (api *API) CreateAccessCustomPage(ctx context.Context, accountID string, customPage AccessCustomPage) (AccessCustomPage, error) {
+	uri := fmt.Sprintf("/%s/%s/access/custom_pages", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, customPage)
+	if err != nil {
+		return AccessCustomPage{}, err
+	}
+
+	var customPageResponse AccessCustomPageDetailResponse
+	err = json.Unmarshal(res, &customPageResponse)
+	if err != nil {
+		return AccessCustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return customPageResponse.Result, nil
+}
+
+// UpdateAccessCustomPage updates an existing Access custom page.
+//
+// API reference: https://api.cloudflare.com/#access-custom-pages-update-a-custom-page
+func (api *API) UpdateAccessCustomPage(ctx context.Context, accountID string, customPage AccessCustomPage) (AccessCustomPage, error) {
+	if customPage.UID == "" {
+		return AccessCustomPage{}, errors.Errorf("access custom page UID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/access/custom_pages/%s", AccountRouteRoot, accountID, customPage.UID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, customPage)
+	if err != nil {
+		return AccessCustomPage{}, err
+	}
+
+	var customPageResponse AccessCustomPageDetailResponse
+	err = json.Unmarshal(res, &customPageResponse)
+	if err != nil {
+		return AccessCustomPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return customPageResponse.Result, nil
+}
+
+// DeleteAccessCustomPage deletes an Access custom page.
+//
+// API reference: https://api.cloudflare.com/#access-custom-pages-delete-a-custom-page
+func (api *API) DeleteAccessCustomPage(ctx context.Context, accountID, pageID string) error {
+	uri := fmt.Sprintf("/%s/%s/access/custom_pages/%s", AccountRouteRoot, accountID, pageID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
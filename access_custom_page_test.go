@@ -0,0 +1,134 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessCustomPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"uid": "699d98642c564d2e855e9661899b7252",
+					"name": "Blocked",
+					"type": "forbidden",
+					"app_count": 2,
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+
+	want := []AccessCustomPage{{
+		UID:       "699d98642c564d2e855e9661899b7252",
+		Name:      "Blocked",
+		Type:      AccessCustomPageForbidden,
+		AppCount:  2,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/custom_pages", handler)
+
+	actual, err := client.AccessCustomPages(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateAccessCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"uid": "699d98642c564d2e855e9661899b7252",
+				"name": "Denied",
+				"type": "identity_denied",
+				"custom_html": "<html><body><h1>Access Denied</h1></body></html>"
+			}
+		}
+		`)
+	}
+
+	customPage := AccessCustomPage{
+		Name:       "Denied",
+		Type:       AccessCustomPageIdentityDenied,
+		CustomHTML: "<html><body><h1>Access Denied</h1></body></html>",
+	}
+
+	want := customPage
+	want.UID = "699d98642c564d2e855e9661899b7252"
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/custom_pages", handler)
+
+	actual, err := client.CreateAccessCustomPage(context.Background(), testAccountID, customPage)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateAccessCustomPageWithMissingUID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateAccessCustomPage(context.Background(), testAccountID, AccessCustomPage{})
+	assert.EqualError(t, err, "access custom page UID cannot be empty")
+}
+
+func TestDeleteAccessCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"uid": "699d98642c564d2e855e9661899b7252"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/custom_pages/699d98642c564d2e855e9661899b7252", handler)
+
+	err := client.DeleteAccessCustomPage(context.Background(), testAccountID, "699d98642c564d2e855e9661899b7252")
+	assert.NoError(t, err)
+}
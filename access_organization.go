@@ -12,11 +12,15 @@ import (
 
 // AccessOrganization represents an Access organization.
 type AccessOrganization struct {
-	CreatedAt   *time.Time                    `json:"created_at"`
-	UpdatedAt   *time.Time                    `json:"updated_at"`
-	Name        string                        `json:"name"`
-	AuthDomain  string                        `json:"auth_domain"`
-	LoginDesign AccessOrganizationLoginDesign `json:"login_design"`
+	CreatedAt                      *time.Time                    `json:"created_at"`
+	UpdatedAt                      *time.Time                    `json:"updated_at"`
+	Name                           string                        `json:"name"`
+	AuthDomain                     string                        `json:"auth_domain"`
+	LoginDesign                    AccessOrganizationLoginDesign `json:"login_design"`
+	IsUIReadOnly                   *bool                         `json:"is_ui_read_only,omitempty"`
+	UserSeatExpirationInactiveTime string                        `json:"user_seat_expiration_inactive_time,omitempty"`
+	AutoRedirectToIdentity         *bool                         `json:"auto_redirect_to_identity,omitempty"`
+	SessionDuration                string                        `json:"session_duration,omitempty"`
 }
 
 // AccessOrganizationLoginDesign represents the login design options.
@@ -24,6 +28,14 @@ type AccessOrganizationLoginDesign struct {
 	BackgroundColor string `json:"background_color"`
 	TextColor       string `json:"text_color"`
 	LogoPath        string `json:"logo_path"`
+	HeaderText      string `json:"header_text,omitempty"`
+	FooterText      string `json:"footer_text,omitempty"`
+}
+
+// AccessOrganizationRevokeUserRequest is the request body used to revoke all
+// of a user's Access sessions.
+type AccessOrganizationRevokeUserRequest struct {
+	Email string `json:"email"`
 }
 
 // AccessOrganizationListResponse represents the response from the list
@@ -135,3 +147,34 @@ func (api *API) updateAccessOrganization(ctx context.Context, id string, accessO
 
 	return accessOrganizationDetailResponse.Result, nil
 }
+
+// RevokeAccessUser revokes all active Access sessions for a user, forcing
+// them to reauthenticate against every application.
+//
+// API reference: https://api.cloudflare.com/#access-organizations-revoke-all-access-tokens-for-a-user
+func (api *API) RevokeAccessUser(ctx context.Context, accountID, email string) error {
+	return api.revokeAccessUser(ctx, accountID, email, AccountRouteRoot)
+}
+
+// RevokeZoneLevelAccessUser revokes all active zone level Access sessions for
+// a user.
+//
+// API reference: https://api.cloudflare.com/#zone-level-access-organizations-revoke-all-access-tokens-for-a-user
+func (api *API) RevokeZoneLevelAccessUser(ctx context.Context, zoneID, email string) error {
+	return api.revokeAccessUser(ctx, zoneID, email, ZoneRouteRoot)
+}
+
+func (api *API) revokeAccessUser(ctx context.Context, id, email string, routeRoot RouteRoot) error {
+	if email == "" {
+		return errors.New("email cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/access/organizations/revoke_user", routeRoot, id)
+
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, AccessOrganizationRevokeUserRequest{Email: email})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -183,3 +184,88 @@ func TestUpdateAccessOrganization(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestAccessOrganizationSessionSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "Widget Corps Internal Applications",
+				"auth_domain": "test.cloudflareaccess.com",
+				"login_design": {
+					"background_color": "#c5ed1b",
+					"text_color": "#c5ed1b",
+					"logo_path": "https://example.com/logo.png",
+					"header_text": "Widget Corps",
+					"footer_text": "Contact IT"
+				},
+				"is_ui_read_only": false,
+				"user_seat_expiration_inactive_time": "720h",
+				"auto_redirect_to_identity": true,
+				"session_duration": "24h"
+			}
+		}
+		`)
+	}
+
+	readOnly := false
+	autoRedirect := true
+
+	want := AccessOrganization{
+		Name:       "Widget Corps Internal Applications",
+		AuthDomain: "test.cloudflareaccess.com",
+		LoginDesign: AccessOrganizationLoginDesign{
+			BackgroundColor: "#c5ed1b",
+			TextColor:       "#c5ed1b",
+			LogoPath:        "https://example.com/logo.png",
+			HeaderText:      "Widget Corps",
+			FooterText:      "Contact IT",
+		},
+		IsUIReadOnly:                   &readOnly,
+		UserSeatExpirationInactiveTime: "720h",
+		AutoRedirectToIdentity:         &autoRedirect,
+		SessionDuration:                "24h",
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/organizations", handler)
+
+	actual, err := client.UpdateAccessOrganization(context.Background(), testAccountID, want)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestRevokeAccessUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{"email":"test@example.com"}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": true
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/organizations/revoke_user", handler)
+
+	err := client.RevokeAccessUser(context.Background(), testAccountID, "test@example.com")
+	assert.NoError(t, err)
+
+	err = client.RevokeAccessUser(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "email cannot be empty")
+}
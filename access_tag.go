@@ -0,0 +1,134 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccessTag represents an Access tag. Tags are referenced by name when
+// attached to applications.
+type AccessTag struct {
+	Name      string     `json:"name"`
+	AppCount  int        `json:"app_count,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// AccessTagListResponse represents the response from the list
+// access tags endpoint.
+type AccessTagListResponse struct {
+	Result []AccessTag `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// AccessTagDetailResponse is the API response, containing a single
+// access tag.
+type AccessTagDetailResponse struct {
+	Response
+	Result AccessTag `json:"result"`
+}
+
+// AccessTags returns all Access tags within an account.
+//
+// API reference: https://api.cloudflare.com/#access-tags-list-tags
+func (api *API) AccessTags(ctx context.Context, accountID string) ([]AccessTag, error) {
+	uri := fmt.Sprintf("/%s/%s/access/tags", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AccessTag{}, err
+	}
+
+	var accessTagListResponse AccessTagListResponse
+	err = json.Unmarshal(res, &accessTagListResponse)
+	if err != nil {
+		return []AccessTag{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return accessTagListResponse.Result, nil
+}
+
+// AccessTag returns a single Access tag based on the tag name.
+//
+// API reference: https://api.cloudflare.com/#access-tags-get-a-tag
+func (api *API) AccessTag(ctx context.Context, accountID, tagName string) (AccessTag, error) {
+	uri := fmt.Sprintf("/%s/%s/access/tags/%s", AccountRouteRoot, accountID, tagName)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return AccessTag{}, err
+	}
+
+	var accessTagDetailResponse AccessTagDetailResponse
+	err = json.Unmarshal(res, &accessTagDetailResponse)
+	if err != nil {
+		return AccessTag{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return accessTagDetailResponse.Result, nil
+}
+
+// CreateAccessTag creates a new Access tag.
+//
+// API reference: https://api.cloudflare.com/#access-tags-create-tag
+func (api *API) CreateAccessTag(ctx context.Context, accountID string, tag AccessTag) (AccessTag, error) {
+	uri := fmt.Sprintf("/%s/%s/access/tags", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, tag)
+	if err != nil {
+		return AccessTag{}, err
+	}
+
+	var accessTagDetailResponse AccessTagDetailResponse
+	err = json.Unmarshal(res, &accessTagDetailResponse)
+	if err != nil {
+		return AccessTag{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return accessTagDetailResponse.Result, nil
+}
+
+// UpdateAccessTag renames an existing Access tag. Applications referencing
+// the tag are updated to use the new name.
+//
+// API reference: https://api.cloudflare.com/#access-tags-update-a-tag
+func (api *API) UpdateAccessTag(ctx context.Context, accountID, tagName string, tag AccessTag) (AccessTag, error) {
+	if tagName == "" {
+		return AccessTag{}, errors.Errorf("access tag name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/access/tags/%s", AccountRouteRoot, accountID, tagName)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, tag)
+	if err != nil {
+		return AccessTag{}, err
+	}
+
+	var accessTagDetailResponse AccessTagDetailResponse
+	err = json.Unmarshal(res, &accessTagDetailResponse)
+	if err != nil {
+		return AccessTag{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return accessTagDetailResponse.Result, nil
+}
+
+// DeleteAccessTag deletes an Access tag.
+//
+// API reference: https://api.cloudflare.com/#access-tags-delete-a-tag
+func (api *API) DeleteAccessTag(ctx context.Context, accountID, tagName string) error {
+	uri := fmt.Sprintf("/%s/%s/access/tags/%s", AccountRouteRoot, accountID, tagName)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessTags(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"name": "engineers",
+					"app_count": 3,
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+
+	want := []AccessTag{{
+		Name:      "engineers",
+		AppCount:  3,
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/tags", handler)
+
+	actual, err := client.AccessTags(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateAccessTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "engineers",
+				"app_count": 0
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/tags", handler)
+
+	actual, err := client.CreateAccessTag(context.Background(), testAccountID, AccessTag{Name: "engineers"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, AccessTag{Name: "engineers"}, actual)
+	}
+}
+
+func TestUpdateAccessTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "developers",
+				"app_count": 3
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/tags/engineers", handler)
+
+	actual, err := client.UpdateAccessTag(context.Background(), testAccountID, "engineers", AccessTag{Name: "developers"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, AccessTag{Name: "developers", AppCount: 3}, actual)
+	}
+
+	_, err = client.UpdateAccessTag(context.Background(), testAccountID, "", AccessTag{Name: "developers"})
+	assert.EqualError(t, err, "access tag name cannot be empty")
+}
+
+func TestDeleteAccessTag(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "engineers"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/tags/engineers", handler)
+
+	err := client.DeleteAccessTag(context.Background(), testAccountID, "engineers")
+	assert.NoError(t, err)
+}
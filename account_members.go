@@ -13,11 +13,35 @@ import (
 
 // AccountMember is the definition of a member of an account.
 type AccountMember struct {
-	ID     string                   `json:"id"`
-	Code   string                   `json:"code"`
-	User   AccountMemberUserDetails `json:"user"`
-	Status string                   `json:"status"`
-	Roles  []AccountRole            `json:"roles"`
+	ID       string                   `json:"id"`
+	Code     string                   `json:"code"`
+	User     AccountMemberUserDetails `json:"user"`
+	Status   string                   `json:"status"`
+	Roles    []AccountRole            `json:"roles,omitempty"`
+	Policies []AccountMemberPolicy    `json:"policies,omitempty"`
+}
+
+// AccountMemberPolicy is a scoped permission of an account member, granting
+// (or denying) the permission groups on the resource groups.
+type AccountMemberPolicy struct {
+	ID               string                         `json:"id,omitempty"`
+	Access           string                         `json:"access"`
+	PermissionGroups []AccountMemberPermissionGroup `json:"permission_groups"`
+	ResourceGroups   []AccountMemberResourceGroup   `json:"resource_groups"`
+}
+
+// AccountMemberPermissionGroup is a set of permissions, such as
+// "Administrator Read Only", that can be granted by a policy.
+type AccountMemberPermissionGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// AccountMemberResourceGroup is a set of resources, such as an account or
+// a zone, that a policy applies to.
+type AccountMemberResourceGroup struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
 }
 
 // AccountMemberUserDetails outlines all the personal information about
@@ -50,8 +74,9 @@ type AccountMemberDetailResponse struct {
 // AccountMemberInvitation represents the invitation for a new member to
 // the account.
 type AccountMemberInvitation struct {
-	Email string   `json:"email"`
-	Roles []string `json:"roles"`
+	Email    string                `json:"email"`
+	Roles    []string              `json:"roles,omitempty"`
+	Policies []AccountMemberPolicy `json:"policies,omitempty"`
 }
 
 // AccountMembers returns all members of an account.
@@ -117,6 +142,38 @@ func (api *API) CreateAccountMember(ctx context.Context, accountID string, email
 	return accountMemberListResponse.Result, nil
 }
 
+// CreateAccountMemberWithPolicies invites a new member to join an account
+// with policy based permissions rather than roles.
+//
+// API reference: https://api.cloudflare.com/#account-members-add-member
+func (api *API) CreateAccountMemberWithPolicies(ctx context.Context, accountID string, emailAddress string, policies []AccountMemberPolicy) (AccountMember, error) {
+	if accountID == "" {
+		return AccountMember{}, errors.New(errMissingAccountID)
+	}
+	if len(policies) == 0 {
+		return AccountMember{}, errors.New("account member policies cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/members", accountID)
+
+	var newMember = AccountMemberInvitation{
+		Email:    emailAddress,
+		Policies: policies,
+	}
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, newMember)
+	if err != nil {
+		return AccountMember{}, err
+	}
+
+	var accountMemberListResponse AccountMemberDetailResponse
+	err = json.Unmarshal(res, &accountMemberListResponse)
+	if err != nil {
+		return AccountMember{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return accountMemberListResponse.Result, nil
+}
+
 // DeleteAccountMember removes a member from an account.
 //
 // API reference: https://api.cloudflare.com/#account-members-remove-member
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -344,3 +345,66 @@ func TestAccountMemberWithoutAccountID(t *testing.T) {
 		assert.Equal(t, err.Error(), errMissingAccountID)
 	}
 }
+
+func TestCreateAccountMemberWithPolicies(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"email": "user@example.com",
+				"policies": [{
+					"access": "allow",
+					"permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f"}],
+					"resource_groups": [{"id": "6d7f2f5f5b1d4a0e9081fdc98d432fd1"}]
+				}]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "4536bcfad5faccb111b47003c79917fa",
+				"user": {"email": "user@example.com"},
+				"status": "pending",
+				"policies": [{
+					"id": "f267e341f3dd4697bd3b9f71dd96247f",
+					"access": "allow",
+					"permission_groups": [{"id": "c8fed203ed3043cba015a93ad1616f1f", "name": "Zone Read"}],
+					"resource_groups": [{"id": "6d7f2f5f5b1d4a0e9081fdc98d432fd1", "name": "example.com"}]
+				}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/01a7362d577a6c3019a474fd6f485823/members", handler)
+
+	policies := []AccountMemberPolicy{{
+		Access:           "allow",
+		PermissionGroups: []AccountMemberPermissionGroup{{ID: "c8fed203ed3043cba015a93ad1616f1f"}},
+		ResourceGroups:   []AccountMemberResourceGroup{{ID: "6d7f2f5f5b1d4a0e9081fdc98d432fd1"}},
+	}}
+
+	actual, err := client.CreateAccountMemberWithPolicies(context.Background(), "01a7362d577a6c3019a474fd6f485823", "user@example.com", policies)
+	if assert.NoError(t, err) {
+		assert.Equal(t, AccountMember{
+			ID:     "4536bcfad5faccb111b47003c79917fa",
+			User:   AccountMemberUserDetails{Email: "user@example.com"},
+			Status: "pending",
+			Policies: []AccountMemberPolicy{{
+				ID:               "f267e341f3dd4697bd3b9f71dd96247f",
+				Access:           "allow",
+				PermissionGroups: []AccountMemberPermissionGroup{{ID: "c8fed203ed3043cba015a93ad1616f1f", Name: "Zone Read"}},
+				ResourceGroups:   []AccountMemberResourceGroup{{ID: "6d7f2f5f5b1d4a0e9081fdc98d432fd1", Name: "example.com"}},
+			}},
+		}, actual)
+	}
+
+	_, err = client.CreateAccountMemberWithPolicies(context.Background(), "01a7362d577a6c3019a474fd6f485823", "user@example.com", nil)
+	assert.EqualError(t, err, "account member policies cannot be empty")
+}
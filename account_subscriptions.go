@@ -0,0 +1,191 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// AccountSubscription is a subscription of an account, to a zone rate plan
+// or an add-on product.
+type AccountSubscription struct {
+	ID                 string                      `json:"id,omitempty"`
+	RatePlan           ZoneRatePlan                `json:"rate_plan"`
+	Frequency          string                      `json:"frequency,omitempty"`
+	ComponentValues    []ZoneSubscriptionComponent `json:"component_values,omitempty"`
+	Zone               *AccountSubscriptionZone    `json:"zone,omitempty"`
+	State              string                      `json:"state,omitempty"`
+	Price              float64                     `json:"price,omitempty"`
+	Currency           string                      `json:"currency,omitempty"`
+	CurrentPeriodStart *time.Time                  `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *time.Time                  `json:"current_period_end,omitempty"`
+}
+
+// AccountSubscriptionZone is the zone an account subscription applies to.
+type AccountSubscriptionZone struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// AccountSubscriptionResponse is the API response containing a single
+// account subscription.
+type AccountSubscriptionResponse struct {
+	Response
+	Result AccountSubscription `json:"result"`
+}
+
+// AccountSubscriptionsResponse is the API response containing the
+// subscriptions of an account.
+type AccountSubscriptionsResponse struct {
+	Response
+	Result []AccountSubscription `json:"result"`
+}
+
+// AccountSubscriptions returns the subscriptions of an account.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-subscriptions/
+func (api *API) AccountSubscriptions(ctx context.Context, accountID string) ([]AccountSubscription, error) {
+	if accountID == "" {
+		return []AccountSubscription{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AccountSubscription{}, err
+	}
+
+	var r AccountSubscriptionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AccountSubscription{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateAccountSubscription subscribes an account to a rate plan or add-on.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-subscriptions/
+func (api *API) CreateAccountSubscription(ctx context.Context, accountID string, subscription AccountSubscription) (AccountSubscription, error) {
+	if accountID == "" {
+		return AccountSubscription{}, errors.New(errMissingAccountID)
+	}
+	if subscription.RatePlan.ID == "" {
+		return AccountSubscription{}, errors.New("rate plan ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions", accountID)
+	return api.accountSubscriptionRequest(ctx, http.MethodPost, uri, subscription)
+}
+
+// UpdateAccountSubscription changes the rate plan, frequency or add-ons of
+// an account subscription.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-subscriptions/
+func (api *API) UpdateAccountSubscription(ctx context.Context, accountID, subscriptionID string, subscription AccountSubscription) (AccountSubscription, error) {
+	if accountID == "" {
+		return AccountSubscription{}, errors.New(errMissingAccountID)
+	}
+	if subscriptionID == "" {
+		return AccountSubscription{}, errors.New("subscription ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", accountID, subscriptionID)
+	return api.accountSubscriptionRequest(ctx, http.MethodPut, uri, subscription)
+}
+
+// DeleteAccountSubscription cancels an account subscription.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-subscriptions/
+func (api *API) DeleteAccountSubscription(ctx context.Context, accountID, subscriptionID string) error {
+	if accountID == "" {
+		return errors.New(errMissingAccountID)
+	}
+	if subscriptionID == "" {
+		return errors.New("subscription ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/subscriptions/%s", accountID, subscriptionID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) accountSubscriptionRequest(ctx context.Context, method, uri string, params interface{}) (AccountSubscription, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return AccountSubscription{}, err
+	}
+
+	var r AccountSubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AccountSubscription{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// AccountEntitlement is a feature an account is entitled to through its
+// subscriptions, with the allocation granted for it.
+type AccountEntitlement struct {
+	ID         string                       `json:"id"`
+	Feature    AccountEntitlementFeature    `json:"feature"`
+	Allocation AccountEntitlementAllocation `json:"allocation"`
+}
+
+// AccountEntitlementFeature identifies an entitled feature.
+type AccountEntitlementFeature struct {
+	Key  string `json:"key"`
+	Type string `json:"type,omitempty"`
+}
+
+// AccountEntitlementAllocation is the amount of a feature granted. Value is
+// a bool for on/off features and a number for metered ones.
+type AccountEntitlementAllocation struct {
+	Type  string      `json:"type,omitempty"`
+	Value interface{} `json:"value"`
+}
+
+// AccountEntitlementsResponse is the API response containing the
+// entitlements of an account.
+type AccountEntitlementsResponse struct {
+	Response
+	Result []AccountEntitlement `json:"result"`
+}
+
+// Enabled reports whether the entitlement grants its feature: a true
+// boolean allocation or a positive numeric one.
+func (e AccountEntitlement) Enabled() bool {
+	switch v := e.Allocation.Value.(type) {
+	case bool:
+		return v
+	case float64:
+		return v > 0
+	}
+	return false
+}
+
+// AccountEntitlements returns the features an account is entitled to.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-subscriptions/
+func (api *API) AccountEntitlements(ctx context.Context, accountID string) ([]AccountEntitlement, error) {
+	if accountID == "" {
+		return []AccountEntitlement{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/entitlements", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AccountEntitlement{}, err
+	}
+
+	var r AccountEntitlementsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []AccountEntitlement{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,171 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountSubscriptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "506e3185e9c882d175a2d0cb0093d9f2",
+				"rate_plan": {"id": "CF_PRO"},
+				"frequency": "monthly",
+				"state": "Paid",
+				"zone": {"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com"}
+			}]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions", handler)
+
+	actual, err := client.AccountSubscriptions(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AccountSubscription{{
+			ID:        "506e3185e9c882d175a2d0cb0093d9f2",
+			RatePlan:  ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: ZoneRatePlanPro}},
+			Frequency: "monthly",
+			State:     "Paid",
+			Zone:      &AccountSubscriptionZone{ID: "023e105f4ecef8ad9ca31a8372d0c353", Name: "example.com"},
+		}}, actual)
+	}
+}
+
+func TestCreateAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"rate_plan": {"id": "CF_BIZ"}, "frequency": "yearly", "zone": {"id": "023e105f4ecef8ad9ca31a8372d0c353"}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "506e3185e9c882d175a2d0cb0093d9f2", "rate_plan": {"id": "CF_BIZ"}, "frequency": "yearly"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions", handler)
+
+	actual, err := client.CreateAccountSubscription(context.Background(), testAccountID, AccountSubscription{
+		RatePlan:  ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: ZoneRatePlanBusiness}},
+		Frequency: "yearly",
+		Zone:      &AccountSubscriptionZone{ID: "023e105f4ecef8ad9ca31a8372d0c353"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "506e3185e9c882d175a2d0cb0093d9f2", actual.ID)
+	}
+
+	_, err = client.CreateAccountSubscription(context.Background(), testAccountID, AccountSubscription{})
+	assert.EqualError(t, err, "rate plan ID cannot be empty")
+}
+
+func TestUpdateAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "506e3185e9c882d175a2d0cb0093d9f2", "rate_plan": {"id": "CF_ENT"}, "frequency": "yearly"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions/506e3185e9c882d175a2d0cb0093d9f2", handler)
+
+	actual, err := client.UpdateAccountSubscription(context.Background(), testAccountID, "506e3185e9c882d175a2d0cb0093d9f2", AccountSubscription{
+		RatePlan: ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: ZoneRatePlanEnterprise}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneRatePlanEnterprise, actual.RatePlan.ID)
+	}
+}
+
+func TestDeleteAccountSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"subscription_id": "506e3185e9c882d175a2d0cb0093d9f2"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/subscriptions/506e3185e9c882d175a2d0cb0093d9f2", handler)
+
+	err := client.DeleteAccountSubscription(context.Background(), testAccountID, "506e3185e9c882d175a2d0cb0093d9f2")
+	assert.NoError(t, err)
+
+	err = client.DeleteAccountSubscription(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "subscription ID cannot be empty")
+}
+
+func TestAccountEntitlements(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "a1", "feature": {"key": "zones.max_count", "type": "max_count"}, "allocation": {"type": "max_count", "value": 10}},
+				{"id": "b2", "feature": {"key": "argo.enabled", "type": "bool"}, "allocation": {"type": "bool", "value": false}}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/entitlements", handler)
+
+	actual, err := client.AccountEntitlements(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AccountEntitlement{
+			{
+				ID:         "a1",
+				Feature:    AccountEntitlementFeature{Key: "zones.max_count", Type: "max_count"},
+				Allocation: AccountEntitlementAllocation{Type: "max_count", Value: float64(10)},
+			},
+			{
+				ID:         "b2",
+				Feature:    AccountEntitlementFeature{Key: "argo.enabled", Type: "bool"},
+				Allocation: AccountEntitlementAllocation{Type: "bool", Value: false},
+			},
+		}, actual)
+		assert.True(t, actual[0].Enabled())
+		assert.False(t, actual[1].Enabled())
+	}
+
+	_, err = client.AccountEntitlements(context.Background(), "")
+	assert.EqualError(t, err, errMissingAccountID)
+}
@@ -7,10 +7,17 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
+// Account types.
+const (
+	AccountTypeStandard   = "standard"
+	AccountTypeEnterprise = "enterprise"
+)
+
 // AccountSettings outlines the available options for an account.
 type AccountSettings struct {
 	EnforceTwoFactor bool `json:"enforce_twofactor"`
@@ -18,10 +25,11 @@ type AccountSettings struct {
 
 // Account represents the root object that owns resources.
 type Account struct {
-	ID       string           `json:"id,omitempty"`
-	Name     string           `json:"name,omitempty"`
-	Type     string           `json:"type,omitempty"`
-	Settings *AccountSettings `json:"settings,omitempty"`
+	ID        string           `json:"id,omitempty"`
+	Name      string           `json:"name,omitempty"`
+	Type      string           `json:"type,omitempty"`
+	Settings  *AccountSettings `json:"settings,omitempty"`
+	CreatedOn *time.Time       `json:"created_on,omitempty"`
 }
 
 // AccountResponse represents the response from the accounts endpoint for a
@@ -101,6 +109,10 @@ func (api *API) Account(ctx context.Context, accountID string) (Account, ResultI
 //
 // API reference: https://api.cloudflare.com/#accounts-update-account
 func (api *API) UpdateAccount(ctx context.Context, accountID string, account Account) (Account, error) {
+	if accountID == "" {
+		return Account{}, errors.New(errMissingAccountID)
+	}
+
 	uri := fmt.Sprintf("/accounts/%s", accountID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, account)
@@ -122,6 +134,13 @@ func (api *API) UpdateAccount(ctx context.Context, accountID string, account Acc
 //
 // API reference: https://developers.cloudflare.com/tenant/tutorial/provisioning-resources#creating-an-account
 func (api *API) CreateAccount(ctx context.Context, account Account) (Account, error) {
+	if account.Name == "" {
+		return Account{}, errors.New("account name cannot be empty")
+	}
+	if account.Type != "" && account.Type != AccountTypeStandard && account.Type != AccountTypeEnterprise {
+		return Account{}, errors.Errorf("invalid account type %q", account.Type)
+	}
+
 	uri := "/accounts"
 
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, account)
@@ -214,3 +214,17 @@ func TestDeleteAccount(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestCreateAccountValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.CreateAccount(context.Background(), Account{Type: AccountTypeStandard})
+	assert.EqualError(t, err, "account name cannot be empty")
+
+	_, err = client.CreateAccount(context.Background(), Account{Name: "Cloudflare Demo", Type: "business"})
+	assert.EqualError(t, err, `invalid account type "business"`)
+
+	_, err = client.UpdateAccount(context.Background(), "", Account{Name: "Cloudflare Demo"})
+	assert.EqualError(t, err, errMissingAccountID)
+}
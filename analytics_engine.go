@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// AnalyticsEngineSQLColumn describes a column returned by a Workers
+// Analytics Engine SQL query.
+type AnalyticsEngineSQLColumn struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// AnalyticsEngineSQLResult is the result of a Workers Analytics Engine SQL
+// query. Data holds the raw rows; use DecodeRows to decode them into typed
+// values.
+type AnalyticsEngineSQLResult struct {
+	Meta                   []AnalyticsEngineSQLColumn `json:"meta"`
+	Data                   json.RawMessage            `json:"data"`
+	Rows                   int                        `json:"rows"`
+	RowsBeforeLimitAtLeast int                        `json:"rows_before_limit_at_least"`
+}
+
+// DecodeRows decodes the rows of the result into v, which should be a
+// pointer to a slice of structs or maps keyed by column name.
+func (r AnalyticsEngineSQLResult) DecodeRows(v interface{}) error {
+	if len(r.Data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(r.Data, v); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
+
+// QueryAnalyticsEngine runs a SQL query against the Workers Analytics
+// Engine datasets of an account.
+//
+// API reference: https://developers.cloudflare.com/analytics/analytics-engine/sql-api/
+func (api *API) QueryAnalyticsEngine(ctx context.Context, accountID, query string) (AnalyticsEngineSQLResult, error) {
+	if query == "" {
+		return AnalyticsEngineSQLResult{}, errors.Errorf("query cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/analytics_engine/sql", AccountRouteRoot, accountID)
+	headers := make(http.Header)
+	headers.Set("Content-Type", "text/plain")
+
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, []byte(query), headers)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, err
+	}
+
+	var r AnalyticsEngineSQLResult
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return AnalyticsEngineSQLResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r, nil
+}
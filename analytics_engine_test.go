@@ -0,0 +1,65 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryAnalyticsEngine(t *testing.T) {
+	setup()
+	defer teardown()
+
+	query := "SELECT blob1 AS city, SUM(_sample_interval) AS visits FROM weather GROUP BY city"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "text/plain", r.Header.Get("Content-Type"))
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.Equal(t, query, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"meta": [
+				{"name": "city", "type": "String"},
+				{"name": "visits", "type": "UInt64"}
+			],
+			"data": [
+				{"city": "London", "visits": 42},
+				{"city": "Lisbon", "visits": 7}
+			],
+			"rows": 2,
+			"rows_before_limit_at_least": 2
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/analytics_engine/sql", handler)
+
+	result, err := client.QueryAnalyticsEngine(context.Background(), testAccountID, query)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []AnalyticsEngineSQLColumn{
+			{Name: "city", Type: "String"},
+			{Name: "visits", Type: "UInt64"},
+		}, result.Meta)
+		assert.Equal(t, 2, result.Rows)
+		assert.Equal(t, 2, result.RowsBeforeLimitAtLeast)
+
+		type row struct {
+			City   string `json:"city"`
+			Visits int    `json:"visits"`
+		}
+		var rows []row
+		if assert.NoError(t, result.DecodeRows(&rows)) {
+			assert.Equal(t, []row{{City: "London", Visits: 42}, {City: "Lisbon", Visits: 7}}, rows)
+		}
+	}
+
+	_, err = client.QueryAnalyticsEngine(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "query cannot be empty")
+}
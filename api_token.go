@@ -31,6 +31,12 @@ type APITokenPermissionGroups struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
+// API token policy effects.
+const (
+	APITokenPolicyEffectAllow = "allow"
+	APITokenPolicyEffectDeny  = "deny"
+)
+
 // APITokenPolicies are policies attached to an API token.
 type APITokenPolicies struct {
 	ID               string                     `json:"id,omitempty"`
@@ -237,3 +243,39 @@ func (api *API) ListAPITokensPermissionGroups(ctx context.Context) ([]APITokenPe
 
 	return r.Result, nil
 }
+
+// APITokenAccountResource returns the policy resource key of an account.
+func APITokenAccountResource(accountID string) string {
+	return fmt.Sprintf("com.cloudflare.api.account.%s", accountID)
+}
+
+// APITokenZoneResource returns the policy resource key of a zone. An empty
+// zoneID matches all zones.
+func APITokenZoneResource(zoneID string) string {
+	if zoneID == "" {
+		zoneID = "*"
+	}
+	return fmt.Sprintf("com.cloudflare.api.account.zone.%s", zoneID)
+}
+
+// APITokenUserResource returns the policy resource key of a user.
+func APITokenUserResource(userID string) string {
+	return fmt.Sprintf("com.cloudflare.api.user.%s", userID)
+}
+
+// NewAPITokenPolicy returns a policy with the given effect that applies the
+// permission groups to all of the given resources, built with
+// APITokenAccountResource, APITokenZoneResource or APITokenUserResource.
+func NewAPITokenPolicy(effect string, resources []string, permissionGroupIDs ...string) APITokenPolicies {
+	policy := APITokenPolicies{
+		Effect:    effect,
+		Resources: make(map[string]interface{}, len(resources)),
+	}
+	for _, r := range resources {
+		policy.Resources[r] = "*"
+	}
+	for _, id := range permissionGroupIDs {
+		policy.PermissionGroups = append(policy.PermissionGroups, APITokenPermissionGroups{ID: id})
+	}
+	return policy
+}
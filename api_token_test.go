@@ -415,3 +415,30 @@ func TestListAPITokensPermissionGroups(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestNewAPITokenPolicy(t *testing.T) {
+	policy := NewAPITokenPolicy(
+		APITokenPolicyEffectAllow,
+		[]string{
+			APITokenZoneResource("eb78d65290b24279ba6f44721b3ea3c4"),
+			APITokenAccountResource("01a7362d577a6c3019a474fd6f485823"),
+			APITokenZoneResource(""),
+			APITokenUserResource("7c5dae5552338874e5053f2534d2767a"),
+		},
+		"c8fed203ed3043cba015a93ad1616f1f", "82e64a83756745bbbb1c9c2701bf816b",
+	)
+
+	assert.Equal(t, APITokenPolicies{
+		Effect: "allow",
+		Resources: map[string]interface{}{
+			"com.cloudflare.api.account.zone.eb78d65290b24279ba6f44721b3ea3c4": "*",
+			"com.cloudflare.api.account.01a7362d577a6c3019a474fd6f485823":      "*",
+			"com.cloudflare.api.account.zone.*":                                "*",
+			"com.cloudflare.api.user.7c5dae5552338874e5053f2534d2767a":         "*",
+		},
+		PermissionGroups: []APITokenPermissionGroups{
+			{ID: "c8fed203ed3043cba015a93ad1616f1f"},
+			{ID: "82e64a83756745bbbb1c9c2701bf816b"},
+		},
+	}, policy)
+}
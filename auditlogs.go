@@ -62,12 +62,16 @@ type AuditLogFilter struct {
 	ID         string
 	ActorIP    string
 	ActorEmail string
+	ActionType string
 	Direction  string
 	ZoneName   string
 	Since      string
 	Before     string
 	PerPage    int
 	Page       int
+	// HideUserLogs excludes logs of actions taken by users through the
+	// dashboard or API, leaving only system generated logs.
+	HideUserLogs bool
 }
 
 // ToQuery turns an audit log filter in to an HTTP Query Param
@@ -85,6 +89,9 @@ func (a AuditLogFilter) ToQuery() url.Values {
 	if a.ActorEmail != "" {
 		v.Add("actor.email", a.ActorEmail)
 	}
+	if a.ActionType != "" {
+		v.Add("action.type", a.ActionType)
+	}
 	if a.ZoneName != "" {
 		v.Add("zone.name", a.ZoneName)
 	}
@@ -103,6 +110,9 @@ func (a AuditLogFilter) ToQuery() url.Values {
 	if a.Page > 0 {
 		v.Add("page", strconv.Itoa(a.Page))
 	}
+	if a.HideUserLogs {
+		v.Add("hide_user_logs", "true")
+	}
 
 	return v
 }
@@ -151,3 +161,52 @@ func (api *API) GetUserAuditLogs(ctx context.Context, a AuditLogFilter) (AuditLo
 	}
 	return unmarshalReturn(res)
 }
+
+// ForEachOrganizationAuditLog calls fn for every audit log of an
+// organization matching the filter, fetching pages as they are needed.
+// Paging starts at a.Page, or the first page if unset. Returning an error
+// from fn stops paging and returns that error.
+func (api *API) ForEachOrganizationAuditLog(ctx context.Context, organizationID string, a AuditLogFilter, fn func(AuditLog) error) error {
+	return forEachAuditLog(ctx, a, fn, func(a AuditLogFilter) (AuditLogResponse, error) {
+		return api.GetOrganizationAuditLogs(ctx, organizationID, a)
+	})
+}
+
+// ForEachUserAuditLog calls fn for every audit log of your user matching the
+// filter, fetching pages as they are needed. Paging starts at a.Page, or
+// the first page if unset. Returning an error from fn stops paging and
+// returns that error.
+func (api *API) ForEachUserAuditLog(ctx context.Context, a AuditLogFilter, fn func(AuditLog) error) error {
+	return forEachAuditLog(ctx, a, fn, func(a AuditLogFilter) (AuditLogResponse, error) {
+		return api.GetUserAuditLogs(ctx, a)
+	})
+}
+
+func forEachAuditLog(ctx context.Context, a AuditLogFilter, fn func(AuditLog) error, get func(AuditLogFilter) (AuditLogResponse, error)) error {
+	if a.Page < 1 {
+		a.Page = 1
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		res, err := get(a)
+		if err != nil {
+			return err
+		}
+		for _, log := range res.Result {
+			if err := fn(log); err != nil {
+				return err
+			}
+		}
+
+		if len(res.Result) == 0 ||
+			(res.TotalPages > 0 && a.Page >= res.TotalPages) ||
+			(res.PerPage > 0 && len(res.Result) < res.PerPage) {
+			return nil
+		}
+		a.Page++
+	}
+}
@@ -1,8 +1,13 @@
 package cloudflare
 
 import (
+	"context"
+	"fmt"
+	"net/http"
 	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAuditLogFilterToQuery(t *testing.T) {
@@ -23,6 +28,11 @@ func TestAuditLogFilterToQuery(t *testing.T) {
 		t.Fatalf("Did not properly stringify the actorip field: %s", filter.ToQuery().Encode())
 	}
 
+	filter.ActionType = "add"
+	if !strings.Contains(filter.ToQuery().Encode(), "action.type=add") {
+		t.Fatalf("Did not properly stringify the action.type field: %s", filter.ToQuery().Encode())
+	}
+
 	filter.ZoneName = "example.com"
 	if !strings.Contains(filter.ToQuery().Encode(), "&zone.name=example.com") {
 		t.Fatalf("Did not properly stringify the zone.name field: %s", filter.ToQuery().Encode())
@@ -52,4 +62,49 @@ func TestAuditLogFilterToQuery(t *testing.T) {
 	if !strings.Contains(filter.ToQuery().Encode(), "&page=3") {
 		t.Fatalf("Did not properly stringify the page field: %s", filter.ToQuery().Encode())
 	}
+
+	filter.HideUserLogs = true
+	if !strings.Contains(filter.ToQuery().Encode(), "hide_user_logs=true") {
+		t.Fatalf("Did not properly stringify the hide_user_logs field: %s", filter.ToQuery().Encode())
+	}
+}
+
+func TestForEachOrganizationAuditLog(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "admin@example.com", r.URL.Query().Get("actor.email"))
+		page := r.URL.Query().Get("page")
+		ids := map[string]string{"1": `{"id": "a"}, {"id": "b"}`, "2": `{"id": "c"}`}[page]
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {
+				"page": %s,
+				"per_page": 2
+			}
+		}`, ids, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/audit_logs", handler)
+
+	var ids []string
+	err := client.ForEachOrganizationAuditLog(context.Background(), testAccountID, AuditLogFilter{ActorEmail: "admin@example.com", PerPage: 2}, func(log AuditLog) error {
+		ids = append(ids, log.ID)
+		return nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"a", "b", "c"}, ids)
+	}
+
+	err = client.ForEachOrganizationAuditLog(context.Background(), testAccountID, AuditLogFilter{ActorEmail: "admin@example.com", PerPage: 2}, func(log AuditLog) error {
+		return fmt.Errorf("stop at %s", log.ID)
+	})
+	assert.EqualError(t, err, "stop at a")
 }
@@ -0,0 +1,125 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// BillingHistory is a billing event, such as a charge or a refund, of the
+// logged-in user.
+type BillingHistory struct {
+	ID          string             `json:"id"`
+	Type        string             `json:"type"`
+	Action      string             `json:"action"`
+	Description string             `json:"description"`
+	OccurredAt  *time.Time         `json:"occurred_at"`
+	Amount      float64            `json:"amount"`
+	Currency    string             `json:"currency"`
+	Zone        BillingHistoryZone `json:"zone"`
+}
+
+// BillingHistoryZone is the zone a billing event relates to.
+type BillingHistoryZone struct {
+	Name string `json:"name"`
+}
+
+// BillingHistoryResponse is the API response containing billing history.
+type BillingHistoryResponse struct {
+	Response
+	Result     []BillingHistory `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// BillingHistoryParams holds the filters used when listing billing history.
+type BillingHistoryParams struct {
+	// Order is the field to sort by, such as "occurred_at" or "type", and
+	// Direction is "asc" or "desc".
+	Order     string
+	Direction string
+	Type      string
+	Action    string
+	// OccurredAt restricts the results to events on or after this time.
+	OccurredAt *time.Time
+	PaginationOptions
+}
+
+// Encode encodes the billing history parameters into a query string.
+func (p BillingHistoryParams) Encode() string {
+	v := url.Values{}
+
+	if p.Order != "" {
+		v.Set("order", p.Order)
+	}
+	if p.Direction != "" {
+		v.Set("direction", p.Direction)
+	}
+	if p.Type != "" {
+		v.Set("type", p.Type)
+	}
+	if p.Action != "" {
+		v.Set("action", p.Action)
+	}
+	if p.OccurredAt != nil {
+		// The API spells this parameter "occured_at".
+		v.Set("occured_at", p.OccurredAt.UTC().Format(time.RFC3339))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// UserBillingHistory returns the billing history of the logged-in user.
+//
+// API reference: https://api.cloudflare.com/#user-billing-history-billing-history-details
+func (api *API) UserBillingHistory(ctx context.Context, params BillingHistoryParams) ([]BillingHistory, ResultInfo, error) {
+	uri := "/user/billing/history"
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []BillingHistory{}, ResultInfo{}, err
+	}
+
+	var r BillingHistoryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []BillingHistory{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// AccountBillingProfile returns the billing profile of an account.
+//
+// API reference: https://api.cloudflare.com/#account-billing-profile-billing-profile-details
+func (api *API) AccountBillingProfile(ctx context.Context, accountID string) (UserBillingProfile, error) {
+	if accountID == "" {
+		return UserBillingProfile{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/billing/profile", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return UserBillingProfile{}, err
+	}
+
+	var r userBillingProfileResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserBillingProfile{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,104 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserBillingHistory(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "occurred_at", r.URL.Query().Get("order"))
+		assert.Equal(t, "2022-01-01T00:00:00Z", r.URL.Query().Get("occured_at"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "b69a9f3492637782896352daae219e7d",
+				"type": "charge",
+				"action": "subscription",
+				"description": "The billing item description",
+				"occurred_at": "2022-03-01T05:20:00Z",
+				"amount": 20.99,
+				"currency": "USD",
+				"zone": {"name": "example.com"}
+			}],
+			"result_info": {"page": 2, "per_page": 20, "count": 1, "total_count": 21}
+		}`)
+	}
+
+	mux.HandleFunc("/user/billing/history", handler)
+
+	since, _ := time.Parse(time.RFC3339, "2022-01-01T00:00:00Z")
+	occurredAt, _ := time.Parse(time.RFC3339, "2022-03-01T05:20:00Z")
+
+	actual, resultInfo, err := client.UserBillingHistory(context.Background(), BillingHistoryParams{
+		Order:             "occurred_at",
+		OccurredAt:        &since,
+		PaginationOptions: PaginationOptions{Page: 2},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []BillingHistory{{
+			ID:          "b69a9f3492637782896352daae219e7d",
+			Type:        "charge",
+			Action:      "subscription",
+			Description: "The billing item description",
+			OccurredAt:  &occurredAt,
+			Amount:      20.99,
+			Currency:    "USD",
+			Zone:        BillingHistoryZone{Name: "example.com"},
+		}}, actual)
+		assert.Equal(t, 21, resultInfo.Total)
+	}
+}
+
+func TestAccountBillingProfile(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "0020c268dbf54e975e7fe8563df49d52",
+				"first_name": "Bob",
+				"last_name": "Smith",
+				"company": "Cloudflare",
+				"country": "US",
+				"card_number": "4242424242424242"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/billing/profile", handler)
+
+	actual, err := client.AccountBillingProfile(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, UserBillingProfile{
+			ID:         "0020c268dbf54e975e7fe8563df49d52",
+			FirstName:  "Bob",
+			LastName:   "Smith",
+			Company:    "Cloudflare",
+			Country:    "US",
+			CardNumber: "4242424242424242",
+		}, actual)
+	}
+
+	_, err = client.AccountBillingProfile(context.Background(), "")
+	assert.EqualError(t, err, errMissingAccountID)
+}
@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// CacheVariantsValues lists, per file extension, the content types served
+// as variants of that file based on the request's Accept header.
+type CacheVariantsValues struct {
+	Avif []string `json:"avif,omitempty"`
+	Bmp  []string `json:"bmp,omitempty"`
+	Gif  []string `json:"gif,omitempty"`
+	Jpeg []string `json:"jpeg,omitempty"`
+	Jpg  []string `json:"jpg,omitempty"`
+	Jpg2 []string `json:"jpg2,omitempty"`
+	Jp2  []string `json:"jp2,omitempty"`
+	Png  []string `json:"png,omitempty"`
+	Tiff []string `json:"tiff,omitempty"`
+	Tif  []string `json:"tif,omitempty"`
+	Webp []string `json:"webp,omitempty"`
+}
+
+// CacheVariants is the cache variants setting of a zone.
+type CacheVariants struct {
+	ID         string              `json:"id,omitempty"`
+	Value      CacheVariantsValues `json:"value"`
+	Editable   bool                `json:"editable,omitempty"`
+	ModifiedOn time.Time           `json:"modified_on,omitempty"`
+}
+
+// CacheVariantsResponse is the API response for the cache variants setting.
+type CacheVariantsResponse struct {
+	Response
+	Result CacheVariants `json:"result"`
+}
+
+// CacheVariants returns the cache variants setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-get-variants-setting
+func (api *API) CacheVariants(ctx context.Context, zoneID string) (CacheVariants, error) {
+	return api.cacheVariantsRequest(ctx, http.MethodGet, zoneID, nil)
+}
+
+// UpdateCacheVariants replaces the cache variants setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-change-variants-setting
+func (api *API) UpdateCacheVariants(ctx context.Context, zoneID string, variants CacheVariantsValues) (CacheVariants, error) {
+	params := struct {
+		Value CacheVariantsValues `json:"value"`
+	}{variants}
+	return api.cacheVariantsRequest(ctx, http.MethodPatch, zoneID, params)
+}
+
+// DeleteCacheVariants removes the cache variants setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-delete-variants-setting
+func (api *API) DeleteCacheVariants(ctx context.Context, zoneID string) error {
+	_, err := api.cacheVariantsRequest(ctx, http.MethodDelete, zoneID, nil)
+	return err
+}
+
+func (api *API) cacheVariantsRequest(ctx context.Context, method, zoneID string, params interface{}) (CacheVariants, error) {
+	uri := fmt.Sprintf("/zones/%s/cache/variants", zoneID)
+
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return CacheVariants{}, err
+	}
+
+	var r CacheVariantsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return CacheVariants{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheVariants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "variants",
+				"value": {
+					"jpeg": ["image/webp", "image/jpeg"],
+					"png": ["image/webp", "image/png"]
+				},
+				"editable": true,
+				"modified_on": "2014-01-01T05:20:00.12345Z"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/variants", handler)
+
+	modifiedOn, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	want := CacheVariants{
+		ID: "variants",
+		Value: CacheVariantsValues{
+			Jpeg: []string{"image/webp", "image/jpeg"},
+			Png:  []string{"image/webp", "image/png"},
+		},
+		Editable:   true,
+		ModifiedOn: modifiedOn,
+	}
+
+	actual, err := client.CacheVariants(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateCacheVariants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": {"avif": ["image/webp", "image/avif"]}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "variants",
+				"value": {"avif": ["image/webp", "image/avif"]},
+				"editable": true,
+				"modified_on": "2014-01-01T05:20:00.12345Z"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/variants", handler)
+
+	actual, err := client.UpdateCacheVariants(context.Background(), testZoneID, CacheVariantsValues{
+		Avif: []string{"image/webp", "image/avif"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []string{"image/webp", "image/avif"}, actual.Value.Avif)
+	}
+}
+
+func TestDeleteCacheVariants(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "variants", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/variants", handler)
+
+	err := client.DeleteCacheVariants(context.Background(), testZoneID)
+	assert.NoError(t, err)
+}
@@ -35,11 +35,34 @@ type CertificatePackCertificate struct {
 
 // CertificatePack is the overarching structure of a certificate pack response.
 type CertificatePack struct {
-	ID                 string                       `json:"id"`
-	Type               string                       `json:"type"`
-	Hosts              []string                     `json:"hosts"`
-	Certificates       []CertificatePackCertificate `json:"certificates"`
-	PrimaryCertificate int                          `json:"primary_certificate"`
+	ID                   string                       `json:"id"`
+	Type                 string                       `json:"type"`
+	Hosts                []string                     `json:"hosts"`
+	Certificates         []CertificatePackCertificate `json:"certificates"`
+	PrimaryCertificate   int                          `json:"primary_certificate"`
+	Status               string                       `json:"status,omitempty"`
+	ValidationMethod     string                       `json:"validation_method,omitempty"`
+	ValidityDays         int                          `json:"validity_days,omitempty"`
+	CertificateAuthority string                       `json:"certificate_authority,omitempty"`
+	CloudflareBranding   bool                         `json:"cloudflare_branding,omitempty"`
+}
+
+// certificatePackCertificateStatusBackup is the status of a certificate that
+// is held in reserve rather than served.
+const certificatePackCertificateStatusBackup = "backup_issued"
+
+// BackupCertificates returns the certificates in the pack that are held in
+// reserve in case the primary certificate has to be revoked. Backups are only
+// included in packs fetched with ListCertificatePacks.
+func (c CertificatePack) BackupCertificates() []CertificatePackCertificate {
+	var backups []CertificatePackCertificate
+	for _, cert := range c.Certificates {
+		if cert.Status == certificatePackCertificateStatusBackup {
+			backups = append(backups, cert)
+		}
+	}
+
+	return backups
 }
 
 // CertificatePackRequest is used for requesting a new certificate.
@@ -54,12 +77,32 @@ type CertificatePackAdvancedCertificate struct {
 	ID                   string   `json:"id"`
 	Type                 string   `json:"type"`
 	Hosts                []string `json:"hosts"`
+	Status               string   `json:"status,omitempty"`
 	ValidationMethod     string   `json:"validation_method"`
 	ValidityDays         int      `json:"validity_days"`
 	CertificateAuthority string   `json:"certificate_authority"`
 	CloudflareBranding   bool     `json:"cloudflare_branding"`
 }
 
+// CertificatePackQuota is the number of certificate packs of a given kind
+// allocated to and used by a zone.
+type CertificatePackQuota struct {
+	Allocated int `json:"allocated"`
+	Used      int `json:"used"`
+}
+
+// CertificatePackQuotas holds the certificate pack quotas of a zone.
+type CertificatePackQuotas struct {
+	Advanced CertificatePackQuota `json:"advanced"`
+}
+
+// CertificatePackQuotasResponse contains the certificate pack quotas of a
+// zone in the response.
+type CertificatePackQuotasResponse struct {
+	Response
+	Result CertificatePackQuotas `json:"result"`
+}
+
 // CertificatePacksResponse is for responses where multiple certificates are
 // expected.
 type CertificatePacksResponse struct {
@@ -189,3 +232,23 @@ func (api *API) RestartAdvancedCertificateValidation(ctx context.Context, zoneID
 
 	return advancedCertificatePacksDetailResponse.Result, nil
 }
+
+// CertificatePackQuotas returns the number of certificate packs allocated to
+// and used by a zone.
+//
+// API Reference: https://api.cloudflare.com/#certificate-packs-get-certificate-pack-quotas
+func (api *API) CertificatePackQuotas(ctx context.Context, zoneID string) (CertificatePackQuotas, error) {
+	uri := fmt.Sprintf("/zones/%s/ssl/certificate_packs/quota", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return CertificatePackQuotas{}, err
+	}
+
+	var certificatePackQuotasResponse CertificatePackQuotasResponse
+	err = json.Unmarshal(res, &certificatePackQuotasResponse)
+	if err != nil {
+		return CertificatePackQuotas{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return certificatePackQuotasResponse.Result, nil
+}
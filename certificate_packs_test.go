@@ -242,10 +242,13 @@ func TestCreateAdvancedCertificatePack(t *testing.T) {
 		CloudflareBranding:   false,
 	}
 
+	want := certificate
+	want.Status = "initializing"
+
 	actual, err := client.CreateAdvancedCertificatePack(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", certificate)
 
 	if assert.NoError(t, err) {
-		assert.Equal(t, certificate, actual)
+		assert.Equal(t, want, actual)
 	}
 }
 
@@ -283,6 +286,7 @@ func TestRestartAdvancedCertificateValidation(t *testing.T) {
 		ID:                   "3822ff90-ea29-44df-9e55-21300bb9419b",
 		Type:                 "advanced",
 		Hosts:                []string{"example.com", "*.example.com", "www.example.com"},
+		Status:               "initializing",
 		ValidityDays:         365,
 		ValidationMethod:     "txt",
 		CertificateAuthority: "digicert",
@@ -320,3 +324,54 @@ func TestDeleteCertificatePack(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestCertificatePackQuotas(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": {
+    "advanced": {
+      "allocated": 10,
+      "used": 3
+    }
+  }
+}`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/ssl/certificate_packs/quota", handler)
+
+	want := CertificatePackQuotas{
+		Advanced: CertificatePackQuota{Allocated: 10, Used: 3},
+	}
+
+	actual, err := client.CertificatePackQuotas(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCertificatePackBackupCertificates(t *testing.T) {
+	pack := CertificatePack{
+		PrimaryCertificate: 12345678,
+		Certificates: []CertificatePackCertificate{
+			{ID: 12345678, Issuer: "DigiCert", Signature: "ECDSAWithSHA256", Status: "active"},
+			{ID: 12345679, Issuer: "DigiCert", Signature: "SHA256WithRSA", Status: "active"},
+			{ID: 87654321, Issuer: "LetsEncrypt", Signature: "ECDSAWithSHA256", Status: "backup_issued"},
+		},
+	}
+
+	want := []CertificatePackCertificate{
+		{ID: 87654321, Issuer: "LetsEncrypt", Signature: "ECDSAWithSHA256", Status: "backup_issued"},
+	}
+
+	assert.Equal(t, want, pack.BackupCertificates())
+	assert.Nil(t, desiredCertificatePack.BackupCertificates())
+}
@@ -0,0 +1,169 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ClientCertificate represents a client certificate issued by the zone's
+// API Shield certificate authority.
+type ClientCertificate struct {
+	ID                   string                     `json:"id"`
+	Certificate          string                     `json:"certificate"`
+	CertificateAuthority ClientCertificateAuthority `json:"certificate_authority"`
+	CommonName           string                     `json:"common_name"`
+	Country              string                     `json:"country"`
+	CSR                  string                     `json:"csr"`
+	FingerprintSha256    string                     `json:"fingerprint_sha256"`
+	Location             string                     `json:"location"`
+	Organization         string                     `json:"organization"`
+	OrganizationalUnit   string                     `json:"organizational_unit"`
+	SerialNumber         string                     `json:"serial_number"`
+	Signature            string                     `json:"signature"`
+	SKI                  string                     `json:"ski"`
+	State                string                     `json:"state"`
+	Status               string                     `json:"status"`
+	ValidityDays         int                        `json:"validity_days"`
+	IssuedOn             *time.Time                 `json:"issued_on,omitempty"`
+	ExpiresOn            *time.Time                 `json:"expires_on,omitempty"`
+}
+
+// ClientCertificateAuthority identifies the certificate authority that
+// issued a client certificate.
+type ClientCertificateAuthority struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// ClientCertificateParams holds the CSR and validity used to issue a new
+// client certificate.
+type ClientCertificateParams struct {
+	CSR          string `json:"csr"`
+	ValidityDays int    `json:"validity_days"`
+}
+
+// ClientCertificatesListParams holds the filters used when listing client
+// certificates.
+type ClientCertificatesListParams struct {
+	Status string
+	PaginationOptions
+}
+
+// Encode encodes the client certificate list parameters into a query string.
+func (p ClientCertificatesListParams) Encode() string {
+	v := url.Values{}
+
+	if p.Status != "" {
+		v.Set("status", p.Status)
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// ClientCertificatesResponse is the API response, containing a list of
+// client certificates.
+type ClientCertificatesResponse struct {
+	Response
+	Result     []ClientCertificate `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// ClientCertificateResponse is the API response, containing a single client
+// certificate.
+type ClientCertificateResponse struct {
+	Response
+	Result ClientCertificate `json:"result"`
+}
+
+// ListClientCertificates returns the client certificates issued for a zone.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-list-client-certificates
+func (api *API) ListClientCertificates(ctx context.Context, zoneID string, params ClientCertificatesListParams) ([]ClientCertificate, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/client_certificates", zoneID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []ClientCertificate{}, ResultInfo{}, err
+	}
+
+	var certificatesResponse ClientCertificatesResponse
+	err = json.Unmarshal(res, &certificatesResponse)
+	if err != nil {
+		return []ClientCertificate{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return certificatesResponse.Result, certificatesResponse.ResultInfo, nil
+}
+
+// ClientCertificate returns a single client certificate.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-client-certificate-details
+func (api *API) ClientCertificate(ctx context.Context, zoneID, certificateID string) (ClientCertificate, error) {
+	uri := fmt.Sprintf("/zones/%s/client_certificates/%s", zoneID, certificateID)
+
+	return api.clientCertificateRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateClientCertificate issues a new client certificate from a CSR.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-create-client-certificate
+func (api *API) CreateClientCertificate(ctx context.Context, zoneID string, params ClientCertificateParams) (ClientCertificate, error) {
+	if params.CSR == "" {
+		return ClientCertificate{}, errors.Errorf("CSR cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/client_certificates", zoneID)
+
+	return api.clientCertificateRequest(ctx, http.MethodPost, uri, params)
+}
+
+// RevokeClientCertificate revokes a client certificate. Revoked
+// certificates can be reactivated with ReactivateClientCertificate.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-revoke-client-certificate
+func (api *API) RevokeClientCertificate(ctx context.Context, zoneID, certificateID string) (ClientCertificate, error) {
+	uri := fmt.Sprintf("/zones/%s/client_certificates/%s", zoneID, certificateID)
+
+	return api.clientCertificateRequest(ctx, http.MethodDelete, uri, nil)
+}
+
+// ReactivateClientCertificate reactivates a previously revoked client
+// certificate.
+//
+// API reference: https://api.cloudflare.com/#client-certificate-for-a-zone-reactivate-client-certificate
+func (api *API) ReactivateClientCertificate(ctx context.Context, zoneID, certificateID string) (ClientCertificate, error) {
+	uri := fmt.Sprintf("/zones/%s/client_certificates/%s", zoneID, certificateID)
+
+	return api.clientCertificateRequest(ctx, http.MethodPatch, uri, nil)
+}
+
+func (api *API) clientCertificateRequest(ctx context.Context, method, uri string, params interface{}) (ClientCertificate, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return ClientCertificate{}, err
+	}
+
+	var certificateResponse ClientCertificateResponse
+	err = json.Unmarshal(res, &certificateResponse)
+	if err != nil {
+		return ClientCertificate{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return certificateResponse.Result, nil
+}
@@ -0,0 +1,188 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testClientCertificateID = "023e105f4ecef8ad9ca31a8372d0c353"
+
+func testClientCertificateJSON(status string) string {
+	return fmt.Sprintf(`{
+		"id": "%s",
+		"certificate": "-----BEGIN CERTIFICATE-----",
+		"certificate_authority": {
+			"id": "568b6b74-7b0c-4755-8840-4e3b8c24adeb",
+			"name": "Cloudflare Managed CA for account"
+		},
+		"common_name": "Cloudflare",
+		"country": "US",
+		"csr": "-----BEGIN CERTIFICATE REQUEST-----",
+		"expires_on": "2033-02-20T23:18:00Z",
+		"fingerprint_sha256": "256c24690243359fb8cf139a125bd05ebf1d968b71e4caf330718e9f5c8a89ea",
+		"issued_on": "2023-02-23T23:18:00Z",
+		"location": "Somewhere",
+		"organization": "Organization",
+		"organizational_unit": "Organizational Unit",
+		"serial_number": "3bb94ff144ac567b9f75ad664b6c55f8d5e48182",
+		"signature": "SHA256WithRSA",
+		"ski": "8e375af1389a069a0f921f8cc8e1eb12d784b949",
+		"state": "CA",
+		"status": "%s",
+		"validity_days": 3650
+	}`, testClientCertificateID, status)
+}
+
+func testClientCertificate(status string) ClientCertificate {
+	issuedOn, _ := time.Parse(time.RFC3339, "2023-02-23T23:18:00Z")
+	expiresOn, _ := time.Parse(time.RFC3339, "2033-02-20T23:18:00Z")
+
+	return ClientCertificate{
+		ID:          testClientCertificateID,
+		Certificate: "-----BEGIN CERTIFICATE-----",
+		CertificateAuthority: ClientCertificateAuthority{
+			ID:   "568b6b74-7b0c-4755-8840-4e3b8c24adeb",
+			Name: "Cloudflare Managed CA for account",
+		},
+		CommonName:         "Cloudflare",
+		Country:            "US",
+		CSR:                "-----BEGIN CERTIFICATE REQUEST-----",
+		FingerprintSha256:  "256c24690243359fb8cf139a125bd05ebf1d968b71e4caf330718e9f5c8a89ea",
+		Location:           "Somewhere",
+		Organization:       "Organization",
+		OrganizationalUnit: "Organizational Unit",
+		SerialNumber:       "3bb94ff144ac567b9f75ad664b6c55f8d5e48182",
+		Signature:          "SHA256WithRSA",
+		SKI:                "8e375af1389a069a0f921f8cc8e1eb12d784b949",
+		State:              "CA",
+		Status:             status,
+		ValidityDays:       3650,
+		IssuedOn:           &issuedOn,
+		ExpiresOn:          &expiresOn,
+	}
+}
+
+func TestListClientCertificates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "active", r.URL.Query().Get("status"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testClientCertificateJSON("active"))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates", handler)
+
+	want := []ClientCertificate{testClientCertificate("active")}
+
+	actual, _, err := client.ListClientCertificates(context.Background(), testZoneID, ClientCertificatesListParams{Status: "active"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateClientCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"csr":"-----BEGIN CERTIFICATE REQUEST-----","validity_days":3650}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": %s
+		}
+		`, testClientCertificateJSON("pending_issuance"))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates", handler)
+
+	actual, err := client.CreateClientCertificate(context.Background(), testZoneID, ClientCertificateParams{
+		CSR:          "-----BEGIN CERTIFICATE REQUEST-----",
+		ValidityDays: 3650,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testClientCertificate("pending_issuance"), actual)
+	}
+
+	_, err = client.CreateClientCertificate(context.Background(), testZoneID, ClientCertificateParams{})
+	assert.EqualError(t, err, "CSR cannot be empty")
+}
+
+func TestRevokeClientCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": %s
+		}
+		`, testClientCertificateJSON("pending_revocation"))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates/"+testClientCertificateID, handler)
+
+	actual, err := client.RevokeClientCertificate(context.Background(), testZoneID, testClientCertificateID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testClientCertificate("pending_revocation"), actual)
+	}
+}
+
+func TestReactivateClientCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": %s
+		}
+		`, testClientCertificateJSON("pending_reactivation"))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/client_certificates/"+testClientCertificateID, handler)
+
+	actual, err := client.ReactivateClientCertificate(context.Background(), testZoneID, testClientCertificateID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testClientCertificate("pending_reactivation"), actual)
+	}
+}
@@ -195,6 +195,10 @@ func main() {
 							Name:  "tags",
 							Usage: "the cache tags to purge (Enterprise only)",
 						},
+						&cli.StringSliceFlag{
+							Name:  "prefixes",
+							Usage: "a list of URL prefixes to purge (Enterprise only)",
+						},
 						&cli.StringSliceFlag{
 							Name:  "files",
 							Usage: "a list of [exact] URLs to purge",
@@ -0,0 +1,60 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// CertificateTransparencyAlerting represents the Certificate Transparency
+// monitoring settings of a zone.
+type CertificateTransparencyAlerting struct {
+	Enabled *bool    `json:"enabled,omitempty"`
+	Emails  []string `json:"emails,omitempty"`
+}
+
+// CertificateTransparencyAlertingResponse is the API response, containing
+// the Certificate Transparency monitoring settings of a zone.
+type CertificateTransparencyAlertingResponse struct {
+	Response
+	Result CertificateTransparencyAlerting `json:"result"`
+}
+
+// CertificateTransparencyAlerting returns the Certificate Transparency
+// monitoring settings of a zone.
+//
+// API reference: https://api.cloudflare.com/#certificate-transparency-monitoring-get-ct-alerting
+func (api *API) CertificateTransparencyAlerting(ctx context.Context, zoneID string) (CertificateTransparencyAlerting, error) {
+	uri := fmt.Sprintf("/zones/%s/ct/alerting", zoneID)
+
+	return api.certificateTransparencyAlertingRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateCertificateTransparencyAlerting enables or disables Certificate
+// Transparency monitoring for a zone and sets the addresses alerted when a
+// new certificate is issued.
+//
+// API reference: https://api.cloudflare.com/#certificate-transparency-monitoring-update-ct-alerting
+func (api *API) UpdateCertificateTransparencyAlerting(ctx context.Context, zoneID string, settings CertificateTransparencyAlerting) (CertificateTransparencyAlerting, error) {
+	uri := fmt.Sprintf("/zones/%s/ct/alerting", zoneID)
+
+	return api.certificateTransparencyAlertingRequest(ctx, http.MethodPatch, uri, settings)
+}
+
+func (api *API) certificateTransparencyAlertingRequest(ctx context.Context, method, uri string, params interface{}) (CertificateTransparencyAlerting, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return CertificateTransparencyAlerting{}, err
+	}
+
+	var alertingResponse CertificateTransparencyAlertingResponse
+	err = json.Unmarshal(res, &alertingResponse)
+	if err != nil {
+		return CertificateTransparencyAlerting{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return alertingResponse.Result, nil
+}
@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCertificateTransparencyAlerting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"enabled": true,
+				"emails": ["security@example.com"]
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ct/alerting", handler)
+
+	enabled := true
+	want := CertificateTransparencyAlerting{
+		Enabled: &enabled,
+		Emails:  []string{"security@example.com"},
+	}
+
+	actual, err := client.CertificateTransparencyAlerting(context.Background(), testZoneID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateCertificateTransparencyAlerting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"enabled":false}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"enabled": false,
+				"emails": ["security@example.com"]
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ct/alerting", handler)
+
+	enabled := false
+	want := CertificateTransparencyAlerting{
+		Enabled: &enabled,
+		Emails:  []string{"security@example.com"},
+	}
+
+	actual, err := client.UpdateCertificateTransparencyAlerting(context.Background(), testZoneID, CertificateTransparencyAlerting{Enabled: &enabled})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
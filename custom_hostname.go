@@ -41,6 +41,18 @@ type CustomHostnameOwnershipVerification struct {
 	Value string `json:"value,omitempty"`
 }
 
+// CustomHostnameSSLValidationRecord represents a record that must be published
+// to validate the SSL certificate of a custom hostname.
+type CustomHostnameSSLValidationRecord struct {
+	TxtName     string   `json:"txt_name,omitempty"`
+	TxtValue    string   `json:"txt_value,omitempty"`
+	HTTPUrl     string   `json:"http_url,omitempty"`
+	HTTPBody    string   `json:"http_body,omitempty"`
+	CnameName   string   `json:"cname,omitempty"`
+	CnameTarget string   `json:"cname_target,omitempty"`
+	Emails      []string `json:"emails,omitempty"`
+}
+
 //CustomHostnameSSLValidationErrors represents errors that occurred during SSL validation.
 type CustomHostnameSSLValidationErrors struct {
 	Message string `json:"message,omitempty"`
@@ -61,6 +73,8 @@ type CustomHostnameSSL struct {
 	Issuer               string                              `json:"issuer,omitempty"`
 	SerialNumber         string                              `json:"serial_number,omitempty"`
 	Settings             CustomHostnameSSLSettings           `json:"settings,omitempty"`
+	BundleMethod         string                              `json:"bundle_method,omitempty"`
+	ValidationRecords    []CustomHostnameSSLValidationRecord `json:"validation_records,omitempty"`
 	ValidationErrors     []CustomHostnameSSLValidationErrors `json:"validation_errors,omitempty"`
 	HTTPUrl              string                              `json:"http_url,omitempty"`
 	HTTPBody             string                              `json:"http_body,omitempty"`
@@ -74,6 +88,7 @@ type CustomHostname struct {
 	ID                        string                                  `json:"id,omitempty"`
 	Hostname                  string                                  `json:"hostname,omitempty"`
 	CustomOriginServer        string                                  `json:"custom_origin_server,omitempty"`
+	CustomOriginSNI           string                                  `json:"custom_origin_sni,omitempty"`
 	SSL                       CustomHostnameSSL                       `json:"ssl,omitempty"`
 	CustomMetadata            CustomMetadata                          `json:"custom_metadata,omitempty"`
 	Status                    CustomHostnameStatus                    `json:"status,omitempty"`
@@ -174,6 +174,89 @@ func TestCustomHostname_CreateCustomHostname_CustomOrigin(t *testing.T) {
 	}
 }
 
+func TestCustomHostname_CreateCustomHostname_CustomOriginSNIAndValidationRecords(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/foo/custom_hostnames", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `
+{
+	"success": true,
+	"errors": [],
+	"messages": [],
+	"result": {
+		"id": "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+		"hostname": "app.example.com",
+		"custom_origin_server": "example.app.com",
+		"custom_origin_sni": "sni.example.app.com",
+		"ssl": {
+			"status": "pending_validation",
+			"method": "txt",
+			"type": "dv",
+			"bundle_method": "ubiquitous",
+			"wildcard": false,
+			"validation_records": [
+				{
+					"txt_name": "_acme-challenge.app.example.com",
+					"txt_value": "810b7d5f01154524b961ba0cd578acc2"
+				}
+			],
+			"settings": {
+				"min_tls_version": "1.2"
+			}
+		}
+	}
+}`)
+	})
+
+	wildcard := false
+	response, err := client.CreateCustomHostname(context.Background(), "foo", CustomHostname{
+		Hostname:           "app.example.com",
+		CustomOriginServer: "example.app.com",
+		CustomOriginSNI:    "sni.example.app.com",
+		SSL: CustomHostnameSSL{
+			Method:   "txt",
+			Type:     "dv",
+			Wildcard: &wildcard,
+			Settings: CustomHostnameSSLSettings{MinTLSVersion: "1.2"},
+		},
+	})
+
+	want := &CustomHostnameResponse{
+		Result: CustomHostname{
+			ID:                 "0d89c70d-ad9f-4843-b99f-6cc0252067e9",
+			Hostname:           "app.example.com",
+			CustomOriginServer: "example.app.com",
+			CustomOriginSNI:    "sni.example.app.com",
+			SSL: CustomHostnameSSL{
+				Type:         "dv",
+				Method:       "txt",
+				Status:       "pending_validation",
+				BundleMethod: "ubiquitous",
+				Wildcard:     &wildcard,
+				ValidationRecords: []CustomHostnameSSLValidationRecord{
+					{
+						TxtName:  "_acme-challenge.app.example.com",
+						TxtValue: "810b7d5f01154524b961ba0cd578acc2",
+					},
+				},
+				Settings: CustomHostnameSSLSettings{
+					MinTLSVersion: "1.2",
+				},
+			},
+		},
+		Response: Response{Success: true, Errors: []ResponseInfo{}, Messages: []ResponseInfo{}},
+	}
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, response)
+	}
+}
+
 func TestCustomHostname_CustomHostnames(t *testing.T) {
 	setup()
 	defer teardown()
@@ -10,6 +10,26 @@ import (
 	"github.com/pkg/errors"
 )
 
+// Custom page IDs.
+const (
+	CustomPageIDBasicChallenge   = "basic_challenge"
+	CustomPageIDManagedChallenge = "managed_challenge"
+	CustomPageIDWAFChallenge     = "waf_challenge"
+	CustomPageIDWAFBlock         = "waf_block"
+	CustomPageIDRateLimitBlock   = "ratelimit_block"
+	CustomPageIDIPBlock          = "ip_block"
+	CustomPageIDCountryChallenge = "country_challenge"
+	CustomPageIDUnderAttack      = "under_attack"
+	CustomPageID500Errors        = "500_errors"
+	CustomPageID1000Errors       = "1000_errors"
+)
+
+// Custom page states.
+const (
+	CustomPageStateDefault    = "default"
+	CustomPageStateCustomized = "customized"
+)
+
 // CustomPage represents a custom page configuration.
 type CustomPage struct {
 	CreatedOn      time.Time   `json:"created_on"`
@@ -51,33 +71,34 @@ type CustomPageParameters struct {
 	State string      `json:"state"`
 }
 
-// CustomPages lists custom pages for a zone or account.
-//
-// Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-list-available-custom-pages
-// Account API reference: https://api.cloudflare.com/#custom-pages-account--list-custom-pages
-func (api *API) CustomPages(ctx context.Context, options *CustomPageOptions) ([]CustomPage, error) {
-	var (
-		pageType, identifier string
-	)
-
-	if options.AccountID == "" && options.ZoneID == "" {
-		return nil, errors.New("either account ID or zone ID must be provided")
+// accountOrZoneRoute returns the custom pages route of the account or zone
+// the options point at. Account level pages apply to every zone of the
+// account that does not override them.
+func (o *CustomPageOptions) accountOrZoneRoute() (string, error) {
+	if o == nil || (o.AccountID == "" && o.ZoneID == "") {
+		return "", errors.New("either account ID or zone ID must be provided")
 	}
 
-	if options.AccountID != "" && options.ZoneID != "" {
-		return nil, errors.New("account ID and zone ID are mutually exclusive")
+	if o.AccountID != "" && o.ZoneID != "" {
+		return "", errors.New("account ID and zone ID are mutually exclusive")
 	}
 
 	// Should the account ID be defined, treat this as an account level operation.
-	if options.AccountID != "" {
-		pageType = "accounts"
-		identifier = options.AccountID
-	} else {
-		pageType = "zones"
-		identifier = options.ZoneID
+	if o.AccountID != "" {
+		return fmt.Sprintf("/accounts/%s/custom_pages", o.AccountID), nil
 	}
+	return fmt.Sprintf("/zones/%s/custom_pages", o.ZoneID), nil
+}
 
-	uri := fmt.Sprintf("/%s/%s/custom_pages", pageType, identifier)
+// CustomPages lists custom pages for a zone or account.
+//
+// Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-list-available-custom-pages
+// Account API reference: https://api.cloudflare.com/#custom-pages-account--list-custom-pages
+func (api *API) CustomPages(ctx context.Context, options *CustomPageOptions) ([]CustomPage, error) {
+	uri, err := options.accountOrZoneRoute()
+	if err != nil {
+		return nil, err
+	}
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -98,28 +119,12 @@ func (api *API) CustomPages(ctx context.Context, options *CustomPageOptions) ([]
 // Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-custom-page-details
 // Account API reference: https://api.cloudflare.com/#custom-pages-account--custom-page-details
 func (api *API) CustomPage(ctx context.Context, options *CustomPageOptions, customPageID string) (CustomPage, error) {
-	var (
-		pageType, identifier string
-	)
-
-	if options.AccountID == "" && options.ZoneID == "" {
-		return CustomPage{}, errors.New("either account ID or zone ID must be provided")
-	}
-
-	if options.AccountID != "" && options.ZoneID != "" {
-		return CustomPage{}, errors.New("account ID and zone ID are mutually exclusive")
-	}
-
-	// Should the account ID be defined, treat this as an account level operation.
-	if options.AccountID != "" {
-		pageType = "accounts"
-		identifier = options.AccountID
-	} else {
-		pageType = "zones"
-		identifier = options.ZoneID
+	root, err := options.accountOrZoneRoute()
+	if err != nil {
+		return CustomPage{}, err
 	}
 
-	uri := fmt.Sprintf("/%s/%s/custom_pages/%s", pageType, identifier, customPageID)
+	uri := fmt.Sprintf("%s/%s", root, customPageID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -140,28 +145,12 @@ func (api *API) CustomPage(ctx context.Context, options *CustomPageOptions, cust
 // Zone API reference: https://api.cloudflare.com/#custom-pages-for-a-zone-update-custom-page-url
 // Account API reference: https://api.cloudflare.com/#custom-pages-account--update-custom-page
 func (api *API) UpdateCustomPage(ctx context.Context, options *CustomPageOptions, customPageID string, pageParameters CustomPageParameters) (CustomPage, error) {
-	var (
-		pageType, identifier string
-	)
-
-	if options.AccountID == "" && options.ZoneID == "" {
-		return CustomPage{}, errors.New("either account ID or zone ID must be provided")
-	}
-
-	if options.AccountID != "" && options.ZoneID != "" {
-		return CustomPage{}, errors.New("account ID and zone ID are mutually exclusive")
-	}
-
-	// Should the account ID be defined, treat this as an account level operation.
-	if options.AccountID != "" {
-		pageType = "accounts"
-		identifier = options.AccountID
-	} else {
-		pageType = "zones"
-		identifier = options.ZoneID
+	root, err := options.accountOrZoneRoute()
+	if err != nil {
+		return CustomPage{}, err
 	}
 
-	uri := fmt.Sprintf("/%s/%s/custom_pages/%s", pageType, identifier, customPageID)
+	uri := fmt.Sprintf("%s/%s", root, customPageID)
 
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, pageParameters)
 	if err != nil {
@@ -176,3 +165,17 @@ func (api *API) UpdateCustomPage(ctx context.Context, options *CustomPageOptions
 
 	return customPageResponse.Result, nil
 }
+
+// PublishCustomPage makes a custom page serve the content of pageURL, which
+// must contain the page's required tokens.
+func (api *API) PublishCustomPage(ctx context.Context, options *CustomPageOptions, customPageID, pageURL string) (CustomPage, error) {
+	if pageURL == "" {
+		return CustomPage{}, errors.New("custom page URL cannot be empty")
+	}
+	return api.UpdateCustomPage(ctx, options, customPageID, CustomPageParameters{URL: pageURL, State: CustomPageStateCustomized})
+}
+
+// ResetCustomPage reverts a custom page to the default Cloudflare page.
+func (api *API) ResetCustomPage(ctx context.Context, options *CustomPageOptions, customPageID string) (CustomPage, error) {
+	return api.UpdateCustomPage(ctx, options, customPageID, CustomPageParameters{URL: nil, State: CustomPageStateDefault})
+}
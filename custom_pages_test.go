@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -47,6 +48,11 @@ func TestCustomPagesWithoutZoneIDOrAccountID(t *testing.T) {
 	assert.EqualError(t, err, "either account ID or zone ID must be provided")
 }
 
+func TestCustomPagesWithoutOptions(t *testing.T) {
+	_, err := client.CustomPage(context.Background(), nil, CustomPageIDWAFBlock)
+	assert.EqualError(t, err, "either account ID or zone ID must be provided")
+}
+
 func TestCustomPagesWithZoneIDAndAccountID(t *testing.T) {
 	_, err := client.CustomPages(context.Background(), &CustomPageOptions{ZoneID: "abc123", AccountID: "321cba"})
 	assert.EqualError(t, err, "account ID and zone ID are mutually exclusive")
@@ -349,3 +355,86 @@ func TestUpdateCustomPagesToDefault(t *testing.T) {
 		assert.Equal(t, defaultCustomPage, actual)
 	}
 }
+
+func TestPublishCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"url": "https://mytestexample.com", "state": "customized"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `
+		{
+			"result":{
+				"id":"basic_challenge",
+				"description":"Basic challenge",
+				"required_tokens":[
+					"::CAPTCHA_BOX::"
+				],
+				"preview_target":"preview:target",
+				"created_on": "2014-01-01T05:20:00.12345Z",
+				"modified_on": "2014-01-01T05:20:00.12345Z",
+				"url":"https://mytestexample.com",
+				"state":"customized"
+			},
+			"success":true,
+			"errors":[],
+			"messages":[]
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/d992d6de698eaf2d8cf8fd53b89b18a4/custom_pages/basic_challenge", handler)
+	actual, err := client.PublishCustomPage(context.Background(), &CustomPageOptions{ZoneID: "d992d6de698eaf2d8cf8fd53b89b18a4"}, CustomPageIDBasicChallenge, "https://mytestexample.com")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, updatedCustomPage, actual)
+	}
+
+	_, err = client.PublishCustomPage(context.Background(), &CustomPageOptions{ZoneID: "d992d6de698eaf2d8cf8fd53b89b18a4"}, CustomPageIDBasicChallenge, "")
+	assert.EqualError(t, err, "custom page URL cannot be empty")
+}
+
+func TestResetCustomPage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"url": null, "state": "default"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `
+		{
+			"result":{
+				"id":"basic_challenge",
+				"description":"Basic challenge",
+				"required_tokens":[
+					"::CAPTCHA_BOX::"
+				],
+				"preview_target":"preview:target",
+				"created_on": "2014-01-01T05:20:00.12345Z",
+				"modified_on": "2014-01-01T05:20:00.12345Z",
+				"url":null,
+				"state":"default"
+			},
+			"success":true,
+			"errors":[],
+			"messages":[]
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/d992d6de698eaf2d8cf8fd53b89b18a4/custom_pages/basic_challenge", handler)
+	actual, err := client.ResetCustomPage(context.Background(), &CustomPageOptions{ZoneID: "d992d6de698eaf2d8cf8fd53b89b18a4"}, CustomPageIDBasicChallenge)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, defaultCustomPage, actual)
+	}
+}
@@ -0,0 +1,63 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// DCVDelegation represents the identifier used to delegate domain control
+// validation of a zone's hostnames to Cloudflare.
+type DCVDelegation struct {
+	UUID string `json:"uuid"`
+}
+
+// DCVDelegationRecord is the CNAME record that delegates domain control
+// validation of a hostname to Cloudflare.
+type DCVDelegationRecord struct {
+	Name   string
+	Target string
+}
+
+// DCVDelegationResponse is the API response, containing the DCV delegation
+// identifier of a zone.
+type DCVDelegationResponse struct {
+	Response
+	Result DCVDelegation `json:"result"`
+}
+
+// Record returns the CNAME record that must be published at the
+// authoritative DNS provider to delegate validation of hostname. Wildcard
+// hostnames share the record of their parent.
+func (d DCVDelegation) Record(hostname string) DCVDelegationRecord {
+	hostname = strings.TrimPrefix(hostname, "*.")
+
+	return DCVDelegationRecord{
+		Name:   fmt.Sprintf("_acme-challenge.%s", hostname),
+		Target: fmt.Sprintf("%s.%s.dcv.cloudflare.com", hostname, d.UUID),
+	}
+}
+
+// DCVDelegation returns the DCV delegation identifier of a zone.
+//
+// API reference: https://api.cloudflare.com/#dcv-delegation-retrieve-the-dcv-delegation-unique-identifier
+func (api *API) DCVDelegation(ctx context.Context, zoneID string) (DCVDelegation, error) {
+	uri := fmt.Sprintf("/zones/%s/dcv_delegation/uuid", zoneID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DCVDelegation{}, err
+	}
+
+	var delegationResponse DCVDelegationResponse
+	err = json.Unmarshal(res, &delegationResponse)
+	if err != nil {
+		return DCVDelegation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return delegationResponse.Result, nil
+}
@@ -0,0 +1,51 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDCVDelegation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"uuid": "abc123def456ghi7"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/dcv_delegation/uuid", handler)
+
+	want := DCVDelegation{UUID: "abc123def456ghi7"}
+
+	actual, err := client.DCVDelegation(context.Background(), testZoneID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDCVDelegationRecord(t *testing.T) {
+	delegation := DCVDelegation{UUID: "abc123def456ghi7"}
+
+	want := DCVDelegationRecord{
+		Name:   "_acme-challenge.example.com",
+		Target: "example.com.abc123def456ghi7.dcv.cloudflare.com",
+	}
+
+	assert.Equal(t, want, delegation.Record("example.com"))
+	assert.Equal(t, want, delegation.Record("*.example.com"))
+}
@@ -0,0 +1,59 @@
+package cloudflare
+
+import (
+	"context"
+)
+
+// DeviceEnrollmentApplication returns the Access application that controls
+// WARP device enrollment. Its policies are the device enrollment
+// permissions.
+func (api *API) DeviceEnrollmentApplication(ctx context.Context, accountID string) (AccessApplication, error) {
+	return api.accessApplicationByType(ctx, accountID, Warp)
+}
+
+// DeviceEnrollmentPermissions returns the rules that determine which users
+// may enroll a device into the organization with WARP.
+//
+// API reference: https://api.cloudflare.com/#access-policy-list-access-policies
+func (api *API) DeviceEnrollmentPermissions(ctx context.Context, accountID string) ([]AccessPolicy, error) {
+	app, err := api.DeviceEnrollmentApplication(ctx, accountID)
+	if err != nil {
+		return []AccessPolicy{}, err
+	}
+
+	var policies []AccessPolicy
+
+	pageOpts := PaginationOptions{Page: 1, PerPage: 50}
+	for {
+		p, resultInfo, err := api.AccessPolicies(ctx, accountID, app.ID, pageOpts)
+		if err != nil {
+			return []AccessPolicy{}, err
+		}
+		policies = append(policies, p...)
+
+		if len(p) == 0 || resultInfo.TotalPages <= pageOpts.Page {
+			break
+		}
+		pageOpts.Page++
+	}
+
+	return policies, nil
+}
+
+// UpdateDeviceEnrollmentPermission updates a device enrollment rule, for
+// example to restrict enrollment to members of an Access group. A new rule
+// is created when the policy has no ID.
+//
+// API reference: https://api.cloudflare.com/#access-policy-update-access-policy
+func (api *API) UpdateDeviceEnrollmentPermission(ctx context.Context, accountID string, policy AccessPolicy) (AccessPolicy, error) {
+	app, err := api.DeviceEnrollmentApplication(ctx, accountID)
+	if err != nil {
+		return AccessPolicy{}, err
+	}
+
+	if policy.ID == "" {
+		return api.CreateAccessPolicy(ctx, accountID, app.ID, policy)
+	}
+
+	return api.UpdateAccessPolicy(ctx, accountID, app.ID, policy)
+}
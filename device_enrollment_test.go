@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testWarpApplicationID = "c3f8a9c4-5a8e-4d3b-9f2a-1e7b6c5d4a3b"
+
+func warpApplicationHandler(t *testing.T) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "%s",
+					"name": "Warp Login App",
+					"domain": "example.cloudflareaccess.com/warp",
+					"type": "warp"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 50,
+				"total_pages": 1,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testWarpApplicationID)
+	}
+}
+
+func TestDeviceEnrollmentPermissions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "699d98642c564d2e855e9661899b7252",
+					"precedence": 1,
+					"decision": "allow",
+					"name": "Allow engineers",
+					"include": [{"group": {"id": "aa0a4aab-672b-4bdb-bc33-a59f1130a11f"}}],
+					"exclude": [],
+					"require": []
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 50,
+				"total_pages": 1,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", warpApplicationHandler(t))
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/"+testWarpApplicationID+"/policies", handler)
+
+	actual, err := client.DeviceEnrollmentPermissions(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Len(t, actual, 1)
+		assert.Equal(t, "699d98642c564d2e855e9661899b7252", actual[0].ID)
+		assert.Equal(t, "allow", actual[0].Decision)
+	}
+}
+
+func TestUpdateDeviceEnrollmentPermission(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "699d98642c564d2e855e9661899b7252",
+				"precedence": 1,
+				"decision": "allow",
+				"name": "Allow engineers",
+				"include": [],
+				"exclude": [],
+				"require": []
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps", warpApplicationHandler(t))
+	mux.HandleFunc("/accounts/"+testAccountID+"/access/apps/"+testWarpApplicationID+"/policies/699d98642c564d2e855e9661899b7252", handler)
+
+	actual, err := client.UpdateDeviceEnrollmentPermission(context.Background(), testAccountID, AccessPolicy{
+		ID:         "699d98642c564d2e855e9661899b7252",
+		Precedence: 1,
+		Decision:   "allow",
+		Name:       "Allow engineers",
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "699d98642c564d2e855e9661899b7252", actual.ID)
+	}
+}
@@ -0,0 +1,123 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// DeviceManagedNetwork represents a managed network. WARP clients detect
+// that they are on the network by reaching the TLS endpoint and matching its
+// certificate fingerprint.
+type DeviceManagedNetwork struct {
+	NetworkID string                      `json:"network_id,omitempty"`
+	Type      string                      `json:"type"`
+	Name      string                      `json:"name"`
+	Config    *DeviceManagedNetworkConfig `json:"config"`
+}
+
+// DeviceManagedNetworkConfig holds the TLS endpoint used to detect a managed
+// network.
+type DeviceManagedNetworkConfig struct {
+	TlsSockAddr string `json:"tls_sockaddr"`
+	Sha256      string `json:"sha256"`
+}
+
+// DeviceManagedNetworkListResponse represents the response from the list
+// device managed networks endpoint.
+type DeviceManagedNetworkListResponse struct {
+	Response
+	Result []DeviceManagedNetwork `json:"result"`
+}
+
+// DeviceManagedNetworkResponse is the API response, containing a single
+// device managed network.
+type DeviceManagedNetworkResponse struct {
+	Response
+	Result DeviceManagedNetwork `json:"result"`
+}
+
+// ListDeviceManagedNetworks returns all device managed networks within an
+// account.
+//
+// API reference: https://api.cloudflare.com/#device-managed-networks-list-device-managed-networks
+func (api *API) ListDeviceManagedNetworks(ctx context.Context, accountID string) ([]DeviceManagedNetwork, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/networks", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []DeviceManagedNetwork{}, err
+	}
+
+	var deviceManagedNetworksResponse DeviceManagedNetworkListResponse
+	err = json.Unmarshal(res, &deviceManagedNetworksResponse)
+	if err != nil {
+		return []DeviceManagedNetwork{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceManagedNetworksResponse.Result, nil
+}
+
+// DeviceManagedNetwork returns a single device managed network based on the
+// network ID.
+//
+// API reference: https://api.cloudflare.com/#device-managed-networks-device-managed-network-details
+func (api *API) DeviceManagedNetwork(ctx context.Context, accountID, networkID string) (DeviceManagedNetwork, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/networks/%s", AccountRouteRoot, accountID, networkID)
+
+	return api.deviceManagedNetworkRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateDeviceManagedNetwork creates a new device managed network.
+//
+// API reference: https://api.cloudflare.com/#device-managed-networks-create-device-managed-network
+func (api *API) CreateDeviceManagedNetwork(ctx context.Context, accountID string, network DeviceManagedNetwork) (DeviceManagedNetwork, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/networks", AccountRouteRoot, accountID)
+
+	return api.deviceManagedNetworkRequest(ctx, http.MethodPost, uri, network)
+}
+
+// UpdateDeviceManagedNetwork updates an existing device managed network.
+//
+// API reference: https://api.cloudflare.com/#device-managed-networks-update-device-managed-network
+func (api *API) UpdateDeviceManagedNetwork(ctx context.Context, accountID string, network DeviceManagedNetwork) (DeviceManagedNetwork, error) {
+	if network.NetworkID == "" {
+		return DeviceManagedNetwork{}, errors.Errorf("device managed network ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/devices/networks/%s", AccountRouteRoot, accountID, network.NetworkID)
+
+	return api.deviceManagedNetworkRequest(ctx, http.MethodPut, uri, network)
+}
+
+// DeleteDeviceManagedNetwork deletes a device managed network.
+//
+// API reference: https://api.cloudflare.com/#device-managed-networks-delete-device-managed-network
+func (api *API) DeleteDeviceManagedNetwork(ctx context.Context, accountID, networkID string) error {
+	uri := fmt.Sprintf("/%s/%s/devices/networks/%s", AccountRouteRoot, accountID, networkID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (api *API) deviceManagedNetworkRequest(ctx context.Context, method, uri string, params interface{}) (DeviceManagedNetwork, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return DeviceManagedNetwork{}, err
+	}
+
+	var deviceManagedNetworkResponse DeviceManagedNetworkResponse
+	err = json.Unmarshal(res, &deviceManagedNetworkResponse)
+	if err != nil {
+		return DeviceManagedNetwork{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceManagedNetworkResponse.Result, nil
+}
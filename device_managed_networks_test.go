@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListDeviceManagedNetworks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"network_id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+					"type": "tls",
+					"name": "managed-network-1",
+					"config": {
+						"tls_sockaddr": "foobar:1234",
+						"sha256": "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+					}
+				}
+			]
+		}
+		`)
+	}
+
+	want := []DeviceManagedNetwork{{
+		NetworkID: "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+		Type:      "tls",
+		Name:      "managed-network-1",
+		Config: &DeviceManagedNetworkConfig{
+			TlsSockAddr: "foobar:1234",
+			Sha256:      "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c",
+		},
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/networks", handler)
+
+	actual, err := client.ListDeviceManagedNetworks(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateDeviceManagedNetwork(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"network_id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+				"type": "tls",
+				"name": "managed-network-1",
+				"config": {
+					"tls_sockaddr": "foobar:1234",
+					"sha256": "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c"
+				}
+			}
+		}
+		`)
+	}
+
+	network := DeviceManagedNetwork{
+		Type: "tls",
+		Name: "managed-network-1",
+		Config: &DeviceManagedNetworkConfig{
+			TlsSockAddr: "foobar:1234",
+			Sha256:      "b5bb9d8014a0f9b1d61e21e796d78dccdf1352f23cd32812f4850b878ae4944c",
+		},
+	}
+
+	want := network
+	want.NetworkID = "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/networks", handler)
+
+	actual, err := client.CreateDeviceManagedNetwork(context.Background(), testAccountID, network)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateDeviceManagedNetworkWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateDeviceManagedNetwork(context.Background(), testAccountID, DeviceManagedNetwork{})
+	assert.EqualError(t, err, "device managed network ID cannot be empty")
+}
+
+func TestDeleteDeviceManagedNetwork(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": []
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/networks/f174e90a-fafe-4643-bbbc-4a0ed4fc8415", handler)
+
+	err := client.DeleteDeviceManagedNetwork(context.Background(), testAccountID, "f174e90a-fafe-4643-bbbc-4a0ed4fc8415")
+	assert.NoError(t, err)
+}
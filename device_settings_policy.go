@@ -0,0 +1,171 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ServiceMode represents the WARP client's mode of operation.
+type ServiceMode string
+
+// These constants represent all valid WARP service modes.
+const (
+	ServiceModeOneDotOne      ServiceMode = "1dot1"
+	ServiceModeWarp           ServiceMode = "warp"
+	ServiceModeProxy          ServiceMode = "proxy"
+	ServiceModePostureOnly    ServiceMode = "posture_only"
+	ServiceModeWarpTunnelOnly ServiceMode = "warp_tunnel_only"
+)
+
+// ServiceModeV2 configures the WARP client's mode of operation.
+type ServiceModeV2 struct {
+	Mode ServiceMode `json:"mode,omitempty"`
+	Port int         `json:"port,omitempty"`
+}
+
+// DeviceSettingsPolicy represents a WARP client settings profile. The
+// default profile applies to every device that isn't matched by a custom
+// profile's Match expression.
+type DeviceSettingsPolicy struct {
+	PolicyID            string           `json:"policy_id,omitempty"`
+	Name                string           `json:"name,omitempty"`
+	Description         string           `json:"description,omitempty"`
+	Match               string           `json:"match,omitempty"`
+	Precedence          int              `json:"precedence,omitempty"`
+	Enabled             *bool            `json:"enabled,omitempty"`
+	Default             bool             `json:"default,omitempty"`
+	ServiceModeV2       *ServiceModeV2   `json:"service_mode_v2,omitempty"`
+	DisableAutoFallback *bool            `json:"disable_auto_fallback,omitempty"`
+	CaptivePortal       int              `json:"captive_portal,omitempty"`
+	AllowModeSwitch     *bool            `json:"allow_mode_switch,omitempty"`
+	SwitchLocked        *bool            `json:"switch_locked,omitempty"`
+	AllowUpdates        *bool            `json:"allow_updates,omitempty"`
+	AutoConnect         int              `json:"auto_connect,omitempty"`
+	AllowedToLeave      *bool            `json:"allowed_to_leave,omitempty"`
+	SupportURL          string           `json:"support_url,omitempty"`
+	ExcludeOfficeIps    *bool            `json:"exclude_office_ips,omitempty"`
+	GatewayUniqueID     string           `json:"gateway_unique_id,omitempty"`
+	Include             []SplitTunnel    `json:"include,omitempty"`
+	Exclude             []SplitTunnel    `json:"exclude,omitempty"`
+	FallbackDomains     []FallbackDomain `json:"fallback_domains,omitempty"`
+}
+
+// DeviceSettingsPolicyListResponse represents the response from the list
+// device settings policies endpoint.
+type DeviceSettingsPolicyListResponse struct {
+	Response
+	Result []DeviceSettingsPolicy `json:"result"`
+}
+
+// DeviceSettingsPolicyResponse is the API response, containing a single
+// device settings policy.
+type DeviceSettingsPolicyResponse struct {
+	Response
+	Result DeviceSettingsPolicy `json:"result"`
+}
+
+// DeviceSettingsPolicies returns all custom device settings policies within
+// an account.
+//
+// API reference: https://api.cloudflare.com/#devices-list-device-settings-policies
+func (api *API) DeviceSettingsPolicies(ctx context.Context, accountID string) ([]DeviceSettingsPolicy, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policies", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []DeviceSettingsPolicy{}, err
+	}
+
+	var deviceSettingsPolicyListResponse DeviceSettingsPolicyListResponse
+	err = json.Unmarshal(res, &deviceSettingsPolicyListResponse)
+	if err != nil {
+		return []DeviceSettingsPolicy{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceSettingsPolicyListResponse.Result, nil
+}
+
+// DefaultDeviceSettingsPolicy returns the default device settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-get-default-device-settings-policy
+func (api *API) DefaultDeviceSettingsPolicy(ctx context.Context, accountID string) (DeviceSettingsPolicy, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy", AccountRouteRoot, accountID)
+
+	return api.deviceSettingsPolicyRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateDefaultDeviceSettingsPolicy updates the default device settings
+// policy.
+//
+// API reference: https://api.cloudflare.com/#devices-update-default-device-settings-policy
+func (api *API) UpdateDefaultDeviceSettingsPolicy(ctx context.Context, accountID string, policy DeviceSettingsPolicy) (DeviceSettingsPolicy, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy", AccountRouteRoot, accountID)
+
+	return api.deviceSettingsPolicyRequest(ctx, http.MethodPatch, uri, policy)
+}
+
+// DeviceSettingsPolicy returns a single custom device settings policy based
+// on the policy ID.
+//
+// API reference: https://api.cloudflare.com/#devices-get-device-settings-policy-by-id
+func (api *API) DeviceSettingsPolicy(ctx context.Context, accountID, policyID string) (DeviceSettingsPolicy, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s", AccountRouteRoot, accountID, policyID)
+
+	return api.deviceSettingsPolicyRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateDeviceSettingsPolicy creates a new custom device settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-create-device-settings-policy
+func (api *API) CreateDeviceSettingsPolicy(ctx context.Context, accountID string, policy DeviceSettingsPolicy) (DeviceSettingsPolicy, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy", AccountRouteRoot, accountID)
+
+	return api.deviceSettingsPolicyRequest(ctx, http.MethodPost, uri, policy)
+}
+
+// UpdateDeviceSettingsPolicy updates an existing custom device settings
+// policy.
+//
+// API reference: https://api.cloudflare.com/#devices-update-device-settings-policy
+func (api *API) UpdateDeviceSettingsPolicy(ctx context.Context, accountID string, policy DeviceSettingsPolicy) (DeviceSettingsPolicy, error) {
+	if policy.PolicyID == "" {
+		return DeviceSettingsPolicy{}, errors.Errorf("device settings policy ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s", AccountRouteRoot, accountID, policy.PolicyID)
+
+	return api.deviceSettingsPolicyRequest(ctx, http.MethodPatch, uri, policy)
+}
+
+// DeleteDeviceSettingsPolicy deletes a custom device settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-delete-device-settings-policy
+func (api *API) DeleteDeviceSettingsPolicy(ctx context.Context, accountID, policyID string) error {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s", AccountRouteRoot, accountID, policyID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (api *API) deviceSettingsPolicyRequest(ctx context.Context, method, uri string, params interface{}) (DeviceSettingsPolicy, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return DeviceSettingsPolicy{}, err
+	}
+
+	var deviceSettingsPolicyResponse DeviceSettingsPolicyResponse
+	err = json.Unmarshal(res, &deviceSettingsPolicyResponse)
+	if err != nil {
+		return DeviceSettingsPolicy{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceSettingsPolicyResponse.Result, nil
+}
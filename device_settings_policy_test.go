@@ -0,0 +1,130 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultDeviceSettingsPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"default": true,
+				"enabled": true,
+				"service_mode_v2": {"mode": "warp"},
+				"disable_auto_fallback": false,
+				"allow_mode_switch": false,
+				"switch_locked": false,
+				"support_url": "https://support.example.com",
+				"exclude": [{"address": "10.0.0.0/8", "description": "private"}],
+				"fallback_domains": [{"suffix": "example.internal", "dns_server": ["10.0.0.53"]}]
+			}
+		}
+		`)
+	}
+
+	enabled := true
+	disabled := false
+
+	want := DeviceSettingsPolicy{
+		Default:             true,
+		Enabled:             &enabled,
+		ServiceModeV2:       &ServiceModeV2{Mode: ServiceModeWarp},
+		DisableAutoFallback: &disabled,
+		AllowModeSwitch:     &disabled,
+		SwitchLocked:        &disabled,
+		SupportURL:          "https://support.example.com",
+		Exclude:             []SplitTunnel{{Address: "10.0.0.0/8", Description: "private"}},
+		FallbackDomains:     []FallbackDomain{{Suffix: "example.internal", DNSServer: []string{"10.0.0.53"}}},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy", handler)
+
+	actual, err := client.DefaultDeviceSettingsPolicy(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateDeviceSettingsPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"policy_id": "a842fa8a-a583-482e-9cd9-eb43362949fd",
+				"name": "engineering",
+				"match": "identity.email == \"test@example.com\"",
+				"precedence": 10,
+				"default": false
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy", handler)
+
+	policy := DeviceSettingsPolicy{
+		Name:       "engineering",
+		Match:      `identity.email == "test@example.com"`,
+		Precedence: 10,
+	}
+
+	want := policy
+	want.PolicyID = "a842fa8a-a583-482e-9cd9-eb43362949fd"
+
+	actual, err := client.CreateDeviceSettingsPolicy(context.Background(), testAccountID, policy)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateDeviceSettingsPolicyWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateDeviceSettingsPolicy(context.Background(), testAccountID, DeviceSettingsPolicy{})
+	assert.EqualError(t, err, "device settings policy ID cannot be empty")
+}
+
+func TestDeleteDeviceSettingsPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": []
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/a842fa8a-a583-482e-9cd9-eb43362949fd", handler)
+
+	err := client.DeleteDeviceSettingsPolicy(context.Background(), testAccountID, "a842fa8a-a583-482e-9cd9-eb43362949fd")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,124 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Device represents a WARP device enrolled in a Zero Trust organization.
+type Device struct {
+	ID               string      `json:"id"`
+	Key              string      `json:"key,omitempty"`
+	DeviceType       string      `json:"device_type,omitempty"`
+	Name             string      `json:"name,omitempty"`
+	Model            string      `json:"model,omitempty"`
+	Manufacturer     string      `json:"manufacturer,omitempty"`
+	OSVersion        string      `json:"os_version,omitempty"`
+	OSDistroName     string      `json:"os_distro_name,omitempty"`
+	OSDistroRevision string      `json:"os_distro_revision,omitempty"`
+	SerialNumber     string      `json:"serial_number,omitempty"`
+	Version          string      `json:"version,omitempty"`
+	IP               string      `json:"ip,omitempty"`
+	MacAddress       string      `json:"mac_address,omitempty"`
+	User             *DeviceUser `json:"user,omitempty"`
+	Created          *time.Time  `json:"created,omitempty"`
+	Updated          *time.Time  `json:"updated,omitempty"`
+	LastSeen         *time.Time  `json:"last_seen,omitempty"`
+	RevokedAt        *time.Time  `json:"revoked_at,omitempty"`
+	Deleted          bool        `json:"deleted,omitempty"`
+}
+
+// DeviceUser represents the user a device is registered to.
+type DeviceUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Name  string `json:"name"`
+}
+
+// DeviceListResponse represents the response from the list devices
+// endpoint.
+type DeviceListResponse struct {
+	Result []Device `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// DeviceDetailResponse is the API response, containing a single device.
+type DeviceDetailResponse struct {
+	Response
+	Result Device `json:"result"`
+}
+
+// Devices returns all devices enrolled within an account.
+//
+// API reference: https://api.cloudflare.com/#devices-list-devices
+func (api *API) Devices(ctx context.Context, accountID string) ([]Device, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/devices", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Device{}, ResultInfo{}, err
+	}
+
+	var deviceListResponse DeviceListResponse
+	err = json.Unmarshal(res, &deviceListResponse)
+	if err != nil {
+		return []Device{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceListResponse.Result, deviceListResponse.ResultInfo, nil
+}
+
+// Device returns a single enrolled device based on the device ID.
+//
+// API reference: https://api.cloudflare.com/#devices-device-details
+func (api *API) Device(ctx context.Context, accountID, deviceID string) (Device, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/%s", AccountRouteRoot, accountID, deviceID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Device{}, err
+	}
+
+	var deviceDetailResponse DeviceDetailResponse
+	err = json.Unmarshal(res, &deviceDetailResponse)
+	if err != nil {
+		return Device{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return deviceDetailResponse.Result, nil
+}
+
+// RevokeDevices revokes the given devices, signing them out of WARP and
+// preventing them from reconnecting until they are unrevoked.
+//
+// API reference: https://api.cloudflare.com/#devices-revoke-devices
+func (api *API) RevokeDevices(ctx context.Context, accountID string, deviceIDs []string) error {
+	uri := fmt.Sprintf("/%s/%s/devices/revoke", AccountRouteRoot, accountID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, deviceIDs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// UnrevokeDevices restores access for previously revoked devices.
+//
+// API reference: https://api.cloudflare.com/#devices-unrevoke-devices
+func (api *API) UnrevokeDevices(ctx context.Context, accountID string, deviceIDs []string) error {
+	uri := fmt.Sprintf("/%s/%s/devices/unrevoke", AccountRouteRoot, accountID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, deviceIDs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+					"key": "yek0SUYoOQ10vMGsIYEevozXUQpQtPMrAzmmfBkFfdk=",
+					"device_type": "windows",
+					"name": "My mobile device",
+					"model": "MyPhone(pro-X)",
+					"os_version": "10.0.0",
+					"version": "1.0.0",
+					"ip": "192.0.2.1",
+					"user": {
+						"id": "f3b12456-80dd-4e89-9f5f-ba3dfff12365",
+						"email": "user@example.com",
+						"name": "John Appleseed"
+					},
+					"created": "2017-06-14T00:00:00Z",
+					"updated": "2017-06-14T00:00:00Z",
+					"last_seen": "2017-06-14T00:00:00Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	timestamp, _ := time.Parse(time.RFC3339, "2017-06-14T00:00:00Z")
+
+	want := []Device{{
+		ID:         "f174e90a-fafe-4643-bbbc-4a0ed4fc8415",
+		Key:        "yek0SUYoOQ10vMGsIYEevozXUQpQtPMrAzmmfBkFfdk=",
+		DeviceType: "windows",
+		Name:       "My mobile device",
+		Model:      "MyPhone(pro-X)",
+		OSVersion:  "10.0.0",
+		Version:    "1.0.0",
+		IP:         "192.0.2.1",
+		User: &DeviceUser{
+			ID:    "f3b12456-80dd-4e89-9f5f-ba3dfff12365",
+			Email: "user@example.com",
+			Name:  "John Appleseed",
+		},
+		Created:  &timestamp,
+		Updated:  &timestamp,
+		LastSeen: &timestamp,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices", handler)
+
+	actual, _, err := client.Devices(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestRevokeAndUnrevokeDevices(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `["f174e90a-fafe-4643-bbbc-4a0ed4fc8415"]`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": null
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/revoke", handler)
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/unrevoke", handler)
+
+	err := client.RevokeDevices(context.Background(), testAccountID, []string{"f174e90a-fafe-4643-bbbc-4a0ed4fc8415"})
+	assert.NoError(t, err)
+
+	err = client.UnrevokeDevices(context.Background(), testAccountID, []string{"f174e90a-fafe-4643-bbbc-4a0ed4fc8415"})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,352 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DEXTestKind represents the kind of synthetic test run by WARP clients.
+type DEXTestKind string
+
+// These constants represent all valid Digital Experience Monitoring test
+// kinds.
+const (
+	DEXTestKindHTTP       DEXTestKind = "http"
+	DEXTestKindTraceroute DEXTestKind = "traceroute"
+)
+
+// DEXTest represents a Digital Experience Monitoring test configuration.
+type DEXTest struct {
+	TestID      string      `json:"test_id,omitempty"`
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Interval    string      `json:"interval"`
+	Enabled     bool        `json:"enabled"`
+	Data        DEXTestData `json:"data"`
+	Updated     *time.Time  `json:"updated,omitempty"`
+	Created     *time.Time  `json:"created,omitempty"`
+}
+
+// DEXTestData holds the target of a Digital Experience Monitoring test.
+type DEXTestData struct {
+	Kind   DEXTestKind `json:"kind"`
+	Host   string      `json:"host"`
+	Method string      `json:"method,omitempty"`
+}
+
+// DEXTestListResponse represents the response from the list DEX tests
+// endpoint.
+type DEXTestListResponse struct {
+	Response
+	Result struct {
+		DEXTests []DEXTest `json:"dex_tests"`
+	} `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// DEXTestDetailResponse is the API response, containing a single DEX test.
+type DEXTestDetailResponse struct {
+	Response
+	Result DEXTest `json:"result"`
+}
+
+// DEXFleetStatus represents the live status of the devices in a fleet.
+type DEXFleetStatus struct {
+	DeviceStats DEXDeviceStats `json:"deviceStats"`
+}
+
+// DEXDeviceStats holds counts of unique devices broken down by a number of
+// dimensions.
+type DEXDeviceStats struct {
+	UniqueDevicesTotal int                  `json:"uniqueDevicesTotal"`
+	ByStatus           []DEXDeviceBreakdown `json:"byStatus"`
+	ByColo             []DEXDeviceBreakdown `json:"byColo"`
+	ByMode             []DEXDeviceBreakdown `json:"byMode"`
+	ByPlatform         []DEXDeviceBreakdown `json:"byPlatform"`
+	ByVersion          []DEXDeviceBreakdown `json:"byVersion"`
+}
+
+// DEXDeviceBreakdown is the number of unique devices sharing a value.
+type DEXDeviceBreakdown struct {
+	Value              string `json:"value"`
+	UniqueDevicesTotal int    `json:"uniqueDevicesTotal"`
+}
+
+// DEXFleetStatusResponse is the API response, containing the fleet status.
+type DEXFleetStatusResponse struct {
+	Response
+	Result DEXFleetStatus `json:"result"`
+}
+
+// DEXTestResultParams holds the time range and filters used when fetching
+// the results of a DEX test.
+type DEXTestResultParams struct {
+	From      time.Time
+	To        time.Time
+	Interval  string
+	DeviceIDs []string
+	Colo      string
+}
+
+// Encode encodes the DEX test result parameters into a query string.
+func (p DEXTestResultParams) Encode() string {
+	v := url.Values{}
+
+	if !p.From.IsZero() {
+		v.Set("from", p.From.Format(time.RFC3339))
+	}
+	if !p.To.IsZero() {
+		v.Set("to", p.To.Format(time.RFC3339))
+	}
+	if p.Interval != "" {
+		v.Set("interval", p.Interval)
+	}
+	for _, id := range p.DeviceIDs {
+		v.Add("deviceId", id)
+	}
+	if p.Colo != "" {
+		v.Set("colo", p.Colo)
+	}
+
+	return v.Encode()
+}
+
+// DEXTimePeriod describes the window a result slot covers.
+type DEXTimePeriod struct {
+	Value int    `json:"value"`
+	Units string `json:"units"`
+}
+
+// DEXTimingSlot is a single aggregated measurement within a time series.
+type DEXTimingSlot struct {
+	TimePeriod DEXTimePeriod `json:"timePeriod"`
+	AvgMs      *int          `json:"avgMs"`
+}
+
+// DEXTimingAggregate summarises a timing measurement over the requested
+// range.
+type DEXTimingAggregate struct {
+	AvgMs   *int            `json:"avgMs"`
+	History []DEXTimingSlot `json:"history"`
+}
+
+// DEXHTTPStatusCodeSlot holds the number of responses of each status class
+// seen within a time slot.
+type DEXHTTPStatusCodeSlot struct {
+	TimePeriod DEXTimePeriod `json:"timePeriod"`
+	Status200  int           `json:"status200"`
+	Status300  int           `json:"status300"`
+	Status400  int           `json:"status400"`
+	Status500  int           `json:"status500"`
+}
+
+// DEXHTTPTestStats holds the aggregated results of an HTTP test.
+type DEXHTTPTestStats struct {
+	UniqueDevicesTotal   int                     `json:"uniqueDevicesTotal"`
+	ResourceFetchTimeMs  DEXTimingAggregate      `json:"resourceFetchTimeMs"`
+	ServerResponseTimeMs DEXTimingAggregate      `json:"serverResponseTimeMs"`
+	DNSResponseTimeMs    DEXTimingAggregate      `json:"dnsResponseTimeMs"`
+	HTTPStatusCode       []DEXHTTPStatusCodeSlot `json:"httpStatusCode"`
+}
+
+// DEXHTTPTestResult represents the results of an HTTP test.
+type DEXHTTPTestResult struct {
+	Name      string            `json:"name"`
+	Kind      DEXTestKind       `json:"kind"`
+	Host      string            `json:"host"`
+	Method    string            `json:"method"`
+	Interval  string            `json:"interval"`
+	HTTPStats *DEXHTTPTestStats `json:"httpStats"`
+}
+
+// DEXHTTPTestResultResponse is the API response, containing the results of
+// an HTTP test.
+type DEXHTTPTestResultResponse struct {
+	Response
+	Result DEXHTTPTestResult `json:"result"`
+}
+
+// DEXTracerouteTestStats holds the aggregated results of a traceroute test.
+type DEXTracerouteTestStats struct {
+	UniqueDevicesTotal int                `json:"uniqueDevicesTotal"`
+	RoundTripTimeMs    DEXTimingAggregate `json:"roundTripTimeMs"`
+	HopsCount          DEXTimingAggregate `json:"hopsCount"`
+	PacketLossPct      DEXTimingAggregate `json:"packetLossPct"`
+}
+
+// DEXTracerouteTestResult represents the results of a traceroute test.
+type DEXTracerouteTestResult struct {
+	Name            string                  `json:"name"`
+	Kind            DEXTestKind             `json:"kind"`
+	Host            string                  `json:"host"`
+	Interval        string                  `json:"interval"`
+	TracerouteStats *DEXTracerouteTestStats `json:"tracerouteStats"`
+}
+
+// DEXTracerouteTestResultResponse is the API response, containing the
+// results of a traceroute test.
+type DEXTracerouteTestResultResponse struct {
+	Response
+	Result DEXTracerouteTestResult `json:"result"`
+}
+
+// DEXTests returns all Digital Experience Monitoring tests within an
+// account.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-details
+func (api *API) DEXTests(ctx context.Context, accountID string) ([]DEXTest, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/dex/devices/dex_tests", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []DEXTest{}, ResultInfo{}, err
+	}
+
+	var dexTestListResponse DEXTestListResponse
+	err = json.Unmarshal(res, &dexTestListResponse)
+	if err != nil {
+		return []DEXTest{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return dexTestListResponse.Result.DEXTests, dexTestListResponse.ResultInfo, nil
+}
+
+// DEXTest returns a single Digital Experience Monitoring test based on the
+// test ID.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-get-device-dex-test
+func (api *API) DEXTest(ctx context.Context, accountID, testID string) (DEXTest, error) {
+	uri := fmt.Sprintf("/%s/%s/dex/devices/dex_tests/%s", AccountRouteRoot, accountID, testID)
+
+	return api.dexTestRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateDEXTest creates a new Digital Experience Monitoring test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-create-device-dex-test
+func (api *API) CreateDEXTest(ctx context.Context, accountID string, test DEXTest) (DEXTest, error) {
+	uri := fmt.Sprintf("/%s/%s/dex/devices/dex_tests", AccountRouteRoot, accountID)
+
+	return api.dexTestRequest(ctx, http.MethodPost, uri, test)
+}
+
+// UpdateDEXTest updates an existing Digital Experience Monitoring test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-update-device-dex-test
+func (api *API) UpdateDEXTest(ctx context.Context, accountID string, test DEXTest) (DEXTest, error) {
+	if test.TestID == "" {
+		return DEXTest{}, errors.Errorf("DEX test ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/dex/devices/dex_tests/%s", AccountRouteRoot, accountID, test.TestID)
+
+	return api.dexTestRequest(ctx, http.MethodPut, uri, test)
+}
+
+// DeleteDEXTest deletes a Digital Experience Monitoring test.
+//
+// API reference: https://api.cloudflare.com/#device-dex-test-delete-device-dex-test
+func (api *API) DeleteDEXTest(ctx context.Context, accountID, testID string) error {
+	uri := fmt.Sprintf("/%s/%s/dex/devices/dex_tests/%s", AccountRouteRoot, accountID, testID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (api *API) dexTestRequest(ctx context.Context, method, uri string, params interface{}) (DEXTest, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return DEXTest{}, err
+	}
+
+	var dexTestDetailResponse DEXTestDetailResponse
+	err = json.Unmarshal(res, &dexTestDetailResponse)
+	if err != nil {
+		return DEXTest{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return dexTestDetailResponse.Result, nil
+}
+
+// DEXFleetStatusLive returns the status of devices seen within the last
+// sinceMinutes minutes.
+//
+// API reference: https://api.cloudflare.com/#dex-fleet-status-get-live-fleet-status
+func (api *API) DEXFleetStatusLive(ctx context.Context, accountID string, sinceMinutes int) (DEXFleetStatus, error) {
+	v := url.Values{}
+	v.Set("since_minutes", strconv.Itoa(sinceMinutes))
+
+	uri := fmt.Sprintf("/%s/%s/dex/fleet-status/live?%s", AccountRouteRoot, accountID, v.Encode())
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DEXFleetStatus{}, err
+	}
+
+	var fleetStatusResponse DEXFleetStatusResponse
+	err = json.Unmarshal(res, &fleetStatusResponse)
+	if err != nil {
+		return DEXFleetStatus{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return fleetStatusResponse.Result, nil
+}
+
+// DEXHTTPTestResults returns the aggregated results of an HTTP test over
+// the requested time range.
+//
+// API reference: https://api.cloudflare.com/#dex-synthetic-application-monitor-get-details-and-aggregate-metrics-for-an-http-test
+func (api *API) DEXHTTPTestResults(ctx context.Context, accountID, testID string, params DEXTestResultParams) (DEXHTTPTestResult, error) {
+	uri := fmt.Sprintf("/%s/%s/dex/http-tests/%s", AccountRouteRoot, accountID, testID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DEXHTTPTestResult{}, err
+	}
+
+	var httpTestResultResponse DEXHTTPTestResultResponse
+	err = json.Unmarshal(res, &httpTestResultResponse)
+	if err != nil {
+		return DEXHTTPTestResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return httpTestResultResponse.Result, nil
+}
+
+// DEXTracerouteTestResults returns the aggregated results of a traceroute
+// test over the requested time range.
+//
+// API reference: https://api.cloudflare.com/#dex-synthetic-application-monitor-get-details-and-aggregate-metrics-for-a-traceroute-test
+func (api *API) DEXTracerouteTestResults(ctx context.Context, accountID, testID string, params DEXTestResultParams) (DEXTracerouteTestResult, error) {
+	uri := fmt.Sprintf("/%s/%s/dex/traceroute-tests/%s", AccountRouteRoot, accountID, testID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return DEXTracerouteTestResult{}, err
+	}
+
+	var tracerouteTestResultResponse DEXTracerouteTestResultResponse
+	err = json.Unmarshal(res, &tracerouteTestResultResponse)
+	if err != nil {
+		return DEXTracerouteTestResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tracerouteTestResultResponse.Result, nil
+}
@@ -0,0 +1,335 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testDEXTestID = "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"
+
+func TestDEXTests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"dex_tests": [
+					{
+						"test_id": "%s",
+						"name": "HTTP dash health check",
+						"description": "Checks the dash endpoint every 30 minutes",
+						"interval": "0h30m0s",
+						"enabled": true,
+						"data": {
+							"kind": "http",
+							"host": "https://dash.cloudflare.com",
+							"method": "GET"
+						}
+					}
+				]
+			},
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testDEXTestID)
+	}
+
+	want := []DEXTest{{
+		TestID:      testDEXTestID,
+		Name:        "HTTP dash health check",
+		Description: "Checks the dash endpoint every 30 minutes",
+		Interval:    "0h30m0s",
+		Enabled:     true,
+		Data: DEXTestData{
+			Kind:   DEXTestKindHTTP,
+			Host:   "https://dash.cloudflare.com",
+			Method: "GET",
+		},
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/devices/dex_tests", handler)
+
+	actual, _, err := client.DEXTests(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateDEXTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"test_id": "%s",
+				"name": "Traceroute to origin",
+				"interval": "0h30m0s",
+				"enabled": true,
+				"data": {
+					"kind": "traceroute",
+					"host": "1.1.1.1"
+				}
+			}
+		}
+		`, testDEXTestID)
+	}
+
+	test := DEXTest{
+		Name:     "Traceroute to origin",
+		Interval: "0h30m0s",
+		Enabled:  true,
+		Data: DEXTestData{
+			Kind: DEXTestKindTraceroute,
+			Host: "1.1.1.1",
+		},
+	}
+
+	want := test
+	want.TestID = testDEXTestID
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/devices/dex_tests", handler)
+
+	actual, err := client.CreateDEXTest(context.Background(), testAccountID, test)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateDEXTestWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateDEXTest(context.Background(), testAccountID, DEXTest{})
+	assert.EqualError(t, err, "DEX test ID cannot be empty")
+}
+
+func TestDeleteDEXTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/devices/dex_tests/"+testDEXTestID, handler)
+
+	err := client.DeleteDEXTest(context.Background(), testAccountID, testDEXTestID)
+	assert.NoError(t, err)
+}
+
+func TestDEXFleetStatusLive(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "10", r.URL.Query().Get("since_minutes"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"deviceStats": {
+					"uniqueDevicesTotal": 3,
+					"byStatus": [
+						{"value": "connected", "uniqueDevicesTotal": 2},
+						{"value": "disconnected", "uniqueDevicesTotal": 1}
+					],
+					"byPlatform": [
+						{"value": "macos", "uniqueDevicesTotal": 3}
+					]
+				}
+			}
+		}
+		`)
+	}
+
+	want := DEXFleetStatus{
+		DeviceStats: DEXDeviceStats{
+			UniqueDevicesTotal: 3,
+			ByStatus: []DEXDeviceBreakdown{
+				{Value: "connected", UniqueDevicesTotal: 2},
+				{Value: "disconnected", UniqueDevicesTotal: 1},
+			},
+			ByPlatform: []DEXDeviceBreakdown{
+				{Value: "macos", UniqueDevicesTotal: 3},
+			},
+		},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/fleet-status/live", handler)
+
+	actual, err := client.DEXFleetStatusLive(context.Background(), testAccountID, 10)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDEXHTTPTestResults(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2023-01-01T00:00:00Z", r.URL.Query().Get("from"))
+		assert.Equal(t, "2023-01-02T00:00:00Z", r.URL.Query().Get("to"))
+		assert.Equal(t, "hour", r.URL.Query().Get("interval"))
+		assert.Equal(t, []string{"device-1", "device-2"}, r.URL.Query()["deviceId"])
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "HTTP dash health check",
+				"kind": "http",
+				"host": "https://dash.cloudflare.com",
+				"method": "GET",
+				"interval": "0h30m0s",
+				"httpStats": {
+					"uniqueDevicesTotal": 2,
+					"resourceFetchTimeMs": {
+						"avgMs": 120,
+						"history": [
+							{"timePeriod": {"value": 1, "units": "hours"}, "avgMs": 120}
+						]
+					},
+					"serverResponseTimeMs": {"avgMs": 80, "history": []},
+					"dnsResponseTimeMs": {"avgMs": null, "history": []},
+					"httpStatusCode": [
+						{
+							"timePeriod": {"value": 1, "units": "hours"},
+							"status200": 4,
+							"status300": 0,
+							"status400": 0,
+							"status500": 1
+						}
+					]
+				}
+			}
+		}
+		`)
+	}
+
+	fetch, server := 120, 80
+	want := DEXHTTPTestResult{
+		Name:     "HTTP dash health check",
+		Kind:     DEXTestKindHTTP,
+		Host:     "https://dash.cloudflare.com",
+		Method:   "GET",
+		Interval: "0h30m0s",
+		HTTPStats: &DEXHTTPTestStats{
+			UniqueDevicesTotal: 2,
+			ResourceFetchTimeMs: DEXTimingAggregate{
+				AvgMs: &fetch,
+				History: []DEXTimingSlot{
+					{TimePeriod: DEXTimePeriod{Value: 1, Units: "hours"}, AvgMs: &fetch},
+				},
+			},
+			ServerResponseTimeMs: DEXTimingAggregate{AvgMs: &server, History: []DEXTimingSlot{}},
+			DNSResponseTimeMs:    DEXTimingAggregate{History: []DEXTimingSlot{}},
+			HTTPStatusCode: []DEXHTTPStatusCodeSlot{
+				{TimePeriod: DEXTimePeriod{Value: 1, Units: "hours"}, Status200: 4, Status500: 1},
+			},
+		},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/http-tests/"+testDEXTestID, handler)
+
+	from, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
+	to, _ := time.Parse(time.RFC3339, "2023-01-02T00:00:00Z")
+
+	actual, err := client.DEXHTTPTestResults(context.Background(), testAccountID, testDEXTestID, DEXTestResultParams{
+		From:      from,
+		To:        to,
+		Interval:  "hour",
+		DeviceIDs: []string{"device-1", "device-2"},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDEXTracerouteTestResults(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "Traceroute to origin",
+				"kind": "traceroute",
+				"host": "1.1.1.1",
+				"interval": "0h30m0s",
+				"tracerouteStats": {
+					"uniqueDevicesTotal": 1,
+					"roundTripTimeMs": {"avgMs": 15, "history": []},
+					"hopsCount": {"avgMs": 7, "history": []},
+					"packetLossPct": {"avgMs": 0, "history": []}
+				}
+			}
+		}
+		`)
+	}
+
+	rtt, hops, loss := 15, 7, 0
+	want := DEXTracerouteTestResult{
+		Name:     "Traceroute to origin",
+		Kind:     DEXTestKindTraceroute,
+		Host:     "1.1.1.1",
+		Interval: "0h30m0s",
+		TracerouteStats: &DEXTracerouteTestStats{
+			UniqueDevicesTotal: 1,
+			RoundTripTimeMs:    DEXTimingAggregate{AvgMs: &rtt, History: []DEXTimingSlot{}},
+			HopsCount:          DEXTimingAggregate{AvgMs: &hops, History: []DEXTimingSlot{}},
+			PacketLossPct:      DEXTimingAggregate{AvgMs: &loss, History: []DEXTimingSlot{}},
+		},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/dex/traceroute-tests/"+testDEXTestID, handler)
+
+	actual, err := client.DEXTracerouteTestResults(context.Background(), testAccountID, testDEXTestID, DEXTestResultParams{})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,80 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// FallbackDomain represents a domain whose DNS queries are sent to the
+// given DNS servers instead of through Gateway.
+type FallbackDomain struct {
+	Suffix      string   `json:"suffix,omitempty"`
+	Description string   `json:"description,omitempty"`
+	DNSServer   []string `json:"dns_server,omitempty"`
+}
+
+// FallbackDomainResponse represents the response from the fallback domain
+// endpoints.
+type FallbackDomainResponse struct {
+	Response
+	Result []FallbackDomain `json:"result"`
+}
+
+// ListFallbackDomains returns the fallback domains of the default device
+// settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-get-local-domain-fallback-list
+func (api *API) ListFallbackDomains(ctx context.Context, accountID string) ([]FallbackDomain, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/fallback_domains", AccountRouteRoot, accountID)
+
+	return api.fallbackDomainRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// ListFallbackDomainsDeviceSettingsPolicy returns the fallback domains of a
+// custom device settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-get-local-domain-fallback-list
+func (api *API) ListFallbackDomainsDeviceSettingsPolicy(ctx context.Context, accountID, policyID string) ([]FallbackDomain, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s/fallback_domains", AccountRouteRoot, accountID, policyID)
+
+	return api.fallbackDomainRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateFallbackDomain replaces the fallback domains of the default device
+// settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-set-local-domain-fallback-list
+func (api *API) UpdateFallbackDomain(ctx context.Context, accountID string, domains []FallbackDomain) ([]FallbackDomain, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/fallback_domains", AccountRouteRoot, accountID)
+
+	return api.fallbackDomainRequest(ctx, http.MethodPut, uri, domains)
+}
+
+// UpdateFallbackDomainDeviceSettingsPolicy replaces the fallback domains of a
+// custom device settings policy.
+//
+// API reference: https://api.cloudflare.com/#devices-set-local-domain-fallback-list
+func (api *API) UpdateFallbackDomainDeviceSettingsPolicy(ctx context.Context, accountID, policyID string, domains []FallbackDomain) ([]FallbackDomain, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s/fallback_domains", AccountRouteRoot, accountID, policyID)
+
+	return api.fallbackDomainRequest(ctx, http.MethodPut, uri, domains)
+}
+
+func (api *API) fallbackDomainRequest(ctx context.Context, method, uri string, params interface{}) ([]FallbackDomain, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return []FallbackDomain{}, err
+	}
+
+	var fallbackDomainResponse FallbackDomainResponse
+	err = json.Unmarshal(res, &fallbackDomainResponse)
+	if err != nil {
+		return []FallbackDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return fallbackDomainResponse.Result, nil
+}
@@ -0,0 +1,88 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListFallbackDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"suffix": "example.com",
+					"description": "Domain bypass for local development",
+					"dns_server": ["1.1.1.1"]
+				}
+			]
+		}
+		`)
+	}
+
+	want := []FallbackDomain{{
+		Suffix:      "example.com",
+		Description: "Domain bypass for local development",
+		DNSServer:   []string{"1.1.1.1"},
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/fallback_domains", handler)
+
+	actual, err := client.ListFallbackDomains(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/a842fa8a-a583-482e-9cd9-eb43362949fd/fallback_domains", handler)
+
+	actual, err = client.ListFallbackDomainsDeviceSettingsPolicy(context.Background(), testAccountID, "a842fa8a-a583-482e-9cd9-eb43362949fd")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateFallbackDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"suffix": "example.com", "dns_server": ["1.1.1.1"]},
+				{"suffix": "internal.example.com"}
+			]
+		}
+		`)
+	}
+
+	domains := []FallbackDomain{
+		{Suffix: "example.com", DNSServer: []string{"1.1.1.1"}},
+		{Suffix: "internal.example.com"},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/fallback_domains", handler)
+
+	actual, err := client.UpdateFallbackDomain(context.Background(), testAccountID, domains)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, domains, actual)
+	}
+}
@@ -80,37 +80,100 @@ func (api *API) Filter(ctx context.Context, zoneID, filterID string) (Filter, er
 	return filterResponse.Result, nil
 }
 
-// Filters returns all filters for a zone.
-//
-// API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-all-filters
-func (api *API) Filters(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]Filter, error) {
-	uri := fmt.Sprintf("/zones/%s/filters", zoneID)
+// FilterListParams holds the filters used when listing filters.
+type FilterListParams struct {
+	ID          string
+	Expression  string
+	Description string
+	Ref         string
+	Paused      *bool
+	PaginationOptions
+}
+
+// Encode encodes the filter list parameters into a query string.
+func (p FilterListParams) Encode() string {
 	v := url.Values{}
 
-	if pageOpts.PerPage > 0 {
-		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	if p.ID != "" {
+		v.Set("id", p.ID)
 	}
-
-	if pageOpts.Page > 0 {
-		v.Set("page", strconv.Itoa(pageOpts.Page))
+	if p.Expression != "" {
+		v.Set("expression", p.Expression)
+	}
+	if p.Description != "" {
+		v.Set("description", p.Description)
+	}
+	if p.Ref != "" {
+		v.Set("ref", p.Ref)
 	}
+	if p.Paused != nil {
+		v.Set("paused", strconv.FormatBool(*p.Paused))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
 
-	if len(v) > 0 {
-		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+// Filters returns all filters for a zone.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-all-filters
+func (api *API) Filters(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]Filter, error) {
+	filters, _, err := api.ListFilters(ctx, zoneID, FilterListParams{PaginationOptions: pageOpts})
+	return filters, err
+}
+
+// ListFilters returns a page of the filters of a zone matching params.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-all-filters
+func (api *API) ListFilters(ctx context.Context, zoneID string, params FilterListParams) ([]Filter, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/filters", zoneID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
 	}
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
-		return []Filter{}, err
+		return []Filter{}, ResultInfo{}, err
 	}
 
 	var filtersResponse FiltersDetailResponse
 	err = json.Unmarshal(res, &filtersResponse)
 	if err != nil {
-		return []Filter{}, errors.Wrap(err, errUnmarshalError)
+		return []Filter{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return filtersResponse.Result, nil
+	return filtersResponse.Result, filtersResponse.ResultInfo, nil
+}
+
+// ListAllFilters returns all filters of a zone matching params, fetching
+// every page of results.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-filters/get/#get-all-filters
+func (api *API) ListAllFilters(ctx context.Context, zoneID string, params FilterListParams) ([]Filter, error) {
+	if params.PerPage < 1 {
+		params.PerPage = 100
+	}
+	params.Page = 1
+
+	var all []Filter
+	for {
+		filters, resultInfo, err := api.ListFilters(ctx, zoneID, params)
+		if err != nil {
+			return []Filter{}, err
+		}
+		all = append(all, filters...)
+		if len(filters) == 0 || resultInfo.Page >= resultInfo.TotalPages {
+			break
+		}
+		params.Page++
+	}
+
+	return all, nil
 }
 
 // CreateFilters creates new filters.
@@ -205,6 +268,10 @@ func (api *API) DeleteFilter(ctx context.Context, zoneID, filterID string) error
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-filters/delete/#delete-multiple-filters
 func (api *API) DeleteFilters(ctx context.Context, zoneID string, filterIDs []string) error {
+	if len(filterIDs) == 0 {
+		return errors.New("filter IDs cannot be empty")
+	}
+
 	ids := strings.Join(filterIDs, ",")
 	uri := fmt.Sprintf("/zones/%s/filters?id=%s", zoneID, ids)
 
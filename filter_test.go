@@ -348,3 +348,66 @@ func TestDeleteFilterWithMissingID(t *testing.T) {
 	err := client.DeleteFilter(context.Background(), "d56084adb405e0b7e32c52321bf07be6", "")
 	assert.EqualError(t, err, "filter ID cannot be empty")
 }
+
+func TestListAllFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "false", r.URL.Query().Get("paused"))
+		assert.Equal(t, "100", r.URL.Query().Get("per_page"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [
+				{
+					"id": "filter-%[1]s",
+					"paused": false,
+					"description": "login",
+					"expression": "http.request.uri.path eq \"/login%[1]s\""
+				}
+			],
+			"success": true,
+			"errors": null,
+			"messages": null,
+			"result_info": {"page": %[1]s, "per_page": 100, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page)
+	}
+
+	mux.HandleFunc("/zones/d56084adb405e0b7e32c52321bf07be6/filters", handler)
+
+	paused := false
+	actual, err := client.ListAllFilters(context.Background(), "d56084adb405e0b7e32c52321bf07be6", FilterListParams{Paused: &paused})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Filter{
+			{ID: "filter-1", Description: "login", Expression: `http.request.uri.path eq "/login1"`},
+			{ID: "filter-2", Description: "login", Expression: `http.request.uri.path eq "/login2"`},
+		}, actual)
+	}
+}
+
+func TestDeleteFilters(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, "a,b", r.URL.Query().Get("id"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [],
+			"success": true,
+			"errors": null,
+			"messages": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/d56084adb405e0b7e32c52321bf07be6/filters", handler)
+
+	err := client.DeleteFilters(context.Background(), "d56084adb405e0b7e32c52321bf07be6", []string{"a", "b"})
+	assert.NoError(t, err)
+
+	err = client.DeleteFilters(context.Background(), "d56084adb405e0b7e32c52321bf07be6", nil)
+	assert.EqualError(t, err, "filter IDs cannot be empty")
+}
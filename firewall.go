@@ -176,6 +176,47 @@ func (api *API) DeleteAccountAccessRule(ctx context.Context, accountID, accessRu
 	return api.deleteAccessRule(ctx, fmt.Sprintf("/accounts/%s", accountID), accessRuleID)
 }
 
+// ListAllUserAccessRules returns all access rules for the logged-in user
+// matching the filters of accessRule, fetching every page of results.
+//
+// API reference: https://api.cloudflare.com/#user-level-firewall-access-rule-list-access-rules
+func (api *API) ListAllUserAccessRules(ctx context.Context, accessRule AccessRule) ([]AccessRule, error) {
+	return api.listAllAccessRules(ctx, "/user", accessRule)
+}
+
+// ListAllZoneAccessRules returns all access rules for the given zone
+// identifier matching the filters of accessRule, fetching every page of
+// results.
+//
+// API reference: https://api.cloudflare.com/#firewall-access-rule-for-a-zone-list-access-rules
+func (api *API) ListAllZoneAccessRules(ctx context.Context, zoneID string, accessRule AccessRule) ([]AccessRule, error) {
+	return api.listAllAccessRules(ctx, fmt.Sprintf("/zones/%s", zoneID), accessRule)
+}
+
+// ListAllAccountAccessRules returns all access rules for the given account
+// identifier matching the filters of accessRule, fetching every page of
+// results.
+//
+// API reference: https://api.cloudflare.com/#account-level-firewall-access-rule-list-access-rules
+func (api *API) ListAllAccountAccessRules(ctx context.Context, accountID string, accessRule AccessRule) ([]AccessRule, error) {
+	return api.listAllAccessRules(ctx, fmt.Sprintf("/accounts/%s", accountID), accessRule)
+}
+
+func (api *API) listAllAccessRules(ctx context.Context, prefix string, accessRule AccessRule) ([]AccessRule, error) {
+	var rules []AccessRule
+	for page := 1; ; page++ {
+		r, err := api.listAccessRules(ctx, prefix, accessRule, page)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r.Result...)
+		if len(r.Result) == 0 || r.ResultInfo.Page >= r.ResultInfo.TotalPages {
+			break
+		}
+	}
+	return rules, nil
+}
+
 func (api *API) listAccessRules(ctx context.Context, prefix string, accessRule AccessRule, page int) (*AccessRuleListResponse, error) {
 	// Construct a query string
 	v := url.Values{}
@@ -0,0 +1,132 @@
+package cloudflare
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// firewallEventsDefaultPageSize is the number of events requested per page
+// when FirewallEventsParams.PerPage is unset.
+const firewallEventsDefaultPageSize = 1000
+
+// FirewallEvent is a single security event for a zone, as reported by the
+// firewallEventsAdaptive GraphQL dataset.
+type FirewallEvent struct {
+	Datetime          time.Time `json:"datetime"`
+	Action            string    `json:"action"`
+	Source            string    `json:"source"`
+	RuleID            string    `json:"ruleId"`
+	RayID             string    `json:"rayName"`
+	ClientIP          string    `json:"clientIP"`
+	ClientCountryName string    `json:"clientCountryName"`
+	ClientRequestHost string    `json:"clientRequestHTTPHost"`
+	ClientRequestPath string    `json:"clientRequestPath"`
+	UserAgent         string    `json:"userAgent"`
+}
+
+// FirewallEventsParams selects the firewall events returned by
+// FirewallEvents and ForEachFirewallEvent.
+type FirewallEventsParams struct {
+	Since time.Time
+	Until time.Time
+	// Action optionally restricts events to an action, e.g. "block".
+	Action string
+	// RuleID optionally restricts events to those matching a rule.
+	RuleID string
+	// ClientIP optionally restricts events to a client IP address.
+	ClientIP string
+	// PerPage is the number of events fetched per request, up to 10000.
+	// Defaults to 1000.
+	PerPage int
+}
+
+// FirewallEvents returns all firewall events for a zone matching params,
+// oldest first.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-firewall-events/
+func (api *API) FirewallEvents(ctx context.Context, zoneID string, params FirewallEventsParams) ([]FirewallEvent, error) {
+	var events []FirewallEvent
+	err := api.ForEachFirewallEvent(ctx, zoneID, params, func(e FirewallEvent) error {
+		events = append(events, e)
+		return nil
+	})
+	if err != nil {
+		return []FirewallEvent{}, err
+	}
+	return events, nil
+}
+
+// ForEachFirewallEvent calls fn for every firewall event for a zone matching
+// params, oldest first, fetching pages as they are needed. Returning an
+// error from fn stops paging and returns that error.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-firewall-events/
+func (api *API) ForEachFirewallEvent(ctx context.Context, zoneID string, params FirewallEventsParams, fn func(FirewallEvent) error) error {
+	if !params.Until.After(params.Since) {
+		return errors.Errorf("until must be after since")
+	}
+
+	perPage := params.PerPage
+	if perPage == 0 {
+		perPage = firewallEventsDefaultPageSize
+	}
+
+	var cursor *FirewallEvent
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		filter := map[string]interface{}{
+			"datetime_geq": params.Since.UTC().Format(time.RFC3339),
+			"datetime_lt":  params.Until.UTC().Format(time.RFC3339),
+		}
+		if params.Action != "" {
+			filter["action"] = params.Action
+		}
+		if params.RuleID != "" {
+			filter["ruleId"] = params.RuleID
+		}
+		if params.ClientIP != "" {
+			filter["clientIP"] = params.ClientIP
+		}
+		// Events are ordered by time and ray ID, so the next page starts
+		// strictly after the last event seen.
+		if cursor != nil {
+			datetime := cursor.Datetime.UTC().Format(time.RFC3339)
+			filter["OR"] = []map[string]interface{}{
+				{"datetime_gt": datetime},
+				{"datetime": datetime, "rayName_gt": cursor.RayID},
+			}
+		}
+
+		var events []FirewallEvent
+		err := api.GraphQLAnalytics(ctx, GraphQLAnalyticsQuery{
+			Dataset: AnalyticsDatasetFirewallEventsAdaptive,
+			Tag:     zoneID,
+			Filter:  filter,
+			Limit:   perPage,
+			OrderBy: []string{"datetime_ASC", "rayName_ASC"},
+			Fields: []string{
+				"datetime action source ruleId rayName clientIP clientCountryName",
+				"clientRequestHTTPHost clientRequestPath userAgent",
+			},
+		}, &events)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < perPage {
+			return nil
+		}
+		cursor = &events[len(events)-1]
+	}
+}
@@ -0,0 +1,91 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFirewallEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		requests++
+
+		body, err := ioutil.ReadAll(r.Body)
+		if !assert.NoError(t, err) {
+			return
+		}
+		var query GraphQLQuery
+		if !assert.NoError(t, json.Unmarshal(body, &query)) {
+			return
+		}
+		assert.Contains(t, query.Query, "rows: firewallEventsAdaptive(filter: $filter, limit: $limit, orderBy: [datetime_ASC, rayName_ASC])")
+		assert.Equal(t, float64(2), query.Variables["limit"])
+
+		filter := query.Variables["filter"].(map[string]interface{})
+		assert.Equal(t, "block", filter["action"])
+
+		w.Header().Set("content-type", "application/json")
+		switch requests {
+		case 1:
+			assert.NotContains(t, filter, "OR")
+			fmt.Fprintf(w, `{
+				"data": {"viewer": {"zones": [{"rows": [
+					{"datetime": "2023-06-01T00:00:00Z", "action": "block", "source": "firewallrules", "ruleId": "rule1", "rayName": "7d1", "clientIP": "192.0.2.1", "clientRequestPath": "/login"},
+					{"datetime": "2023-06-01T00:00:00Z", "action": "block", "source": "firewallrules", "ruleId": "rule1", "rayName": "7d2", "clientIP": "192.0.2.2", "clientRequestPath": "/admin"}
+				]}]}},
+				"errors": null
+			}`)
+		case 2:
+			assert.Equal(t, []interface{}{
+				map[string]interface{}{"datetime_gt": "2023-06-01T00:00:00Z"},
+				map[string]interface{}{"datetime": "2023-06-01T00:00:00Z", "rayName_gt": "7d2"},
+			}, filter["OR"])
+			fmt.Fprintf(w, `{
+				"data": {"viewer": {"zones": [{"rows": [
+					{"datetime": "2023-06-01T00:05:00Z", "action": "block", "source": "waf", "ruleId": "rule2", "rayName": "7d3", "clientIP": "192.0.2.3", "clientRequestPath": "/"}
+				]}]}},
+				"errors": null
+			}`)
+		default:
+			t.Fatalf("unexpected request %d", requests)
+		}
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	since, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-06-02T00:00:00Z")
+	later, _ := time.Parse(time.RFC3339, "2023-06-01T00:05:00Z")
+
+	want := []FirewallEvent{
+		{Datetime: since, Action: "block", Source: "firewallrules", RuleID: "rule1", RayID: "7d1", ClientIP: "192.0.2.1", ClientRequestPath: "/login"},
+		{Datetime: since, Action: "block", Source: "firewallrules", RuleID: "rule1", RayID: "7d2", ClientIP: "192.0.2.2", ClientRequestPath: "/admin"},
+		{Datetime: later, Action: "block", Source: "waf", RuleID: "rule2", RayID: "7d3", ClientIP: "192.0.2.3", ClientRequestPath: "/"},
+	}
+
+	actual, err := client.FirewallEvents(context.Background(), testZoneID, FirewallEventsParams{
+		Since:   since,
+		Until:   until,
+		Action:  "block",
+		PerPage: 2,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, 2, requests)
+	}
+
+	_, err = client.FirewallEvents(context.Background(), testZoneID, FirewallEventsParams{Since: until, Until: since})
+	assert.EqualError(t, err, "until must be after since")
+}
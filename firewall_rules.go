@@ -41,37 +41,98 @@ type FirewallRuleResponse struct {
 	Response
 }
 
-// FirewallRules returns all firewall rules.
-//
-// API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-all-rules
-func (api *API) FirewallRules(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]FirewallRule, error) {
-	uri := fmt.Sprintf("/zones/%s/firewall/rules", zoneID)
+// FirewallRuleListParams holds the filters used when listing firewall
+// rules.
+type FirewallRuleListParams struct {
+	ID          string
+	Description string
+	Action      string
+	Paused      *bool
+	PaginationOptions
+}
+
+// Encode encodes the firewall rule list parameters into a query string.
+func (p FirewallRuleListParams) Encode() string {
 	v := url.Values{}
 
-	if pageOpts.PerPage > 0 {
-		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	if p.ID != "" {
+		v.Set("id", p.ID)
 	}
-
-	if pageOpts.Page > 0 {
-		v.Set("page", strconv.Itoa(pageOpts.Page))
+	if p.Description != "" {
+		v.Set("description", p.Description)
+	}
+	if p.Action != "" {
+		v.Set("action", p.Action)
 	}
+	if p.Paused != nil {
+		v.Set("paused", strconv.FormatBool(*p.Paused))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// FirewallRules returns all firewall rules.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-all-rules
+func (api *API) FirewallRules(ctx context.Context, zoneID string, pageOpts PaginationOptions) ([]FirewallRule, error) {
+	rules, _, err := api.ListFirewallRules(ctx, zoneID, FirewallRuleListParams{PaginationOptions: pageOpts})
+	return rules, err
+}
 
-	if len(v) > 0 {
-		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+// ListFirewallRules returns a page of the firewall rules of a zone matching
+// params.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-all-rules
+func (api *API) ListFirewallRules(ctx context.Context, zoneID string, params FirewallRuleListParams) ([]FirewallRule, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/firewall/rules", zoneID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
 	}
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
-		return []FirewallRule{}, err
+		return []FirewallRule{}, ResultInfo{}, err
 	}
 
 	var firewallDetailResponse FirewallRulesDetailResponse
 	err = json.Unmarshal(res, &firewallDetailResponse)
 	if err != nil {
-		return []FirewallRule{}, errors.Wrap(err, errUnmarshalError)
+		return []FirewallRule{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
 	}
 
-	return firewallDetailResponse.Result, nil
+	return firewallDetailResponse.Result, firewallDetailResponse.ResultInfo, nil
+}
+
+// ListAllFirewallRules returns all firewall rules of a zone matching params,
+// fetching every page of results.
+//
+// API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/get/#get-all-rules
+func (api *API) ListAllFirewallRules(ctx context.Context, zoneID string, params FirewallRuleListParams) ([]FirewallRule, error) {
+	if params.PerPage < 1 {
+		params.PerPage = 100
+	}
+	params.Page = 1
+
+	var all []FirewallRule
+	for {
+		rules, resultInfo, err := api.ListFirewallRules(ctx, zoneID, params)
+		if err != nil {
+			return []FirewallRule{}, err
+		}
+		all = append(all, rules...)
+		if len(rules) == 0 || resultInfo.Page >= resultInfo.TotalPages {
+			break
+		}
+		params.Page++
+	}
+
+	return all, nil
 }
 
 // FirewallRule returns a single firewall rule based on the ID.
@@ -186,6 +247,10 @@ func (api *API) DeleteFirewallRule(ctx context.Context, zoneID, firewallRuleID s
 //
 // API reference: https://developers.cloudflare.com/firewall/api/cf-firewall-rules/delete/#delete-multiple-rules
 func (api *API) DeleteFirewallRules(ctx context.Context, zoneID string, firewallRuleIDs []string) error {
+	if len(firewallRuleIDs) == 0 {
+		return errors.New("firewall rule IDs cannot be empty")
+	}
+
 	v := url.Values{}
 
 	for _, ruleID := range firewallRuleIDs {
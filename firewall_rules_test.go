@@ -545,3 +545,65 @@ func TestDeleteFirewallRuleWithMissingID(t *testing.T) {
 	err := client.DeleteFirewallRule(context.Background(), "d56084adb405e0b7e32c52321bf07be6", "")
 	assert.EqualError(t, err, "firewall rule ID cannot be empty")
 }
+
+func TestListAllFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "block", r.URL.Query().Get("action"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [
+				{
+					"id": "rule-%[1]s",
+					"paused": false,
+					"description": "block login",
+					"action": "block",
+					"priority": null,
+					"filter": {"id": "filter-%[1]s", "expression": "true", "paused": false, "description": ""}
+				}
+			],
+			"success": true,
+			"errors": null,
+			"messages": null,
+			"result_info": {"page": %[1]s, "per_page": 100, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page)
+	}
+
+	mux.HandleFunc("/zones/d56084adb405e0b7e32c52321bf07be6/firewall/rules", handler)
+
+	actual, err := client.ListAllFirewallRules(context.Background(), "d56084adb405e0b7e32c52321bf07be6", FirewallRuleListParams{Action: "block"})
+	if assert.NoError(t, err) && assert.Len(t, actual, 2) {
+		assert.Equal(t, "rule-1", actual[0].ID)
+		assert.Equal(t, "rule-2", actual[1].ID)
+		assert.Equal(t, Filter{ID: "filter-2", Expression: "true"}, actual[1].Filter)
+	}
+}
+
+func TestDeleteMultipleFirewallRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, []string{"a", "b"}, r.URL.Query()["id"])
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [],
+			"success": true,
+			"errors": null,
+			"messages": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/d56084adb405e0b7e32c52321bf07be6/firewall/rules", handler)
+
+	err := client.DeleteFirewallRules(context.Background(), "d56084adb405e0b7e32c52321bf07be6", []string{"a", "b"})
+	assert.NoError(t, err)
+
+	err = client.DeleteFirewallRules(context.Background(), "d56084adb405e0b7e32c52321bf07be6", nil)
+	assert.EqualError(t, err, "firewall rule IDs cannot be empty")
+}
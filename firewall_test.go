@@ -403,3 +403,56 @@ func TestDeleteAccessRule(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, want, actual)
 }
+
+func TestListAllAccessRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "block", r.URL.Query().Get("mode"))
+		assert.Equal(t, "country", r.URL.Query().Get("configuration_target"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "rule-%[1]s",
+					"mode": "block",
+					"configuration": {"target": "country", "value": "T%[1]s"}
+				}
+			],
+			"result_info": {
+				"page": %[1]s,
+				"per_page": 1,
+				"count": 1,
+				"total_count": 2,
+				"total_pages": 2
+			}
+		}`, page)
+	}
+
+	want := []AccessRule{
+		{ID: "rule-1", Mode: "block", Configuration: AccessRuleConfiguration{Target: "country", Value: "T1"}},
+		{ID: "rule-2", Mode: "block", Configuration: AccessRuleConfiguration{Target: "country", Value: "T2"}},
+	}
+	filter := AccessRule{Mode: "block", Configuration: AccessRuleConfiguration{Target: "country"}}
+
+	mux.HandleFunc("/user/firewall/access_rules/rules", handler)
+	actual, err := client.ListAllUserAccessRules(context.Background(), filter)
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+
+	mux.HandleFunc("/zones/"+testZoneID+"/firewall/access_rules/rules", handler)
+	actual, err = client.ListAllZoneAccessRules(context.Background(), testZoneID, filter)
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/firewall/access_rules/rules", handler)
+	actual, err = client.ListAllAccountAccessRules(context.Background(), testAccountID, filter)
+	require.NoError(t, err)
+	assert.Equal(t, want, actual)
+}
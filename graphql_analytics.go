@@ -0,0 +1,223 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// graphQLAnalyticsMaxLimit is the largest number of rows the adaptive
+// datasets return for a single query.
+const graphQLAnalyticsMaxLimit = 10000
+
+// graphQLAnalyticsDefaultLimit is used when no limit is set on a query.
+const graphQLAnalyticsDefaultLimit = 100
+
+var graphQLIdentifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// GraphQLQuery is a query sent to the GraphQL Analytics API.
+type GraphQLQuery struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+// GraphQLError is a single error returned by the GraphQL Analytics API.
+type GraphQLError struct {
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+}
+
+// GraphQLErrors is returned when the GraphQL Analytics API responds with
+// one or more errors.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+	return "graphql: " + strings.Join(messages, "; ")
+}
+
+type graphQLResponse struct {
+	Data   json.RawMessage `json:"data"`
+	Errors GraphQLErrors   `json:"errors"`
+}
+
+// GraphQL runs a query against the GraphQL Analytics API and decodes the
+// "data" field of the response into result. Errors reported by the API are
+// returned as GraphQLErrors.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/
+func (api *API) GraphQL(ctx context.Context, query GraphQLQuery, result interface{}) error {
+	res, err := api.makeRequestContext(ctx, http.MethodPost, "/graphql", query)
+	if err != nil {
+		return err
+	}
+
+	var r graphQLResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if len(r.Errors) > 0 {
+		return r.Errors
+	}
+	if result == nil || len(r.Data) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(r.Data, result)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
+
+// AnalyticsDataset is a GraphQL Analytics dataset that can be queried with
+// GraphQLAnalyticsQuery.
+type AnalyticsDataset string
+
+// These constants represent the datasets supported by GraphQLAnalyticsQuery.
+const (
+	AnalyticsDatasetHTTPRequestsAdaptiveGroups   AnalyticsDataset = "httpRequestsAdaptiveGroups"
+	AnalyticsDatasetHTTPRequests1hGroups         AnalyticsDataset = "httpRequests1hGroups"
+	AnalyticsDatasetFirewallEventsAdaptive       AnalyticsDataset = "firewallEventsAdaptive"
+	AnalyticsDatasetWorkersInvocationsAdaptive   AnalyticsDataset = "workersInvocationsAdaptive"
+	AnalyticsDatasetMagicTransitNetworkAnalytics AnalyticsDataset = "magicTransitNetworkAnalyticsAdaptiveGroups"
+	AnalyticsDatasetSpectrumNetworkAnalytics     AnalyticsDataset = "spectrumNetworkAnalyticsAdaptiveGroups"
+)
+
+// scope returns the viewer node the dataset belongs to and the name of its
+// filter input type.
+func (d AnalyticsDataset) scope() (node, tagField, filterType string, err error) {
+	switch d {
+	case AnalyticsDatasetHTTPRequestsAdaptiveGroups:
+		return "zones", "zoneTag", "ZoneHttpRequestsAdaptiveGroupsFilter_InputObject", nil
+	case AnalyticsDatasetHTTPRequests1hGroups:
+		return "zones", "zoneTag", "ZoneHttpRequests1hGroupsFilter_InputObject", nil
+	case AnalyticsDatasetFirewallEventsAdaptive:
+		return "zones", "zoneTag", "ZoneFirewallEventsAdaptiveFilter_InputObject", nil
+	case AnalyticsDatasetWorkersInvocationsAdaptive:
+		return "accounts", "accountTag", "AccountWorkersInvocationsAdaptiveFilter_InputObject", nil
+	case AnalyticsDatasetMagicTransitNetworkAnalytics:
+		return "accounts", "accountTag", "AccountMagicTransitNetworkAnalyticsAdaptiveGroupsFilter_InputObject", nil
+	case AnalyticsDatasetSpectrumNetworkAnalytics:
+		return "accounts", "accountTag", "AccountSpectrumNetworkAnalyticsAdaptiveGroupsFilter_InputObject", nil
+	}
+	return "", "", "", errors.Errorf("unsupported analytics dataset %q", d)
+}
+
+// GraphQLAnalyticsQuery describes a query against a single GraphQL
+// Analytics dataset for one zone or account.
+type GraphQLAnalyticsQuery struct {
+	Dataset AnalyticsDataset
+	// Tag is the zone ID for zone datasets and the account ID for account
+	// datasets.
+	Tag string
+	// Filter is passed as the dataset filter, e.g.
+	// {"datetime_geq": "2023-06-01T00:00:00Z", "datetime_lt": "2023-06-02T00:00:00Z"}.
+	Filter map[string]interface{}
+	// Limit is the maximum number of rows returned, up to 10000. Defaults
+	// to 100.
+	Limit int
+	// OrderBy lists the sort order, e.g. "datetimeMinute_ASC".
+	OrderBy []string
+	// Fields is the selection set for each row, e.g.
+	// "count", "dimensions { datetimeMinute }" or "sum { edgeResponseBytes }".
+	Fields []string
+}
+
+// Build returns the GraphQL query for q. The rows are aliased as "rows" on
+// the first zone or account of the viewer.
+func (q GraphQLAnalyticsQuery) Build() (GraphQLQuery, error) {
+	node, tagField, filterType, err := q.Dataset.scope()
+	if err != nil {
+		return GraphQLQuery{}, err
+	}
+	if q.Tag == "" {
+		return GraphQLQuery{}, errors.Errorf("tag cannot be empty")
+	}
+	if len(q.Fields) == 0 {
+		return GraphQLQuery{}, errors.Errorf("fields cannot be empty")
+	}
+
+	limit := q.Limit
+	if limit == 0 {
+		limit = graphQLAnalyticsDefaultLimit
+	}
+	if limit < 0 || limit > graphQLAnalyticsMaxLimit {
+		return GraphQLQuery{}, errors.Errorf("limit must be between 1 and %d", graphQLAnalyticsMaxLimit)
+	}
+
+	for _, o := range q.OrderBy {
+		if !graphQLIdentifierRegexp.MatchString(o) {
+			return GraphQLQuery{}, errors.Errorf("invalid order %q", o)
+		}
+	}
+
+	orderBy := ""
+	if len(q.OrderBy) > 0 {
+		orderBy = fmt.Sprintf(", orderBy: [%s]", strings.Join(q.OrderBy, ", "))
+	}
+
+	filter := q.Filter
+	if filter == nil {
+		filter = map[string]interface{}{}
+	}
+
+	query := fmt.Sprintf(`query ($tag: string, $filter: %s, $limit: uint64) {
+  viewer {
+    %s(filter: {%s: $tag}) {
+      rows: %s(filter: $filter, limit: $limit%s) {
+        %s
+      }
+    }
+  }
+}`, filterType, node, tagField, q.Dataset, orderBy, strings.Join(q.Fields, "\n        "))
+
+	return GraphQLQuery{
+		Query: query,
+		Variables: map[string]interface{}{
+			"tag":    q.Tag,
+			"filter": filter,
+			"limit":  limit,
+		},
+	}, nil
+}
+
+// GraphQLAnalytics runs q and decodes the returned rows into rows, which
+// should be a pointer to a slice of structs matching q.Fields.
+func (api *API) GraphQLAnalytics(ctx context.Context, q GraphQLAnalyticsQuery, rows interface{}) error {
+	query, err := q.Build()
+	if err != nil {
+		return err
+	}
+
+	var data struct {
+		Viewer map[string][]struct {
+			Rows json.RawMessage `json:"rows"`
+		} `json:"viewer"`
+	}
+	if err := api.GraphQL(ctx, query, &data); err != nil {
+		return err
+	}
+
+	node, _, _, _ := q.Dataset.scope()
+	scopes := data.Viewer[node]
+	if len(scopes) == 0 || len(scopes[0].Rows) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(scopes[0].Rows, rows)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
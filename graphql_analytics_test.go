@@ -0,0 +1,140 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphQLAnalyticsQueryBuild(t *testing.T) {
+	query, err := GraphQLAnalyticsQuery{
+		Dataset: AnalyticsDatasetHTTPRequestsAdaptiveGroups,
+		Tag:     testZoneID,
+		Filter:  map[string]interface{}{"datetime_geq": "2023-06-01T00:00:00Z"},
+		OrderBy: []string{"datetimeMinute_ASC"},
+		Fields:  []string{"count", "dimensions { datetimeMinute }"},
+	}.Build()
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, `query ($tag: string, $filter: ZoneHttpRequestsAdaptiveGroupsFilter_InputObject, $limit: uint64) {
+  viewer {
+    zones(filter: {zoneTag: $tag}) {
+      rows: httpRequestsAdaptiveGroups(filter: $filter, limit: $limit, orderBy: [datetimeMinute_ASC]) {
+        count
+        dimensions { datetimeMinute }
+      }
+    }
+  }
+}`, query.Query)
+		assert.Equal(t, map[string]interface{}{
+			"tag":    testZoneID,
+			"filter": map[string]interface{}{"datetime_geq": "2023-06-01T00:00:00Z"},
+			"limit":  100,
+		}, query.Variables)
+	}
+
+	_, err = GraphQLAnalyticsQuery{Dataset: "httpRequests1dGroups", Tag: testZoneID, Fields: []string{"count"}}.Build()
+	assert.EqualError(t, err, `unsupported analytics dataset "httpRequests1dGroups"`)
+
+	_, err = GraphQLAnalyticsQuery{Dataset: AnalyticsDatasetFirewallEventsAdaptive, Tag: testZoneID, Fields: []string{"count"}, Limit: 10001}.Build()
+	assert.EqualError(t, err, "limit must be between 1 and 10000")
+
+	_, err = GraphQLAnalyticsQuery{Dataset: AnalyticsDatasetFirewallEventsAdaptive, Tag: testZoneID, Fields: []string{"count"}, OrderBy: []string{"datetime_ASC] {"}}.Build()
+	assert.EqualError(t, err, `invalid order "datetime_ASC] {"`)
+}
+
+func TestGraphQLAnalytics(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			var query GraphQLQuery
+			if assert.NoError(t, json.Unmarshal(body, &query)) {
+				assert.Contains(t, query.Query, "accounts(filter: {accountTag: $tag})")
+				assert.Contains(t, query.Query, "rows: workersInvocationsAdaptive(filter: $filter, limit: $limit)")
+				assert.Equal(t, testAccountID, query.Variables["tag"])
+			}
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"data": {
+				"viewer": {
+					"accounts": [
+						{
+							"rows": [
+								{"dimensions": {"scriptName": "api"}, "sum": {"requests": 1200, "errors": 3}},
+								{"dimensions": {"scriptName": "auth"}, "sum": {"requests": 800, "errors": 0}}
+							]
+						}
+					]
+				}
+			},
+			"errors": null
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	type row struct {
+		Dimensions struct {
+			ScriptName string `json:"scriptName"`
+		} `json:"dimensions"`
+		Sum struct {
+			Requests int `json:"requests"`
+			Errors   int `json:"errors"`
+		} `json:"sum"`
+	}
+
+	var rows []row
+	err := client.GraphQLAnalytics(context.Background(), GraphQLAnalyticsQuery{
+		Dataset: AnalyticsDatasetWorkersInvocationsAdaptive,
+		Tag:     testAccountID,
+		Fields:  []string{"dimensions { scriptName }", "sum { requests errors }"},
+	}, &rows)
+
+	if assert.NoError(t, err) && assert.Len(t, rows, 2) {
+		assert.Equal(t, "api", rows[0].Dimensions.ScriptName)
+		assert.Equal(t, 1200, rows[0].Sum.Requests)
+		assert.Equal(t, 3, rows[0].Sum.Errors)
+		assert.Equal(t, "auth", rows[1].Dimensions.ScriptName)
+	}
+}
+
+func TestGraphQLErrors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"data": null,
+			"errors": [
+				{
+					"message": "cannot request data older than 2678400s",
+					"path": ["viewer", "zones", 0, "rows"],
+					"extensions": {"code": "authz", "timestamp": "2023-06-05T21:20:00Z"}
+				}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	err := client.GraphQL(context.Background(), GraphQLQuery{Query: "{ viewer { zones { rows: httpRequestsAdaptiveGroups { count } } } }"}, nil)
+
+	if assert.Error(t, err) {
+		assert.EqualError(t, err, "graphql: cannot request data older than 2678400s")
+		gqlErrs, ok := err.(GraphQLErrors)
+		if assert.True(t, ok) {
+			assert.Equal(t, "authz", gqlErrs[0].Extensions["code"])
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// HostnameTLSSettingType identifies a TLS setting that can be applied to an
+// individual hostname.
+type HostnameTLSSettingType string
+
+// These constants represent all valid per-hostname TLS settings.
+const (
+	HostnameTLSSettingMinTLSVersion HostnameTLSSettingType = "min_tls_version"
+	HostnameTLSSettingCiphers       HostnameTLSSettingType = "ciphers"
+	HostnameTLSSettingHTTP2         HostnameTLSSettingType = "http2"
+)
+
+// HostnameTLSSetting represents the value of a TLS setting on a single
+// hostname. Value is a string for min_tls_version and http2, and a list of
+// strings for ciphers.
+type HostnameTLSSetting struct {
+	Hostname  string      `json:"hostname"`
+	Value     interface{} `json:"value"`
+	Status    string      `json:"status,omitempty"`
+	CreatedAt *time.Time  `json:"created_at,omitempty"`
+	UpdatedAt *time.Time  `json:"updated_at,omitempty"`
+}
+
+// HostnameTLSSettingsResponse is the API response, containing the values of
+// a TLS setting across hostnames.
+type HostnameTLSSettingsResponse struct {
+	Response
+	Result     []HostnameTLSSetting `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// HostnameTLSSettingResponse is the API response, containing the value of a
+// TLS setting on a single hostname.
+type HostnameTLSSettingResponse struct {
+	Response
+	Result HostnameTLSSetting `json:"result"`
+}
+
+// HostnameTLSSettings returns the value of a TLS setting for every hostname
+// it has been applied to within a zone.
+//
+// API reference: https://api.cloudflare.com/#per-hostname-tls-settings-list
+func (api *API) HostnameTLSSettings(ctx context.Context, zoneID string, setting HostnameTLSSettingType) ([]HostnameTLSSetting, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/hostnames/settings/%s", zoneID, setting)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []HostnameTLSSetting{}, ResultInfo{}, err
+	}
+
+	var settingsResponse HostnameTLSSettingsResponse
+	err = json.Unmarshal(res, &settingsResponse)
+	if err != nil {
+		return []HostnameTLSSetting{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return settingsResponse.Result, settingsResponse.ResultInfo, nil
+}
+
+// UpdateHostnameTLSSetting sets the value of a TLS setting on a single
+// hostname.
+//
+// API reference: https://api.cloudflare.com/#per-hostname-tls-settings-put
+func (api *API) UpdateHostnameTLSSetting(ctx context.Context, zoneID string, setting HostnameTLSSettingType, hostname string, value interface{}) (HostnameTLSSetting, error) {
+	if hostname == "" {
+		return HostnameTLSSetting{}, errors.Errorf("hostname cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/hostnames/settings/%s/%s", zoneID, setting, hostname)
+	params := struct {
+		Value interface{} `json:"value"`
+	}{
+		Value: value,
+	}
+
+	return api.hostnameTLSSettingRequest(ctx, http.MethodPut, uri, params)
+}
+
+// DeleteHostnameTLSSetting removes a TLS setting from a single hostname so
+// that it falls back to the zone setting.
+//
+// API reference: https://api.cloudflare.com/#per-hostname-tls-settings-delete
+func (api *API) DeleteHostnameTLSSetting(ctx context.Context, zoneID string, setting HostnameTLSSettingType, hostname string) (HostnameTLSSetting, error) {
+	if hostname == "" {
+		return HostnameTLSSetting{}, errors.Errorf("hostname cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/hostnames/settings/%s/%s", zoneID, setting, hostname)
+
+	return api.hostnameTLSSettingRequest(ctx, http.MethodDelete, uri, nil)
+}
+
+func (api *API) hostnameTLSSettingRequest(ctx context.Context, method, uri string, params interface{}) (HostnameTLSSetting, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return HostnameTLSSetting{}, err
+	}
+
+	var settingResponse HostnameTLSSettingResponse
+	err = json.Unmarshal(res, &settingResponse)
+	if err != nil {
+		return HostnameTLSSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return settingResponse.Result, nil
+}
@@ -0,0 +1,138 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostnameTLSSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"hostname": "app.example.com",
+					"value": "1.2",
+					"status": "active",
+					"created_at": "2023-07-10T20:01:50.219171Z",
+					"updated_at": "2023-07-10T20:01:50.219171Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 50,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2023-07-10T20:01:50.219171Z")
+
+	want := []HostnameTLSSetting{{
+		Hostname:  "app.example.com",
+		Value:     "1.2",
+		Status:    "active",
+		CreatedAt: &createdAt,
+		UpdatedAt: &createdAt,
+	}}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hostnames/settings/min_tls_version", handler)
+
+	actual, _, err := client.HostnameTLSSettings(context.Background(), testZoneID, HostnameTLSSettingMinTLSVersion)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateHostnameTLSSetting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value":["ECDHE-RSA-AES128-GCM-SHA256","AES128-GCM-SHA256"]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"hostname": "app.example.com",
+				"value": ["ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"],
+				"status": "pending_deployment"
+			}
+		}
+		`)
+	}
+
+	want := HostnameTLSSetting{
+		Hostname: "app.example.com",
+		Value:    []interface{}{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"},
+		Status:   "pending_deployment",
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hostnames/settings/ciphers/app.example.com", handler)
+
+	actual, err := client.UpdateHostnameTLSSetting(context.Background(), testZoneID, HostnameTLSSettingCiphers, "app.example.com", []string{"ECDHE-RSA-AES128-GCM-SHA256", "AES128-GCM-SHA256"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateHostnameTLSSetting(context.Background(), testZoneID, HostnameTLSSettingCiphers, "", nil)
+	assert.EqualError(t, err, "hostname cannot be empty")
+}
+
+func TestDeleteHostnameTLSSetting(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"hostname": "app.example.com",
+				"value": "",
+				"status": "pending_deletion"
+			}
+		}
+		`)
+	}
+
+	want := HostnameTLSSetting{
+		Hostname: "app.example.com",
+		Value:    "",
+		Status:   "pending_deletion",
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hostnames/settings/http2/app.example.com", handler)
+
+	actual, err := client.DeleteHostnameTLSSetting(context.Background(), testZoneID, HostnameTLSSettingHTTP2, "app.example.com")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,120 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneHTTPTraffic is a summary of the HTTP traffic served for a zone over a
+// time range, as returned by ZoneHTTPTraffic.
+type ZoneHTTPTraffic struct {
+	Totals     ZoneHTTPTrafficPoint
+	Timeseries []ZoneHTTPTrafficPoint
+}
+
+// ZoneHTTPTrafficPoint holds the HTTP traffic totals of a zone for the hour
+// starting at Datetime, or for the whole range when used as totals.
+type ZoneHTTPTrafficPoint struct {
+	Datetime       time.Time
+	Requests       int64
+	CachedRequests int64
+	Bytes          int64
+	CachedBytes    int64
+	Threats        int64
+	PageViews      int64
+	// StatusCodes maps edge response status codes to their request count.
+	StatusCodes map[int]int64
+}
+
+type zoneHTTPTrafficRow struct {
+	Dimensions struct {
+		Datetime time.Time `json:"datetime"`
+	} `json:"dimensions"`
+	Sum struct {
+		Requests          int64 `json:"requests"`
+		CachedRequests    int64 `json:"cachedRequests"`
+		Bytes             int64 `json:"bytes"`
+		CachedBytes       int64 `json:"cachedBytes"`
+		Threats           int64 `json:"threats"`
+		PageViews         int64 `json:"pageViews"`
+		ResponseStatusMap []struct {
+			EdgeResponseStatus int   `json:"edgeResponseStatus"`
+			Requests           int64 `json:"requests"`
+		} `json:"responseStatusMap"`
+	} `json:"sum"`
+}
+
+// ZoneHTTPTraffic returns the requests, bandwidth, threats and status code
+// breakdown of a zone between since and until, in hourly buckets.
+func (api *API) ZoneHTTPTraffic(ctx context.Context, zoneID string, since, until time.Time) (ZoneHTTPTraffic, error) {
+	if !until.After(since) {
+		return ZoneHTTPTraffic{}, errors.Errorf("until must be after since")
+	}
+
+	hours := int(until.Sub(since).Hours()) + 1
+	if hours > graphQLAnalyticsMaxLimit {
+		return ZoneHTTPTraffic{}, errors.Errorf("time range cannot exceed %d hours", graphQLAnalyticsMaxLimit)
+	}
+
+	var rows []zoneHTTPTrafficRow
+	err := api.GraphQLAnalytics(ctx, GraphQLAnalyticsQuery{
+		Dataset: AnalyticsDatasetHTTPRequests1hGroups,
+		Tag:     zoneID,
+		Filter: map[string]interface{}{
+			"datetime_geq": since.UTC().Format(time.RFC3339),
+			"datetime_lt":  until.UTC().Format(time.RFC3339),
+		},
+		Limit:   hours,
+		OrderBy: []string{"datetime_ASC"},
+		Fields: []string{
+			"dimensions { datetime }",
+			"sum { requests cachedRequests bytes cachedBytes threats pageViews responseStatusMap { edgeResponseStatus requests } }",
+		},
+	}, &rows)
+	if err != nil {
+		return ZoneHTTPTraffic{}, err
+	}
+
+	traffic := ZoneHTTPTraffic{
+		Totals:     ZoneHTTPTrafficPoint{Datetime: since, StatusCodes: map[int]int64{}},
+		Timeseries: make([]ZoneHTTPTrafficPoint, 0, len(rows)),
+	}
+	for _, row := range rows {
+		point := ZoneHTTPTrafficPoint{
+			Datetime:       row.Dimensions.Datetime,
+			Requests:       row.Sum.Requests,
+			CachedRequests: row.Sum.CachedRequests,
+			Bytes:          row.Sum.Bytes,
+			CachedBytes:    row.Sum.CachedBytes,
+			Threats:        row.Sum.Threats,
+			PageViews:      row.Sum.PageViews,
+			StatusCodes:    make(map[int]int64, len(row.Sum.ResponseStatusMap)),
+		}
+		for _, status := range row.Sum.ResponseStatusMap {
+			point.StatusCodes[status.EdgeResponseStatus] += status.Requests
+			traffic.Totals.StatusCodes[status.EdgeResponseStatus] += status.Requests
+		}
+
+		traffic.Totals.Requests += point.Requests
+		traffic.Totals.CachedRequests += point.CachedRequests
+		traffic.Totals.Bytes += point.Bytes
+		traffic.Totals.CachedBytes += point.CachedBytes
+		traffic.Totals.Threats += point.Threats
+		traffic.Totals.PageViews += point.PageViews
+		traffic.Timeseries = append(traffic.Timeseries, point)
+	}
+
+	return traffic, nil
+}
+
+// StatusCodeClasses groups the status codes of p by class, e.g. "2xx".
+func (p ZoneHTTPTrafficPoint) StatusCodeClasses() map[string]int64 {
+	classes := make(map[string]int64)
+	for code, requests := range p.StatusCodes {
+		classes[fmt.Sprintf("%dxx", code/100)] += requests
+	}
+	return classes
+}
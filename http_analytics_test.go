@@ -0,0 +1,120 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneHTTPTraffic(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			var query GraphQLQuery
+			if assert.NoError(t, json.Unmarshal(body, &query)) {
+				assert.Contains(t, query.Query, "rows: httpRequests1hGroups(filter: $filter, limit: $limit, orderBy: [datetime_ASC])")
+				assert.Equal(t, map[string]interface{}{
+					"datetime_geq": "2023-06-01T00:00:00Z",
+					"datetime_lt":  "2023-06-01T02:00:00Z",
+				}, query.Variables["filter"])
+				assert.Equal(t, float64(3), query.Variables["limit"])
+			}
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"data": {
+				"viewer": {
+					"zones": [
+						{
+							"rows": [
+								{
+									"dimensions": {"datetime": "2023-06-01T00:00:00Z"},
+									"sum": {
+										"requests": 100, "cachedRequests": 60, "bytes": 2000, "cachedBytes": 1500,
+										"threats": 2, "pageViews": 40,
+										"responseStatusMap": [
+											{"edgeResponseStatus": 200, "requests": 90},
+											{"edgeResponseStatus": 404, "requests": 10}
+										]
+									}
+								},
+								{
+									"dimensions": {"datetime": "2023-06-01T01:00:00Z"},
+									"sum": {
+										"requests": 50, "cachedRequests": 20, "bytes": 1000, "cachedBytes": 500,
+										"threats": 0, "pageViews": 25,
+										"responseStatusMap": [
+											{"edgeResponseStatus": 200, "requests": 45},
+											{"edgeResponseStatus": 503, "requests": 5}
+										]
+									}
+								}
+							]
+						}
+					]
+				}
+			},
+			"errors": null
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	since, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-06-01T02:00:00Z")
+	hour, _ := time.Parse(time.RFC3339, "2023-06-01T01:00:00Z")
+
+	want := ZoneHTTPTraffic{
+		Totals: ZoneHTTPTrafficPoint{
+			Datetime:       since,
+			Requests:       150,
+			CachedRequests: 80,
+			Bytes:          3000,
+			CachedBytes:    2000,
+			Threats:        2,
+			PageViews:      65,
+			StatusCodes:    map[int]int64{200: 135, 404: 10, 503: 5},
+		},
+		Timeseries: []ZoneHTTPTrafficPoint{
+			{
+				Datetime:       since,
+				Requests:       100,
+				CachedRequests: 60,
+				Bytes:          2000,
+				CachedBytes:    1500,
+				Threats:        2,
+				PageViews:      40,
+				StatusCodes:    map[int]int64{200: 90, 404: 10},
+			},
+			{
+				Datetime:       hour,
+				Requests:       50,
+				CachedRequests: 20,
+				Bytes:          1000,
+				CachedBytes:    500,
+				PageViews:      25,
+				StatusCodes:    map[int]int64{200: 45, 503: 5},
+			},
+		},
+	}
+
+	actual, err := client.ZoneHTTPTraffic(context.Background(), testZoneID, since, until)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, map[string]int64{"2xx": 135, "4xx": 10, "5xx": 5}, actual.Totals.StatusCodeClasses())
+	}
+
+	_, err = client.ZoneHTTPTraffic(context.Background(), testZoneID, until, since)
+	assert.EqualError(t, err, "until must be after since")
+}
@@ -0,0 +1,313 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Image is an image stored in Cloudflare Images.
+type Image struct {
+	ID                string                 `json:"id"`
+	Filename          string                 `json:"filename"`
+	Meta              map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs bool                   `json:"requireSignedURLs"`
+	// Variants are the delivery URLs of the image, one per variant.
+	Variants []string   `json:"variants"`
+	Uploaded *time.Time `json:"uploaded"`
+}
+
+// ImageUploadParams describes an image to upload. Exactly one of File and
+// URL must be set.
+type ImageUploadParams struct {
+	// File is read for the image content; Name is used as its filename.
+	File io.Reader
+	Name string
+	// URL is fetched by Cloudflare for the image content.
+	URL string
+	// ID is a custom ID for the image. One is generated if empty.
+	ID                string
+	RequireSignedURLs bool
+	Metadata          map[string]interface{}
+}
+
+// ImageUpdateParams holds the changes to make to an image.
+type ImageUpdateParams struct {
+	RequireSignedURLs *bool                  `json:"requireSignedURLs,omitempty"`
+	Metadata          map[string]interface{} `json:"metadata,omitempty"`
+}
+
+// ImageDirectUploadParams configures a direct creator upload.
+type ImageDirectUploadParams struct {
+	// ID is a custom ID for the image. One is generated if empty.
+	ID                string
+	RequireSignedURLs bool
+	Metadata          map[string]interface{}
+	// Expiry is when the upload URL stops accepting uploads. It defaults
+	// to 30 minutes after creation.
+	Expiry *time.Time
+}
+
+// ImageDirectUpload is a one-time URL a creator uploads an image to with a
+// multipart POST, and the ID the image will have.
+type ImageDirectUpload struct {
+	ID        string `json:"id"`
+	UploadURL string `json:"uploadURL"`
+}
+
+// ImagesStats is the image usage of an account.
+type ImagesStats struct {
+	Count ImagesStatsCount `json:"count"`
+}
+
+// ImagesStatsCount is the number of images stored and the number allowed.
+type ImagesStatsCount struct {
+	Current int64 `json:"current"`
+	Allowed int64 `json:"allowed"`
+}
+
+// ImageResponse is the API response containing an image.
+type ImageResponse struct {
+	Response
+	Result Image `json:"result"`
+}
+
+// ImagesListResponse is the API response containing a page of images.
+type ImagesListResponse struct {
+	Response
+	Result struct {
+		Images []Image `json:"images"`
+	} `json:"result"`
+}
+
+// ImageDirectUploadResponse is the API response containing a direct
+// creator upload URL.
+type ImageDirectUploadResponse struct {
+	Response
+	Result ImageDirectUpload `json:"result"`
+}
+
+// ImagesStatsResponse is the API response containing the image usage of an
+// account.
+type ImagesStatsResponse struct {
+	Response
+	Result ImagesStats `json:"result"`
+}
+
+// UploadImage uploads an image, either from params.File or fetched from
+// params.URL.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-upload-an-image-using-a-single-http-request
+func (api *API) UploadImage(ctx context.Context, accountID string, params ImageUploadParams) (Image, error) {
+	if accountID == "" {
+		return Image{}, errors.New(errMissingAccountID)
+	}
+	if (params.File == nil) == (params.URL == "") {
+		return Image{}, errors.New("image requires either a file or a URL")
+	}
+
+	fields := map[string]string{}
+	if params.URL != "" {
+		fields["url"] = params.URL
+	}
+	if params.ID != "" {
+		fields["id"] = params.ID
+	}
+	if params.RequireSignedURLs {
+		fields["requireSignedURLs"] = "true"
+	}
+	if err := setImageMetadataField(fields, params.Metadata); err != nil {
+		return Image{}, err
+	}
+
+	contentType, body, err := multipartFormBody(fields, params.Name, params.File)
+	if err != nil {
+		return Image{}, err
+	}
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	uri := fmt.Sprintf("/accounts/%s/images/v1", accountID)
+	return api.imageRequest(ctx, http.MethodPost, uri, body, headers)
+}
+
+// ListImages returns a page of the images of an account.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-list-images
+func (api *API) ListImages(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]Image, error) {
+	if accountID == "" {
+		return []Image{}, errors.New(errMissingAccountID)
+	}
+
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/images/v1", accountID)
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Image{}, err
+	}
+
+	var r ImagesListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Image{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Images, nil
+}
+
+// ImageDetails returns the details of an image.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-image-details
+func (api *API) ImageDetails(ctx context.Context, accountID, imageID string) (Image, error) {
+	uri, err := imageURI(accountID, imageID)
+	if err != nil {
+		return Image{}, err
+	}
+	return api.imageRequest(ctx, http.MethodGet, uri, nil, nil)
+}
+
+// UpdateImage changes the access control and metadata of an image.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-update-image
+func (api *API) UpdateImage(ctx context.Context, accountID, imageID string, params ImageUpdateParams) (Image, error) {
+	uri, err := imageURI(accountID, imageID)
+	if err != nil {
+		return Image{}, err
+	}
+	return api.imageRequest(ctx, http.MethodPatch, uri, params, nil)
+}
+
+// DeleteImage deletes an image and its variants.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-delete-image
+func (api *API) DeleteImage(ctx context.Context, accountID, imageID string) error {
+	uri, err := imageURI(accountID, imageID)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// CreateImageDirectUploadURL creates a one-time URL that lets a creator
+// upload an image without access to the account's credentials.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-create-authenticated-direct-upload-url-v2
+func (api *API) CreateImageDirectUploadURL(ctx context.Context, accountID string, params ImageDirectUploadParams) (ImageDirectUpload, error) {
+	if accountID == "" {
+		return ImageDirectUpload{}, errors.New(errMissingAccountID)
+	}
+
+	fields := map[string]string{}
+	if params.ID != "" {
+		fields["id"] = params.ID
+	}
+	if params.RequireSignedURLs {
+		fields["requireSignedURLs"] = "true"
+	}
+	if params.Expiry != nil {
+		fields["expiry"] = params.Expiry.UTC().Format(time.RFC3339)
+	}
+	if err := setImageMetadataField(fields, params.Metadata); err != nil {
+		return ImageDirectUpload{}, err
+	}
+
+	contentType, body, err := multipartFormBody(fields, "", nil)
+	if err != nil {
+		return ImageDirectUpload{}, err
+	}
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	uri := fmt.Sprintf("/accounts/%s/images/v2/direct_upload", accountID)
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPost, uri, body, headers)
+	if err != nil {
+		return ImageDirectUpload{}, err
+	}
+
+	var r ImageDirectUploadResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImageDirectUpload{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ImagesStats returns the number of images stored in an account and the
+// number allowed.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-images-images-usage-statistics
+func (api *API) ImagesStats(ctx context.Context, accountID string) (ImagesStats, error) {
+	if accountID == "" {
+		return ImagesStats{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/images/v1/stats", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ImagesStats{}, err
+	}
+
+	var r ImagesStatsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ImagesStats{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func (api *API) imageRequest(ctx context.Context, method, uri string, params interface{}, headers http.Header) (Image, error) {
+	res, err := api.makeRequestContextWithHeaders(ctx, method, uri, params, headers)
+	if err != nil {
+		return Image{}, err
+	}
+
+	var r ImageResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Image{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// setImageMetadataField adds metadata to the multipart fields of an upload
+// as a JSON encoded "metadata" field.
+func setImageMetadataField(fields map[string]string, metadata map[string]interface{}) error {
+	if len(metadata) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(metadata)
+	if err != nil {
+		return errors.Wrap(err, "could not encode image metadata")
+	}
+	fields["metadata"] = string(b)
+	return nil
+}
+
+func imageURI(accountID, imageID string) (string, error) {
+	if accountID == "" {
+		return "", errors.New(errMissingAccountID)
+	}
+	if imageID == "" {
+		return "", errors.New("image ID cannot be empty")
+	}
+	return fmt.Sprintf("/accounts/%s/images/v1/%s", accountID, imageID), nil
+}
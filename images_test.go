@@ -0,0 +1,242 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testImageJSON = `{
+	"id": "ZxR0pLaXRldlBtaFhhO2FiZGVnaA",
+	"filename": "avatar.png",
+	"meta": {"key": "value"},
+	"requireSignedURLs": true,
+	"variants": [
+		"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhO2FiZGVnaA/hero",
+		"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhO2FiZGVnaA/thumbnail"
+	],
+	"uploaded": "2022-08-15T18:00:00Z"
+}`
+
+func testImage() Image {
+	uploaded, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+	return Image{
+		ID:                "ZxR0pLaXRldlBtaFhhO2FiZGVnaA",
+		Filename:          "avatar.png",
+		Meta:              map[string]interface{}{"key": "value"},
+		RequireSignedURLs: true,
+		Variants: []string{
+			"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhO2FiZGVnaA/hero",
+			"https://imagedelivery.net/MTt4OTd0b0w5aj/ZxR0pLaXRldlBtaFhhO2FiZGVnaA/thumbnail",
+		},
+		Uploaded: &uploaded,
+	}
+}
+
+func TestUploadImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"))
+		file, header, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			content, _ := ioutil.ReadAll(file)
+			assert.Equal(t, "PNG", string(content))
+			assert.Equal(t, "avatar.png", header.Filename)
+		}
+		assert.Equal(t, "true", r.FormValue("requireSignedURLs"))
+		assert.JSONEq(t, `{"key": "value"}`, r.FormValue("metadata"))
+		assert.Equal(t, "", r.FormValue("url"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testImageJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1", handler)
+
+	actual, err := client.UploadImage(context.Background(), testAccountID, ImageUploadParams{
+		File:              strings.NewReader("PNG"),
+		Name:              "avatar.png",
+		RequireSignedURLs: true,
+		Metadata:          map[string]interface{}{"key": "value"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testImage(), actual)
+	}
+}
+
+func TestUploadImageFromURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "https://example.com/avatar.png", r.FormValue("url"))
+		assert.Equal(t, "avatar", r.FormValue("id"))
+		_, _, err := r.FormFile("file")
+		assert.Equal(t, http.ErrMissingFile, err)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testImageJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1", handler)
+
+	_, err := client.UploadImage(context.Background(), testAccountID, ImageUploadParams{
+		URL: "https://example.com/avatar.png",
+		ID:  "avatar",
+	})
+	assert.NoError(t, err)
+
+	_, err = client.UploadImage(context.Background(), testAccountID, ImageUploadParams{})
+	assert.EqualError(t, err, "image requires either a file or a URL")
+
+	_, err = client.UploadImage(context.Background(), testAccountID, ImageUploadParams{
+		File: strings.NewReader("PNG"),
+		URL:  "https://example.com/avatar.png",
+	})
+	assert.EqualError(t, err, "image requires either a file or a URL")
+}
+
+func TestListImages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"images": [%s]}}`, testImageJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1", handler)
+
+	actual, err := client.ListImages(context.Background(), testAccountID, PaginationOptions{Page: 2, PerPage: 50})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Image{testImage()}, actual)
+	}
+}
+
+func TestImageDetails(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testImageJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/ZxR0pLaXRldlBtaFhhO2FiZGVnaA", handler)
+
+	actual, err := client.ImageDetails(context.Background(), testAccountID, "ZxR0pLaXRldlBtaFhhO2FiZGVnaA")
+	if assert.NoError(t, err) {
+		assert.Equal(t, testImage(), actual)
+	}
+
+	_, err = client.ImageDetails(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "image ID cannot be empty")
+}
+
+func TestUpdateImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"requireSignedURLs": true, "metadata": {"key": "value"}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testImageJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/ZxR0pLaXRldlBtaFhhO2FiZGVnaA", handler)
+
+	requireSignedURLs := true
+	actual, err := client.UpdateImage(context.Background(), testAccountID, "ZxR0pLaXRldlBtaFhhO2FiZGVnaA", ImageUpdateParams{
+		RequireSignedURLs: &requireSignedURLs,
+		Metadata:          map[string]interface{}{"key": "value"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, testImage(), actual)
+	}
+}
+
+func TestDeleteImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/ZxR0pLaXRldlBtaFhhO2FiZGVnaA", handler)
+
+	assert.NoError(t, client.DeleteImage(context.Background(), testAccountID, "ZxR0pLaXRldlBtaFhhO2FiZGVnaA"))
+}
+
+func TestCreateImageDirectUploadURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "2022-08-15T18:30:00Z", r.FormValue("expiry"))
+		assert.Equal(t, "true", r.FormValue("requireSignedURLs"))
+		assert.JSONEq(t, `{"key": "value"}`, r.FormValue("metadata"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "e22e9e6b-c02b-42fd-c405-6c32af5fe600",
+				"uploadURL": "https://upload.imagedelivery.net/FxUufywByo0m2v3xhKSiU8/e22e9e6b-c02b-42fd-c405-6c32af5fe600"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v2/direct_upload", handler)
+
+	expiry := time.Date(2022, 8, 15, 18, 30, 0, 0, time.UTC)
+	actual, err := client.CreateImageDirectUploadURL(context.Background(), testAccountID, ImageDirectUploadParams{
+		RequireSignedURLs: true,
+		Metadata:          map[string]interface{}{"key": "value"},
+		Expiry:            &expiry,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ImageDirectUpload{
+			ID:        "e22e9e6b-c02b-42fd-c405-6c32af5fe600",
+			UploadURL: "https://upload.imagedelivery.net/FxUufywByo0m2v3xhKSiU8/e22e9e6b-c02b-42fd-c405-6c32af5fe600",
+		}, actual)
+	}
+}
+
+func TestImagesStats(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"count": {"allowed": 100000, "current": 1000}}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/images/v1/stats", handler)
+
+	actual, err := client.ImagesStats(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ImagesStats{Count: ImagesStatsCount{Current: 1000, Allowed: 100000}}, actual)
+	}
+}
@@ -0,0 +1,177 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/net/websocket"
+)
+
+// instantLogsOrigin is sent as the Origin header when connecting to an
+// Instant Logs session.
+const instantLogsOrigin = "https://api.cloudflare.com"
+
+// InstantLogsJob is an Instant Logs session. DestinationConf is the websocket
+// URL the live logs are streamed from.
+type InstantLogsJob struct {
+	DestinationConf string `json:"destination_conf"`
+	SessionID       string `json:"session_id"`
+	Fields          string `json:"fields"`
+	Sample          int    `json:"sample"`
+	Filter          string `json:"filter"`
+}
+
+// InstantLogsJobParams holds the options used when creating an Instant Logs
+// job.
+type InstantLogsJobParams struct {
+	// Fields lists the HTTP request fields to stream.
+	Fields []string
+	// Sample streams one in every Sample requests. Zero streams every
+	// request.
+	Sample int
+	// Filter is a JSON encoded Logpush filter, such as
+	// {"where":{"and":[{"key":"ClientCountry","operator":"neq","value":"ca"}]}}.
+	Filter string
+}
+
+// MarshalJSON encodes the params in the shape expected by the API.
+func (p InstantLogsJobParams) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Fields string `json:"fields"`
+		Sample int    `json:"sample,omitempty"`
+		Filter string `json:"filter,omitempty"`
+		Kind   string `json:"kind"`
+	}{
+		Fields: strings.Join(p.Fields, ","),
+		Sample: p.Sample,
+		Filter: p.Filter,
+		Kind:   "instant-logs",
+	})
+}
+
+// InstantLogsJobResponse is the API response, containing a single Instant
+// Logs job.
+type InstantLogsJobResponse struct {
+	Response
+	Result InstantLogsJob `json:"result"`
+}
+
+// InstantLogsJobsResponse is the API response, containing a list of Instant
+// Logs jobs.
+type InstantLogsJobsResponse struct {
+	Response
+	Result []InstantLogsJob `json:"result"`
+}
+
+// CreateInstantLogsJob creates an Instant Logs session for a zone. The
+// session can be consumed with StreamInstantLogs.
+//
+// API reference: https://developers.cloudflare.com/logs/instant-logs/
+func (api *API) CreateInstantLogsJob(ctx context.Context, zoneID string, params InstantLogsJobParams) (InstantLogsJob, error) {
+	if len(params.Fields) == 0 {
+		return InstantLogsJob{}, errors.Errorf("fields cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/logpush/edge", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return InstantLogsJob{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// InstantLogsJobs returns the Instant Logs sessions for a zone.
+//
+// API reference: https://developers.cloudflare.com/logs/instant-logs/
+func (api *API) InstantLogsJobs(ctx context.Context, zoneID string) ([]InstantLogsJob, error) {
+	uri := fmt.Sprintf("/zones/%s/logpush/edge", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []InstantLogsJob{}, err
+	}
+
+	var r InstantLogsJobsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []InstantLogsJob{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// StreamInstantLogs connects to an Instant Logs session and sends each log
+// record to records until ctx is cancelled or the session ends. records is
+// closed when StreamInstantLogs returns, so it can be ranged over. A nil
+// error is returned when the session is closed by either side.
+func (api *API) StreamInstantLogs(ctx context.Context, job InstantLogsJob, records chan<- LogpullRecord) error {
+	defer close(records)
+
+	if job.DestinationConf == "" {
+		return errors.Errorf("destination cannot be empty")
+	}
+
+	config, err := websocket.NewConfig(job.DestinationConf, instantLogsOrigin)
+	if err != nil {
+		return errors.Wrap(err, "invalid Instant Logs destination")
+	}
+	if api.UserAgent != "" {
+		config.Header.Set("User-Agent", api.UserAgent)
+	}
+
+	ws, err := websocket.DialConfig(config)
+	if err != nil {
+		return errors.Wrap(err, "could not connect to Instant Logs session")
+	}
+	defer ws.Close()
+
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			ws.Close()
+		case <-done:
+		}
+	}()
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(ws, &msg); err != nil {
+			if ctx.Err() != nil || err == io.EOF {
+				return nil
+			}
+			return errors.Wrap(err, "could not read from Instant Logs session")
+		}
+
+		// A single message can hold several newline delimited records.
+		dec := json.NewDecoder(bytes.NewReader(msg))
+		dec.UseNumber()
+		for {
+			var record LogpullRecord
+			err := dec.Decode(&record)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return errors.Wrap(err, errUnmarshalError)
+			}
+
+			select {
+			case records <- record:
+			case <-ctx.Done():
+				return nil
+			}
+		}
+	}
+}
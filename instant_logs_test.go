@@ -0,0 +1,104 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/net/websocket"
+)
+
+func TestCreateInstantLogsJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"fields": "ClientIP,ClientRequestHost,EdgeResponseStatus",
+				"sample": 10,
+				"filter": "{\"where\":{\"and\":[{\"key\":\"ClientCountry\",\"operator\":\"neq\",\"value\":\"ca\"}]}}",
+				"kind": "instant-logs"
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"destination_conf": "wss://logs.cloudflare.com/instant-logs/ws/sessions/99d471b1ca3c23cc8e30b6acec5db987",
+				"session_id": "99d471b1ca3c23cc8e30b6acec5db987",
+				"fields": "ClientIP,ClientRequestHost,EdgeResponseStatus",
+				"sample": 10,
+				"filter": "{\"where\":{\"and\":[{\"key\":\"ClientCountry\",\"operator\":\"neq\",\"value\":\"ca\"}]}}"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logpush/edge", handler)
+
+	filter := `{"where":{"and":[{"key":"ClientCountry","operator":"neq","value":"ca"}]}}`
+	want := InstantLogsJob{
+		DestinationConf: "wss://logs.cloudflare.com/instant-logs/ws/sessions/99d471b1ca3c23cc8e30b6acec5db987",
+		SessionID:       "99d471b1ca3c23cc8e30b6acec5db987",
+		Fields:          "ClientIP,ClientRequestHost,EdgeResponseStatus",
+		Sample:          10,
+		Filter:          filter,
+	}
+
+	actual, err := client.CreateInstantLogsJob(context.Background(), testZoneID, InstantLogsJobParams{
+		Fields: []string{"ClientIP", "ClientRequestHost", "EdgeResponseStatus"},
+		Sample: 10,
+		Filter: filter,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreateInstantLogsJob(context.Background(), testZoneID, InstantLogsJobParams{})
+	assert.EqualError(t, err, "fields cannot be empty")
+}
+
+func TestStreamInstantLogs(t *testing.T) {
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		_ = websocket.Message.Send(ws, `{"ClientIP":"198.51.100.1","EdgeResponseStatus":200}`)
+		_ = websocket.Message.Send(ws, "{\"ClientIP\":\"198.51.100.2\",\"EdgeResponseStatus\":404}\n{\"ClientIP\":\"198.51.100.3\",\"EdgeResponseStatus\":500}\n")
+	}))
+	defer server.Close()
+
+	setup()
+	defer teardown()
+
+	job := InstantLogsJob{DestinationConf: "ws://" + strings.TrimPrefix(server.URL, "http://")}
+	records := make(chan LogpullRecord)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- client.StreamInstantLogs(context.Background(), job, records)
+	}()
+
+	var actual []LogpullRecord
+	for record := range records {
+		actual = append(actual, record)
+	}
+
+	want := []LogpullRecord{
+		{"ClientIP": "198.51.100.1", "EdgeResponseStatus": json.Number("200")},
+		{"ClientIP": "198.51.100.2", "EdgeResponseStatus": json.Number("404")},
+		{"ClientIP": "198.51.100.3", "EdgeResponseStatus": json.Number("500")},
+	}
+
+	if assert.NoError(t, <-errc) {
+		assert.Equal(t, want, actual)
+	}
+}
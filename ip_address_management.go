@@ -31,6 +31,17 @@ type AdvertisementStatus struct {
 	AdvertisedModifiedAt *time.Time `json:"advertised_modified_at"`
 }
 
+// IPPrefixDelegation contains information about a part of an IP prefix
+// delegated to another account
+type IPPrefixDelegation struct {
+	ID                 string     `json:"id"`
+	CreatedAt          *time.Time `json:"created_at"`
+	ModifiedAt         *time.Time `json:"modified_at"`
+	CIDR               string     `json:"cidr"`
+	DelegatedAccountID string     `json:"delegated_account_id"`
+	ParentPrefixID     string     `json:"parent_prefix_id"`
+}
+
 // ListIPPrefixResponse contains a slice of IP prefixes
 type ListIPPrefixResponse struct {
 	Response
@@ -49,6 +60,18 @@ type GetAdvertisementStatusResponse struct {
 	Result AdvertisementStatus `json:"result"`
 }
 
+// ListIPPrefixDelegationsResponse contains a slice of prefix delegations
+type ListIPPrefixDelegationsResponse struct {
+	Response
+	Result []IPPrefixDelegation `json:"result"`
+}
+
+// IPPrefixDelegationResponse contains a specific prefix delegation's API Response
+type IPPrefixDelegationResponse struct {
+	Response
+	Result IPPrefixDelegation `json:"result"`
+}
+
 // IPPrefixUpdateRequest contains information about prefix updates
 type IPPrefixUpdateRequest struct {
 	Description string `json:"description"`
@@ -59,6 +82,12 @@ type AdvertisementStatusUpdateRequest struct {
 	Advertised bool `json:"advertised"`
 }
 
+// IPPrefixDelegationCreateRequest contains information about a new prefix delegation
+type IPPrefixDelegationCreateRequest struct {
+	CIDR               string `json:"cidr"`
+	DelegatedAccountID string `json:"delegated_account_id"`
+}
+
 // ListPrefixes lists all IP prefixes for a given account
 //
 // API reference: https://api.cloudflare.com/#ip-address-management-prefixes-list-prefixes
@@ -148,3 +177,60 @@ func (api *API) UpdateAdvertisementStatus(ctx context.Context, id string, advert
 
 	return result.Result, nil
 }
+
+// ListPrefixDelegations lists the delegations of an IP prefix to other accounts
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-list-prefix-delegations
+func (api *API) ListPrefixDelegations(ctx context.Context, prefixID string) ([]IPPrefixDelegation, error) {
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations", api.AccountID, prefixID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []IPPrefixDelegation{}, err
+	}
+
+	result := ListIPPrefixDelegationsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []IPPrefixDelegation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// CreatePrefixDelegation delegates part of an IP prefix to another account
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-create-prefix-delegation
+func (api *API) CreatePrefixDelegation(ctx context.Context, prefixID, cidr, delegatedAccountID string) (IPPrefixDelegation, error) {
+	if cidr == "" || delegatedAccountID == "" {
+		return IPPrefixDelegation{}, errors.Errorf("CIDR and delegated account ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations", api.AccountID, prefixID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, IPPrefixDelegationCreateRequest{CIDR: cidr, DelegatedAccountID: delegatedAccountID})
+	if err != nil {
+		return IPPrefixDelegation{}, err
+	}
+
+	result := IPPrefixDelegationResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return IPPrefixDelegation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// DeletePrefixDelegation removes a delegation of an IP prefix
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-prefix-delegation-delete-prefix-delegation
+func (api *API) DeletePrefixDelegation(ctx context.Context, prefixID, delegationID string) error {
+	if delegationID == "" {
+		return errors.Errorf("delegation ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/prefixes/%s/delegations/%s", api.AccountID, prefixID, delegationID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
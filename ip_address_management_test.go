@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -235,3 +236,111 @@ func TestUpdateAdvertisementStatus(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestListPrefixDelegations(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": [
+				{
+					"id": "d933b1530bc56c9953cf8ce166da8004",
+					"created_at": "2020-04-24T21:25:55.643771Z",
+					"modified_at": "2020-04-24T21:25:55.643771Z",
+					"cidr": "10.1.2.0/25",
+					"delegated_account_id": "b1946ac92492d2347c6235b4d2611184",
+					"parent_prefix_id": "f68579455bd947efb65ffa1bcf33b52c"
+				}
+			],
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/prefixes/f68579455bd947efb65ffa1bcf33b52c/delegations", handler)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2020-04-24T21:25:55.643771Z")
+
+	want := []IPPrefixDelegation{
+		{
+			ID:                 "d933b1530bc56c9953cf8ce166da8004",
+			CreatedAt:          &createdAt,
+			ModifiedAt:         &createdAt,
+			CIDR:               "10.1.2.0/25",
+			DelegatedAccountID: "b1946ac92492d2347c6235b4d2611184",
+			ParentPrefixID:     "f68579455bd947efb65ffa1bcf33b52c",
+		},
+	}
+
+	actual, err := client.ListPrefixDelegations(context.Background(), "f68579455bd947efb65ffa1bcf33b52c")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreatePrefixDelegation(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"cidr": "10.1.2.0/25", "delegated_account_id": "b1946ac92492d2347c6235b4d2611184"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "d933b1530bc56c9953cf8ce166da8004",
+				"cidr": "10.1.2.0/25",
+				"delegated_account_id": "b1946ac92492d2347c6235b4d2611184",
+				"parent_prefix_id": "f68579455bd947efb65ffa1bcf33b52c"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/prefixes/f68579455bd947efb65ffa1bcf33b52c/delegations", handler)
+
+	want := IPPrefixDelegation{
+		ID:                 "d933b1530bc56c9953cf8ce166da8004",
+		CIDR:               "10.1.2.0/25",
+		DelegatedAccountID: "b1946ac92492d2347c6235b4d2611184",
+		ParentPrefixID:     "f68579455bd947efb65ffa1bcf33b52c",
+	}
+
+	actual, err := client.CreatePrefixDelegation(context.Background(), "f68579455bd947efb65ffa1bcf33b52c", "10.1.2.0/25", "b1946ac92492d2347c6235b4d2611184")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreatePrefixDelegation(context.Background(), "f68579455bd947efb65ffa1bcf33b52c", "", "b1946ac92492d2347c6235b4d2611184")
+	assert.EqualError(t, err, "CIDR and delegated account ID cannot be empty")
+}
+
+func TestDeletePrefixDelegation(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {"id": "d933b1530bc56c9953cf8ce166da8004"},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/prefixes/f68579455bd947efb65ffa1bcf33b52c/delegations/d933b1530bc56c9953cf8ce166da8004", handler)
+
+	err := client.DeletePrefixDelegation(context.Background(), "f68579455bd947efb65ffa1bcf33b52c", "d933b1530bc56c9953cf8ce166da8004")
+	assert.NoError(t, err)
+}
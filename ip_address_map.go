@@ -0,0 +1,223 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Address map membership kinds
+const (
+	// AddressMapMembershipZone is a zone member of an address map
+	AddressMapMembershipZone = "zone"
+
+	// AddressMapMembershipAccount is an account member of an address map
+	AddressMapMembershipAccount = "account"
+)
+
+// AddressMapIP contains information about an IP of an address map
+type AddressMapIP struct {
+	IP        string     `json:"ip"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// AddressMapMembership contains information about a zone or account using an address map
+type AddressMapMembership struct {
+	Identifier string     `json:"identifier"`
+	Kind       string     `json:"kind"`
+	Deletable  *bool      `json:"can_delete,omitempty"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+}
+
+// AddressMap contains information about an address map, which controls the
+// BYOIP addresses used by the zones and accounts it is applied to
+type AddressMap struct {
+	ID           string                 `json:"id,omitempty"`
+	Description  *string                `json:"description,omitempty"`
+	DefaultSNI   *string                `json:"default_sni,omitempty"`
+	Enabled      *bool                  `json:"enabled,omitempty"`
+	Deletable    *bool                  `json:"can_delete,omitempty"`
+	CanModifyIPs *bool                  `json:"can_modify_ips,omitempty"`
+	IPs          []AddressMapIP         `json:"ips,omitempty"`
+	Memberships  []AddressMapMembership `json:"memberships,omitempty"`
+	CreatedAt    *time.Time             `json:"created_at,omitempty"`
+	ModifiedAt   *time.Time             `json:"modified_at,omitempty"`
+}
+
+// CreateAddressMapRequest contains information about a new address map
+type CreateAddressMapRequest struct {
+	Description *string                `json:"description,omitempty"`
+	Enabled     *bool                  `json:"enabled,omitempty"`
+	IPs         []string               `json:"ips,omitempty"`
+	Memberships []AddressMapMembership `json:"memberships,omitempty"`
+}
+
+// UpdateAddressMapRequest contains information about address map updates
+type UpdateAddressMapRequest struct {
+	Description *string `json:"description,omitempty"`
+	Enabled     *bool   `json:"enabled,omitempty"`
+	DefaultSNI  *string `json:"default_sni,omitempty"`
+}
+
+// ListAddressMapsResponse contains a slice of address maps
+type ListAddressMapsResponse struct {
+	Response
+	Result []AddressMap `json:"result"`
+}
+
+// AddressMapResponse contains a specific address map's API Response
+type AddressMapResponse struct {
+	Response
+	Result AddressMap `json:"result"`
+}
+
+// ListAddressMaps lists all address maps for a given account
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-list-address-maps
+func (api *API) ListAddressMaps(ctx context.Context) ([]AddressMap, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []AddressMap{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/address_maps", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []AddressMap{}, err
+	}
+
+	result := ListAddressMapsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []AddressMap{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// GetAddressMap returns a specific address map, including its IPs and memberships
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-address-map-details
+func (api *API) GetAddressMap(ctx context.Context, id string) (AddressMap, error) {
+	return api.addressMapRequest(ctx, http.MethodGet, id, nil)
+}
+
+// CreateAddressMap creates a new address map
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-create-address-map
+func (api *API) CreateAddressMap(ctx context.Context, params CreateAddressMapRequest) (AddressMap, error) {
+	if err := api.checkAccountID(); err != nil {
+		return AddressMap{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/address_maps", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	result := AddressMapResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return AddressMap{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// UpdateAddressMap edits the description, default SNI or status of an address map
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-update-address-map
+func (api *API) UpdateAddressMap(ctx context.Context, id string, params UpdateAddressMapRequest) (AddressMap, error) {
+	return api.addressMapRequest(ctx, http.MethodPatch, id, params)
+}
+
+// DeleteAddressMap deletes an address map
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-delete-address-map
+func (api *API) DeleteAddressMap(ctx context.Context, id string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodDelete, id, "")
+}
+
+// AddIPToAddressMap adds an IP to an address map
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-an-ip-to-an-address-map
+func (api *API) AddIPToAddressMap(ctx context.Context, id, ip string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodPut, id, fmt.Sprintf("ips/%s", ip))
+}
+
+// RemoveIPFromAddressMap removes an IP from an address map
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-an-ip-from-an-address-map
+func (api *API) RemoveIPFromAddressMap(ctx context.Context, id, ip string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodDelete, id, fmt.Sprintf("ips/%s", ip))
+}
+
+// AddZoneToAddressMap applies an address map to a zone
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-a-zone-membership-to-an-address-map
+func (api *API) AddZoneToAddressMap(ctx context.Context, id, zoneID string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodPut, id, fmt.Sprintf("zones/%s", zoneID))
+}
+
+// RemoveZoneFromAddressMap stops applying an address map to a zone
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-a-zone-membership-from-an-address-map
+func (api *API) RemoveZoneFromAddressMap(ctx context.Context, id, zoneID string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodDelete, id, fmt.Sprintf("zones/%s", zoneID))
+}
+
+// AddAccountToAddressMap applies an address map to every zone of an account
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-add-an-account-membership-to-an-address-map
+func (api *API) AddAccountToAddressMap(ctx context.Context, id, accountID string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodPut, id, fmt.Sprintf("accounts/%s", accountID))
+}
+
+// RemoveAccountFromAddressMap stops applying an address map to an account
+//
+// API reference: https://api.cloudflare.com/#ip-address-management-address-maps-remove-an-account-membership-from-an-address-map
+func (api *API) RemoveAccountFromAddressMap(ctx context.Context, id, accountID string) error {
+	return api.addressMapMembershipRequest(ctx, http.MethodDelete, id, fmt.Sprintf("accounts/%s", accountID))
+}
+
+func (api *API) addressMapRequest(ctx context.Context, method, id string, params interface{}) (AddressMap, error) {
+	if err := api.checkAccountID(); err != nil {
+		return AddressMap{}, err
+	}
+	if id == "" {
+		return AddressMap{}, errors.Errorf("address map ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/address_maps/%s", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return AddressMap{}, err
+	}
+
+	result := AddressMapResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return AddressMap{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// addressMapMembershipRequest sends a request to an address map, or to one
+// of its members when path is set, ignoring the result.
+func (api *API) addressMapMembershipRequest(ctx context.Context, method, id, path string) error {
+	if err := api.checkAccountID(); err != nil {
+		return err
+	}
+	if id == "" {
+		return errors.Errorf("address map ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/addressing/address_maps/%s", api.AccountID, id)
+	if path != "" {
+		uri += "/" + path
+	}
+	_, err := api.makeRequestContext(ctx, method, uri, nil)
+	return err
+}
@@ -0,0 +1,180 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAddressMap(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "9a7806061c88ada191ed06f989cc3dac",
+				"description": "My Ecommerce zones",
+				"default_sni": "*.example.com",
+				"enabled": true,
+				"can_delete": true,
+				"can_modify_ips": true,
+				"ips": [
+					{"ip": "192.0.2.1", "created_at": "2023-01-01T05:20:00.12345Z"}
+				],
+				"memberships": [
+					{"identifier": "023e105f4ecef8ad9ca31a8372d0c353", "kind": "zone", "can_delete": true, "created_at": "2023-01-01T05:20:00.12345Z"}
+				],
+				"created_at": "2023-01-01T05:20:00.12345Z",
+				"modified_at": "2023-01-01T05:20:00.12345Z"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/address_maps/9a7806061c88ada191ed06f989cc3dac", handler)
+
+	createdAt, _ := time.Parse(time.RFC3339, "2023-01-01T05:20:00.12345Z")
+	description, defaultSNI, enabled := "My Ecommerce zones", "*.example.com", true
+
+	want := AddressMap{
+		ID:           "9a7806061c88ada191ed06f989cc3dac",
+		Description:  &description,
+		DefaultSNI:   &defaultSNI,
+		Enabled:      &enabled,
+		Deletable:    &enabled,
+		CanModifyIPs: &enabled,
+		IPs:          []AddressMapIP{{IP: "192.0.2.1", CreatedAt: &createdAt}},
+		Memberships: []AddressMapMembership{{
+			Identifier: "023e105f4ecef8ad9ca31a8372d0c353",
+			Kind:       AddressMapMembershipZone,
+			Deletable:  &enabled,
+			CreatedAt:  &createdAt,
+		}},
+		CreatedAt:  &createdAt,
+		ModifiedAt: &createdAt,
+	}
+
+	actual, err := client.GetAddressMap(context.Background(), "9a7806061c88ada191ed06f989cc3dac")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.GetAddressMap(context.Background(), "")
+	assert.EqualError(t, err, "address map ID cannot be empty")
+}
+
+func TestCreateAddressMap(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "My Ecommerce zones",
+				"enabled": false,
+				"ips": ["192.0.2.1"],
+				"memberships": [{"identifier": "023e105f4ecef8ad9ca31a8372d0c353", "kind": "zone"}]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "9a7806061c88ada191ed06f989cc3dac",
+				"description": "My Ecommerce zones",
+				"enabled": false
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/address_maps", handler)
+
+	description, enabled := "My Ecommerce zones", false
+	actual, err := client.CreateAddressMap(context.Background(), CreateAddressMapRequest{
+		Description: &description,
+		Enabled:     &enabled,
+		IPs:         []string{"192.0.2.1"},
+		Memberships: []AddressMapMembership{{Identifier: "023e105f4ecef8ad9ca31a8372d0c353", Kind: AddressMapMembershipZone}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, AddressMap{
+			ID:          "9a7806061c88ada191ed06f989cc3dac",
+			Description: &description,
+			Enabled:     &enabled,
+		}, actual)
+	}
+}
+
+func TestUpdateAddressMap(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"enabled": true}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {"id": "9a7806061c88ada191ed06f989cc3dac", "enabled": true},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/address_maps/9a7806061c88ada191ed06f989cc3dac", handler)
+
+	enabled := true
+	actual, err := client.UpdateAddressMap(context.Background(), "9a7806061c88ada191ed06f989cc3dac", UpdateAddressMapRequest{Enabled: &enabled})
+	if assert.NoError(t, err) {
+		assert.Equal(t, AddressMap{ID: "9a7806061c88ada191ed06f989cc3dac", Enabled: &enabled}, actual)
+	}
+}
+
+func TestAddressMapMemberships(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	var requests []string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"result": [], "success": true, "errors": [], "messages": []}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/addressing/address_maps/9a7806061c88ada191ed06f989cc3dac/", handler)
+
+	id := "9a7806061c88ada191ed06f989cc3dac"
+	assert.NoError(t, client.AddIPToAddressMap(context.Background(), id, "192.0.2.1"))
+	assert.NoError(t, client.RemoveIPFromAddressMap(context.Background(), id, "192.0.2.1"))
+	assert.NoError(t, client.AddZoneToAddressMap(context.Background(), id, testZoneID))
+	assert.NoError(t, client.RemoveZoneFromAddressMap(context.Background(), id, testZoneID))
+	assert.NoError(t, client.AddAccountToAddressMap(context.Background(), id, "bar"))
+	assert.NoError(t, client.RemoveAccountFromAddressMap(context.Background(), id, "bar"))
+
+	prefix := "/accounts/foo/addressing/address_maps/" + id
+	assert.Equal(t, []string{
+		"PUT " + prefix + "/ips/192.0.2.1",
+		"DELETE " + prefix + "/ips/192.0.2.1",
+		"PUT " + prefix + "/zones/" + testZoneID,
+		"DELETE " + prefix + "/zones/" + testZoneID,
+		"PUT " + prefix + "/accounts/bar",
+		"DELETE " + prefix + "/accounts/bar",
+	}, requests)
+}
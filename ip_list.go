@@ -2,21 +2,20 @@ package cloudflare
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"math"
 	"net/http"
 	"time"
-
-	"github.com/pkg/errors"
 )
 
 const (
 	// IPListTypeIP specifies a list containing IP addresses
-	IPListTypeIP = "ip"
+	//
+	// Deprecated: Use ListTypeIP.
+	IPListTypeIP = ListTypeIP
 )
 
 // IPListBulkOperation contains information about a Bulk Operation
+//
+// Deprecated: Use ListBulkOperation.
 type IPListBulkOperation struct {
 	ID        string     `json:"id"`
 	Status    string     `json:"status"`
@@ -25,6 +24,8 @@ type IPListBulkOperation struct {
 }
 
 // IPList contains information about an IP List
+//
+// Deprecated: Use List.
 type IPList struct {
 	ID                    string     `json:"id"`
 	Name                  string     `json:"name"`
@@ -37,6 +38,8 @@ type IPList struct {
 }
 
 // IPListItem contains information about a single IP List Item
+//
+// Deprecated: Use ListItem.
 type IPListItem struct {
 	ID         string     `json:"id"`
 	IP         string     `json:"ip"`
@@ -53,6 +56,8 @@ type IPListCreateRequest struct {
 }
 
 // IPListItemCreateRequest contains data for a new IP List Item
+//
+// Deprecated: Use ListItemCreateRequest.
 type IPListItemCreateRequest struct {
 	IP      string `json:"ip"`
 	Comment string `json:"comment"`
@@ -130,331 +135,248 @@ type IPListItemsGetResponse struct {
 
 // ListIPLists lists all IP Lists
 //
+// Deprecated: Use ListLists, which also supports hostname, ASN and
+// redirect Lists.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-list-lists
 func (api *API) ListIPLists(ctx context.Context) ([]IPList, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists", api.AccountID)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	lists, err := api.ListLists(ctx, api.AccountID)
 	if err != nil {
 		return []IPList{}, err
 	}
 
-	result := IPListListResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return []IPList{}, errors.Wrap(err, errUnmarshalError)
+	result := make([]IPList, 0, len(lists))
+	for _, l := range lists {
+		result = append(result, IPList(l))
 	}
-
-	return result.Result, nil
+	return result, nil
 }
 
 // CreateIPList creates a new IP List
 //
+// Deprecated: Use CreateList.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-create-list
 func (api *API) CreateIPList(ctx context.Context, name string, description string, kind string) (IPList,
 	error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists", api.AccountID)
-	res, err := api.makeRequestContext(ctx, http.MethodPost, uri,
-		IPListCreateRequest{Name: name, Description: description, Kind: kind})
+	list, err := api.CreateList(ctx, api.AccountID, ListCreateParams{Name: name, Description: description, Kind: kind})
 	if err != nil {
 		return IPList{}, err
 	}
-
-	result := IPListResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPList{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result.Result, nil
+	return IPList(list), nil
 }
 
 // GetIPList returns a single IP List
 //
+// Deprecated: Use GetList.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-get-list
 func (api *API) GetIPList(ctx context.Context, id string) (IPList, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	list, err := api.GetList(ctx, api.AccountID, id)
 	if err != nil {
 		return IPList{}, err
 	}
-
-	result := IPListResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPList{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result.Result, nil
+	return IPList(list), nil
 }
 
 // UpdateIPList updates the description of an existing IP List
 //
+// Deprecated: Use UpdateList.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-update-list
 func (api *API) UpdateIPList(ctx context.Context, id string, description string) (IPList, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, IPListUpdateRequest{Description: description})
+	list, err := api.UpdateList(ctx, api.AccountID, id, description)
 	if err != nil {
 		return IPList{}, err
 	}
-
-	result := IPListResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPList{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result.Result, nil
+	return IPList(list), nil
 }
 
 // DeleteIPList deletes an IP List
 //
+// Deprecated: Use DeleteList.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-delete-list
 func (api *API) DeleteIPList(ctx context.Context, id string) (IPListDeleteResponse, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	result, err := api.deleteList(ctx, api.AccountID, id)
 	if err != nil {
 		return IPListDeleteResponse{}, err
 	}
-
-	result := IPListDeleteResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListDeleteResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result, nil
+	return IPListDeleteResponse(result), nil
 }
 
 // ListIPListItems returns a list with all items in an IP List
 //
+// Deprecated: Use ListListItems.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-list-list-items
 func (api *API) ListIPListItems(ctx context.Context, id string) ([]IPListItem, error) {
-	var list []IPListItem
-	var cursor string
-	var cursorQuery string
-
-	for {
-		if len(cursor) > 0 {
-			cursorQuery = fmt.Sprintf("?cursor=%s", cursor)
-		}
-		uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items%s", api.AccountID, id, cursorQuery)
-		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
-		if err != nil {
-			return []IPListItem{}, err
-		}
-
-		result := IPListItemsListResponse{}
-		if err := json.Unmarshal(res, &result); err != nil {
-			return []IPListItem{}, errors.Wrap(err, errUnmarshalError)
-		}
-
-		list = append(list, result.Result...)
-		if cursor = result.ResultInfo.Cursors.After; cursor == "" {
-			break
-		}
+	items, err := api.ListListItems(ctx, api.AccountID, id)
+	if err != nil {
+		return []IPListItem{}, err
 	}
-
-	return list, nil
+	return ipListItems(items), nil
 }
 
 // CreateIPListItemAsync creates a new IP List Item asynchronously. Users have to poll the operation status by
 // using the operation_id returned by this function.
 //
+// Deprecated: Use CreateListItemsAsync.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-create-list-items
 func (api *API) CreateIPListItemAsync(ctx context.Context, id, ip, comment string) (IPListItemCreateResponse, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, []IPListItemCreateRequest{{IP: ip, Comment: comment}})
-	if err != nil {
-		return IPListItemCreateResponse{}, err
-	}
-
-	result := IPListItemCreateResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListItemCreateResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result, nil
+	return api.CreateIPListItemsAsync(ctx, id, []IPListItemCreateRequest{{IP: ip, Comment: comment}})
 }
 
 // CreateIPListItem creates a new IP List Item synchronously and returns the current set of IP List Items
+//
+// Deprecated: Use CreateListItems.
 func (api *API) CreateIPListItem(ctx context.Context, id, ip, comment string) ([]IPListItem, error) {
-	result, err := api.CreateIPListItemAsync(ctx, id, ip, comment)
-
-	if err != nil {
-		return []IPListItem{}, err
-	}
-
-	err = api.pollIPListBulkOperation(ctx, result.Result.OperationID)
-	if err != nil {
-		return []IPListItem{}, err
-	}
-
-	return api.ListIPListItems(ctx, id)
+	return api.CreateIPListItems(ctx, id, []IPListItemCreateRequest{{IP: ip, Comment: comment}})
 }
 
 // CreateIPListItemsAsync bulk creates many IP List Items asynchronously. Users have to poll the operation status by
 // using the operation_id returned by this function.
 //
+// Deprecated: Use CreateListItemsAsync.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-create-list-items
 func (api *API) CreateIPListItemsAsync(ctx context.Context, id string, items []IPListItemCreateRequest) (
 	IPListItemCreateResponse, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, items)
+	result, err := api.listItemsOperation(ctx, http.MethodPost, api.AccountID, id, listItemCreateRequests(items))
 	if err != nil {
 		return IPListItemCreateResponse{}, err
 	}
-
-	result := IPListItemCreateResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListItemCreateResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result, nil
+	return IPListItemCreateResponse(result), nil
 }
 
 // CreateIPListItems bulk creates many IP List Items synchronously and returns the current set of IP List Items
+//
+// Deprecated: Use CreateListItems.
 func (api *API) CreateIPListItems(ctx context.Context, id string, items []IPListItemCreateRequest) (
 	[]IPListItem, error) {
-	result, err := api.CreateIPListItemsAsync(ctx, id, items)
+	result, err := api.CreateListItems(ctx, api.AccountID, id, listItemCreateRequests(items))
 	if err != nil {
 		return []IPListItem{}, err
 	}
-
-	err = api.pollIPListBulkOperation(ctx, result.Result.OperationID)
-	if err != nil {
-		return []IPListItem{}, err
-	}
-
-	return api.ListIPListItems(ctx, id)
+	return ipListItems(result), nil
 }
 
 // ReplaceIPListItemsAsync replaces all IP List Items asynchronously. Users have to poll the operation status by
 // using the operation_id returned by this function.
 //
+// Deprecated: Use ReplaceListItemsAsync.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-replace-list-items
 func (api *API) ReplaceIPListItemsAsync(ctx context.Context, id string, items []IPListItemCreateRequest) (
 	IPListItemCreateResponse, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, items)
+	result, err := api.replaceListItems(ctx, api.AccountID, id, listItemCreateRequests(items))
 	if err != nil {
 		return IPListItemCreateResponse{}, err
 	}
-
-	result := IPListItemCreateResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListItemCreateResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result, nil
+	return IPListItemCreateResponse(result), nil
 }
 
 // ReplaceIPListItems replaces all IP List Items synchronously and returns the current set of IP List Items
+//
+// Deprecated: Use ReplaceListItems.
 func (api *API) ReplaceIPListItems(ctx context.Context, id string, items []IPListItemCreateRequest) (
 	[]IPListItem, error) {
-	result, err := api.ReplaceIPListItemsAsync(ctx, id, items)
+	result, err := api.ReplaceListItems(ctx, api.AccountID, id, listItemCreateRequests(items))
 	if err != nil {
 		return []IPListItem{}, err
 	}
-
-	err = api.pollIPListBulkOperation(ctx, result.Result.OperationID)
-	if err != nil {
-		return []IPListItem{}, err
-	}
-
-	return api.ListIPListItems(ctx, id)
+	return ipListItems(result), nil
 }
 
 // DeleteIPListItemsAsync removes specific Items of an IP List by their ID asynchronously. Users have to poll the
 // operation status by using the operation_id returned by this function.
 //
+// Deprecated: Use DeleteListItemsAsync.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-delete-list-items
 func (api *API) DeleteIPListItemsAsync(ctx context.Context, id string, items IPListItemDeleteRequest) (
 	IPListItemDeleteResponse, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, items)
+	result, err := api.deleteListItems(ctx, api.AccountID, id, items.ids())
 	if err != nil {
 		return IPListItemDeleteResponse{}, err
 	}
-
-	result := IPListItemDeleteResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListItemDeleteResponse{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result, nil
+	return IPListItemDeleteResponse(result), nil
 }
 
 // DeleteIPListItems removes specific Items of an IP List by their ID synchronously and returns the current set
 // of IP List Items
+//
+// Deprecated: Use DeleteListItems.
 func (api *API) DeleteIPListItems(ctx context.Context, id string, items IPListItemDeleteRequest) (
 	[]IPListItem, error) {
-	result, err := api.DeleteIPListItemsAsync(ctx, id, items)
-	if err != nil {
-		return []IPListItem{}, err
-	}
-
-	err = api.pollIPListBulkOperation(ctx, result.Result.OperationID)
+	result, err := api.DeleteListItems(ctx, api.AccountID, id, items.ids())
 	if err != nil {
 		return []IPListItem{}, err
 	}
-
-	return api.ListIPListItems(ctx, id)
+	return ipListItems(result), nil
 }
 
 // GetIPListItem returns a single IP List Item
 //
+// Deprecated: Use GetListItem.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-get-list-item
 func (api *API) GetIPListItem(ctx context.Context, listID, id string) (IPListItem, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/%s/items/%s", api.AccountID, listID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	item, err := api.GetListItem(ctx, api.AccountID, listID, id)
 	if err != nil {
 		return IPListItem{}, err
 	}
-
-	result := IPListItemsGetResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListItem{}, errors.Wrap(err, errUnmarshalError)
-	}
-
-	return result.Result, nil
+	return ipListItem(item), nil
 }
 
 // GetIPListBulkOperation returns the status of a bulk operation
 //
+// Deprecated: Use GetListBulkOperation, or PollListBulkOperation to wait
+// for the operation to finish.
+//
 // API reference: https://api.cloudflare.com/#rules-lists-get-bulk-operation
 func (api *API) GetIPListBulkOperation(ctx context.Context, id string) (IPListBulkOperation, error) {
-	uri := fmt.Sprintf("/accounts/%s/rules/lists/bulk_operations/%s", api.AccountID, id)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	op, err := api.GetListBulkOperation(ctx, api.AccountID, id)
 	if err != nil {
 		return IPListBulkOperation{}, err
 	}
+	return IPListBulkOperation(op), nil
+}
 
-	result := IPListBulkOperationResponse{}
-	if err := json.Unmarshal(res, &result); err != nil {
-		return IPListBulkOperation{}, errors.Wrap(err, errUnmarshalError)
+func (r IPListItemDeleteRequest) ids() []string {
+	ids := make([]string, 0, len(r.Items))
+	for _, item := range r.Items {
+		ids = append(ids, item.ID)
 	}
+	return ids
+}
 
-	return result.Result, nil
-}
-
-// pollIPListBulkOperation implements synchronous behaviour for some asynchronous endpoints.
-// bulk-operation status can be either pending, running, failed or completed
-func (api *API) pollIPListBulkOperation(ctx context.Context, id string) error {
-	var i uint8
-	for i = 0; i < 16; i++ {
-		time.Sleep(0x1 << uint8(math.Ceil(float64(i/2))) * time.Second)
-
-		bulkResult, err := api.GetIPListBulkOperation(ctx, id)
-		if err != nil {
-			return err
-		}
-
-		switch bulkResult.Status {
-		case "failed":
-			return errors.New(bulkResult.Error)
-		case "pending", "running":
-			continue
-		case "completed":
-			return nil
-		default:
-			return errors.New(fmt.Sprintf("%s: %s", errOperationUnexpectedStatus, bulkResult.Status))
-		}
+func listItemCreateRequests(items []IPListItemCreateRequest) []ListItemCreateRequest {
+	if items == nil {
+		return nil
+	}
+	result := make([]ListItemCreateRequest, 0, len(items))
+	for _, item := range items {
+		result = append(result, ListItemCreateRequest{IP: item.IP, Comment: item.Comment})
 	}
+	return result
+}
 
-	return errors.New(errOperationStillRunning)
+func ipListItem(item ListItem) IPListItem {
+	return IPListItem{
+		ID:         item.ID,
+		IP:         item.IP,
+		Comment:    item.Comment,
+		CreatedOn:  item.CreatedOn,
+		ModifiedOn: item.ModifiedOn,
+	}
+}
+
+func ipListItems(items []ListItem) []IPListItem {
+	result := make([]IPListItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, ipListItem(item))
+	}
+	return result
 }
@@ -462,3 +462,37 @@ func TestGetIPListItem(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestCreateIPListItemsWaitsForOperation(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	mux.HandleFunc("/accounts/foo/rules/lists/2c0fc9fa937b11eaa1b71c4d701ab86e/items", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [{"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "ip": "192.0.2.1", "comment": "Private IP address"}],
+				"result_info": {"cursors": {}}
+			}`)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	})
+	mux.HandleFunc("/accounts/foo/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4da8780eeb215e6cb7f48dd981c4ea02", "status": "completed"}}`)
+	})
+
+	actual, err := client.CreateIPListItems(context.Background(), "2c0fc9fa937b11eaa1b71c4d701ab86e",
+		[]IPListItemCreateRequest{{IP: "192.0.2.1", Comment: "Private IP address"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []IPListItem{{ID: "2c0fc9fa937b11eaa1b71c4d701ab86e", IP: "192.0.2.1", Comment: "Private IP address"}}, actual)
+	}
+}
@@ -12,24 +12,33 @@ import (
 
 // KeylessSSL represents Keyless SSL configuration.
 type KeylessSSL struct {
-	ID          string    `json:"id"`
-	Name        string    `json:"name"`
-	Host        string    `json:"host"`
-	Port        int       `json:"port"`
-	Status      string    `json:"status"`
-	Enabled     bool      `json:"enabled"`
-	Permissions []string  `json:"permissions"`
-	CreatedOn   time.Time `json:"created_on"`
-	ModifiedOn  time.Time `json:"modified_on"`
+	ID          string            `json:"id"`
+	Name        string            `json:"name"`
+	Host        string            `json:"host"`
+	Port        int               `json:"port"`
+	Status      string            `json:"status"`
+	Enabled     bool              `json:"enabled"`
+	Permissions []string          `json:"permissions"`
+	Tunnel      *KeylessSSLTunnel `json:"tunnel,omitempty"`
+	CreatedOn   time.Time         `json:"created_on"`
+	ModifiedOn  time.Time         `json:"modified_on"`
+}
+
+// KeylessSSLTunnel represents the Cloudflare Tunnel used to reach a key
+// server on a private network.
+type KeylessSSLTunnel struct {
+	PrivateIP string `json:"private_ip"`
+	VnetID    string `json:"vnet_id"`
 }
 
 // KeylessSSLCreateRequest represents the request format made for creating KeylessSSL.
 type KeylessSSLCreateRequest struct {
-	Host         string `json:"host"`
-	Port         int    `json:"port"`
-	Certificate  string `json:"certificate"`
-	Name         string `json:"name,omitempty"`
-	BundleMethod string `json:"bundle_method,omitempty"`
+	Host         string            `json:"host"`
+	Port         int               `json:"port"`
+	Certificate  string            `json:"certificate"`
+	Name         string            `json:"name,omitempty"`
+	BundleMethod string            `json:"bundle_method,omitempty"`
+	Tunnel       *KeylessSSLTunnel `json:"tunnel,omitempty"`
 }
 
 // KeylessSSLDetailResponse is the API response, containing a single Keyless SSL.
@@ -46,10 +55,11 @@ type KeylessSSLListResponse struct {
 
 // KeylessSSLUpdateRequest represents the request for updating KeylessSSL.
 type KeylessSSLUpdateRequest struct {
-	Host    string `json:"host,omitempty"`
-	Name    string `json:"name,omitempty"`
-	Port    int    `json:"port,omitempty"`
-	Enabled *bool  `json:"enabled,omitempty"`
+	Host    string            `json:"host,omitempty"`
+	Name    string            `json:"name,omitempty"`
+	Port    int               `json:"port,omitempty"`
+	Enabled *bool             `json:"enabled,omitempty"`
+	Tunnel  *KeylessSSLTunnel `json:"tunnel,omitempty"`
 }
 
 // CreateKeylessSSL creates a new Keyless SSL configuration for the zone.
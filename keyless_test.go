@@ -75,6 +75,71 @@ func TestCreateKeylessSSL(t *testing.T) {
 	assert.Equal(t, want, actual)
 }
 
+func TestCreateKeylessSSLWithTunnel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	input := KeylessSSLCreateRequest{
+		Host:        "keyless.internal",
+		Port:        2407,
+		Certificate: "-----BEGIN CERTIFICATE----- MIIDtTCCAp2g1v2tdw= -----END CERTIFICATE-----",
+		Tunnel: &KeylessSSLTunnel{
+			PrivateIP: "10.0.0.1",
+			VnetID:    "7365377a-85a4-4390-9480-531ef7dc7a3c",
+		},
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+
+		var v KeylessSSLCreateRequest
+		err := json.NewDecoder(r.Body).Decode(&v)
+		require.NoError(t, err)
+		assert.Equal(t, input, v)
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "4d2844d2ce78891c34d0b6c0535a291e",
+				"name": "",
+				"host": "keyless.internal",
+				"port": 2407,
+				"status": "active",
+				"enabled": true,
+				"permissions": [],
+				"tunnel": {
+					"private_ip": "10.0.0.1",
+					"vnet_id": "7365377a-85a4-4390-9480-531ef7dc7a3c"
+				},
+				"created_on": "2014-01-01T05:20:00Z",
+				"modified_on": "2014-01-01T05:20:00Z"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/keyless_certificates", handler)
+
+	actual, err := client.CreateKeylessSSL(context.Background(), testZoneID, input)
+	require.NoError(t, err)
+
+	createdOn, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00Z")
+	want := KeylessSSL{
+		ID:          "4d2844d2ce78891c34d0b6c0535a291e",
+		Host:        input.Host,
+		Port:        input.Port,
+		Status:      "active",
+		Enabled:     true,
+		Permissions: []string{},
+		Tunnel:      input.Tunnel,
+		CreatedOn:   createdOn,
+		ModifiedOn:  createdOn,
+	}
+	assert.Equal(t, want, actual)
+}
+
 func TestListKeylessSSL(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,506 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// List kinds, which determine the type of the items a List holds.
+const (
+	ListTypeIP       = "ip"
+	ListTypeHostname = "hostname"
+	ListTypeASN      = "asn"
+	ListTypeRedirect = "redirect"
+)
+
+var validListTypes = []string{ListTypeIP, ListTypeHostname, ListTypeASN, ListTypeRedirect}
+
+// Bulk operation statuses.
+const (
+	ListBulkOperationStatusPending   = "pending"
+	ListBulkOperationStatusRunning   = "running"
+	ListBulkOperationStatusCompleted = "completed"
+	ListBulkOperationStatusFailed    = "failed"
+)
+
+// listBulkOperationPollInterval is the delay before the first status check
+// of a pending bulk operation. It doubles on every attempt up to
+// listBulkOperationMaxPollInterval.
+var (
+	listBulkOperationPollInterval    = time.Second
+	listBulkOperationMaxPollInterval = 30 * time.Second
+	listBulkOperationMaxPolls        = 16
+)
+
+// List is an account level list that can be referenced from rule
+// expressions.
+type List struct {
+	ID                    string     `json:"id"`
+	Name                  string     `json:"name"`
+	Description           string     `json:"description"`
+	Kind                  string     `json:"kind"`
+	NumItems              int        `json:"num_items"`
+	NumReferencingFilters int        `json:"num_referencing_filters"`
+	CreatedOn             *time.Time `json:"created_on"`
+	ModifiedOn            *time.Time `json:"modified_on"`
+}
+
+// ListItem is a single item of a List. Exactly one of IP, Hostname, ASN and
+// Redirect is set, depending on the kind of the List.
+type ListItem struct {
+	ID         string            `json:"id"`
+	IP         string            `json:"ip,omitempty"`
+	Hostname   *ListItemHostname `json:"hostname,omitempty"`
+	ASN        *uint32           `json:"asn,omitempty"`
+	Redirect   *ListItemRedirect `json:"redirect,omitempty"`
+	Comment    string            `json:"comment"`
+	CreatedOn  *time.Time        `json:"created_on"`
+	ModifiedOn *time.Time        `json:"modified_on"`
+}
+
+// ListItemHostname is the item of a hostname List.
+type ListItemHostname struct {
+	URLHostname string `json:"url_hostname"`
+}
+
+// ListItemRedirect is the item of a redirect List, matching requests to
+// SourceURL and redirecting them to TargetURL.
+type ListItemRedirect struct {
+	SourceURL           string `json:"source_url"`
+	TargetURL           string `json:"target_url"`
+	StatusCode          *int   `json:"status_code,omitempty"`
+	IncludeSubdomains   *bool  `json:"include_subdomains,omitempty"`
+	SubpathMatching     *bool  `json:"subpath_matching,omitempty"`
+	PreserveQueryString *bool  `json:"preserve_query_string,omitempty"`
+	PreservePathSuffix  *bool  `json:"preserve_path_suffix,omitempty"`
+}
+
+// ListCreateParams contains the data for a new List.
+type ListCreateParams struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Kind        string `json:"kind"`
+}
+
+// ListItemCreateRequest contains the data for a new List item. Set the
+// field matching the kind of the List.
+type ListItemCreateRequest struct {
+	IP       string            `json:"ip,omitempty"`
+	Hostname *ListItemHostname `json:"hostname,omitempty"`
+	ASN      *uint32           `json:"asn,omitempty"`
+	Redirect *ListItemRedirect `json:"redirect,omitempty"`
+	Comment  string            `json:"comment"`
+}
+
+// ListBulkOperation is the status of an asynchronous write to the items of
+// a List.
+type ListBulkOperation struct {
+	ID        string     `json:"id"`
+	Status    string     `json:"status"`
+	Error     string     `json:"error"`
+	Completed *time.Time `json:"completed"`
+}
+
+// ListResponse is the API response containing a single List.
+type ListResponse struct {
+	Response
+	Result List `json:"result"`
+}
+
+// ListListResponse is the API response containing Lists.
+type ListListResponse struct {
+	Response
+	Result []List `json:"result"`
+}
+
+// ListItemResponse is the API response containing a single List item.
+type ListItemResponse struct {
+	Response
+	Result ListItem `json:"result"`
+}
+
+// ListItemsListResponse is the API response containing a page of List items.
+type ListItemsListResponse struct {
+	Response
+	ResultInfo `json:"result_info"`
+	Result     []ListItem `json:"result"`
+}
+
+// ListBulkOperationResponse is the API response containing a bulk operation.
+type ListBulkOperationResponse struct {
+	Response
+	Result ListBulkOperation `json:"result"`
+}
+
+// ListOperationResponse is the API response to an asynchronous write to the
+// items of a List.
+type ListOperationResponse struct {
+	Response
+	Result struct {
+		OperationID string `json:"operation_id"`
+	} `json:"result"`
+}
+
+// ListDeleteResponse is the API response to the deletion of a List.
+type ListDeleteResponse struct {
+	Response
+	Result struct {
+		ID string `json:"id"`
+	} `json:"result"`
+}
+
+// ListLists returns the Lists of an account.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-list-lists
+func (api *API) ListLists(ctx context.Context, accountID string) ([]List, error) {
+	if accountID == "" {
+		return []List{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/rules/lists", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []List{}, err
+	}
+
+	var r ListListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []List{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateList creates a new List.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-create-list
+func (api *API) CreateList(ctx context.Context, accountID string, params ListCreateParams) (List, error) {
+	if accountID == "" {
+		return List{}, errors.New(errMissingAccountID)
+	}
+	if params.Name == "" {
+		return List{}, errors.New("list name cannot be empty")
+	}
+	if params.Kind == "" {
+		return List{}, errors.New("list kind cannot be empty")
+	}
+	if !contains(validListTypes, params.Kind) {
+		return List{}, errors.Errorf("invalid list kind %q", params.Kind)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/rules/lists", accountID)
+	return api.listRequest(ctx, http.MethodPost, uri, params)
+}
+
+// GetList returns a single List.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-get-list
+func (api *API) GetList(ctx context.Context, accountID, listID string) (List, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return List{}, err
+	}
+	return api.listRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateList updates the description of a List.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-update-list
+func (api *API) UpdateList(ctx context.Context, accountID, listID, description string) (List, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return List{}, err
+	}
+
+	params := struct {
+		Description string `json:"description"`
+	}{Description: description}
+	return api.listRequest(ctx, http.MethodPut, uri, params)
+}
+
+// DeleteList deletes a List. Lists referenced from filters cannot be
+// deleted.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-delete-list
+func (api *API) DeleteList(ctx context.Context, accountID, listID string) error {
+	_, err := api.deleteList(ctx, accountID, listID)
+	return err
+}
+
+// ListListItems returns all items of a List, following the cursors of the
+// paginated API.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-list-list-items
+func (api *API) ListListItems(ctx context.Context, accountID, listID string) ([]ListItem, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return []ListItem{}, err
+	}
+
+	var items []ListItem
+	var cursor string
+	for {
+		itemsURI := uri + "/items"
+		if cursor != "" {
+			itemsURI = fmt.Sprintf("%s?%s", itemsURI, url.Values{"cursor": {cursor}}.Encode())
+		}
+
+		res, err := api.makeRequestContext(ctx, http.MethodGet, itemsURI, nil)
+		if err != nil {
+			return []ListItem{}, err
+		}
+
+		var r ListItemsListResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []ListItem{}, errors.Wrap(err, errUnmarshalError)
+		}
+
+		items = append(items, r.Result...)
+		if cursor = r.ResultInfo.Cursors.After; cursor == "" {
+			break
+		}
+	}
+	return items, nil
+}
+
+// GetListItem returns a single item of a List.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-get-list-item
+func (api *API) GetListItem(ctx context.Context, accountID, listID, itemID string) (ListItem, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return ListItem{}, err
+	}
+	if itemID == "" {
+		return ListItem{}, errors.New("list item ID cannot be empty")
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, fmt.Sprintf("%s/items/%s", uri, itemID), nil)
+	if err != nil {
+		return ListItem{}, err
+	}
+
+	var r ListItemResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListItem{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateListItemsAsync appends items to a List and returns the ID of the
+// bulk operation performing the write, which can be waited on with
+// PollListBulkOperation.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-create-list-items
+func (api *API) CreateListItemsAsync(ctx context.Context, accountID, listID string, items []ListItemCreateRequest) (string, error) {
+	r, err := api.listItemsOperation(ctx, http.MethodPost, accountID, listID, items)
+	return r.Result.OperationID, err
+}
+
+// CreateListItems appends items to a List, waits for the write to complete
+// and returns the resulting items of the List.
+func (api *API) CreateListItems(ctx context.Context, accountID, listID string, items []ListItemCreateRequest) ([]ListItem, error) {
+	operationID, err := api.CreateListItemsAsync(ctx, accountID, listID, items)
+	if err != nil {
+		return []ListItem{}, err
+	}
+	return api.listItemsAfterOperation(ctx, accountID, listID, operationID)
+}
+
+// ReplaceListItemsAsync replaces all items of a List and returns the ID of
+// the bulk operation performing the write.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-replace-list-items
+func (api *API) ReplaceListItemsAsync(ctx context.Context, accountID, listID string, items []ListItemCreateRequest) (string, error) {
+	r, err := api.replaceListItems(ctx, accountID, listID, items)
+	return r.Result.OperationID, err
+}
+
+// ReplaceListItems replaces all items of a List, waits for the write to
+// complete and returns the resulting items of the List.
+func (api *API) ReplaceListItems(ctx context.Context, accountID, listID string, items []ListItemCreateRequest) ([]ListItem, error) {
+	operationID, err := api.ReplaceListItemsAsync(ctx, accountID, listID, items)
+	if err != nil {
+		return []ListItem{}, err
+	}
+	return api.listItemsAfterOperation(ctx, accountID, listID, operationID)
+}
+
+// DeleteListItemsAsync removes items from a List by ID and returns the ID
+// of the bulk operation performing the write.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-delete-list-items
+func (api *API) DeleteListItemsAsync(ctx context.Context, accountID, listID string, itemIDs []string) (string, error) {
+	r, err := api.deleteListItems(ctx, accountID, listID, itemIDs)
+	return r.Result.OperationID, err
+}
+
+// DeleteListItems removes items from a List by ID, waits for the write to
+// complete and returns the remaining items of the List.
+func (api *API) DeleteListItems(ctx context.Context, accountID, listID string, itemIDs []string) ([]ListItem, error) {
+	operationID, err := api.DeleteListItemsAsync(ctx, accountID, listID, itemIDs)
+	if err != nil {
+		return []ListItem{}, err
+	}
+	return api.listItemsAfterOperation(ctx, accountID, listID, operationID)
+}
+
+// GetListBulkOperation returns the status of a bulk operation.
+//
+// API reference: https://api.cloudflare.com/#rules-lists-get-bulk-operation
+func (api *API) GetListBulkOperation(ctx context.Context, accountID, operationID string) (ListBulkOperation, error) {
+	if accountID == "" {
+		return ListBulkOperation{}, errors.New(errMissingAccountID)
+	}
+	if operationID == "" {
+		return ListBulkOperation{}, errors.New("operation ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/rules/lists/bulk_operations/%s", accountID, operationID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ListBulkOperation{}, err
+	}
+
+	var r ListBulkOperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListBulkOperation{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PollListBulkOperation waits for a bulk operation to finish, checking its
+// status with exponential backoff. It returns an error if the operation
+// failed, did not finish in time or ctx is done first.
+func (api *API) PollListBulkOperation(ctx context.Context, accountID, operationID string) error {
+	delay := listBulkOperationPollInterval
+	for i := 0; i < listBulkOperationMaxPolls; i++ {
+		op, err := api.GetListBulkOperation(ctx, accountID, operationID)
+		if err != nil {
+			return err
+		}
+
+		switch op.Status {
+		case ListBulkOperationStatusCompleted:
+			return nil
+		case ListBulkOperationStatusFailed:
+			return errors.New(op.Error)
+		case ListBulkOperationStatusPending, ListBulkOperationStatusRunning:
+		default:
+			return errors.Errorf("%s: %s", errOperationUnexpectedStatus, op.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+		if delay *= 2; delay > listBulkOperationMaxPollInterval {
+			delay = listBulkOperationMaxPollInterval
+		}
+	}
+	return errors.New(errOperationStillRunning)
+}
+
+func (api *API) deleteList(ctx context.Context, accountID, listID string) (ListDeleteResponse, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return ListDeleteResponse{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return ListDeleteResponse{}, err
+	}
+
+	var r ListDeleteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListDeleteResponse{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r, nil
+}
+
+func (api *API) replaceListItems(ctx context.Context, accountID, listID string, items []ListItemCreateRequest) (ListOperationResponse, error) {
+	if items == nil {
+		// An empty list clears the List; null is rejected by the API.
+		items = []ListItemCreateRequest{}
+	}
+	return api.listItemsOperation(ctx, http.MethodPut, accountID, listID, items)
+}
+
+func (api *API) deleteListItems(ctx context.Context, accountID, listID string, itemIDs []string) (ListOperationResponse, error) {
+	if len(itemIDs) == 0 {
+		return ListOperationResponse{}, errors.New("list item IDs cannot be empty")
+	}
+
+	type item struct {
+		ID string `json:"id"`
+	}
+	params := struct {
+		Items []item `json:"items"`
+	}{}
+	for _, id := range itemIDs {
+		params.Items = append(params.Items, item{ID: id})
+	}
+	return api.listItemsOperation(ctx, http.MethodDelete, accountID, listID, params)
+}
+
+func (api *API) listItemsOperation(ctx context.Context, method, accountID, listID string, params interface{}) (ListOperationResponse, error) {
+	uri, err := listURI(accountID, listID)
+	if err != nil {
+		return ListOperationResponse{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, method, uri+"/items", params)
+	if err != nil {
+		return ListOperationResponse{}, err
+	}
+
+	var r ListOperationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ListOperationResponse{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r, nil
+}
+
+func (api *API) listItemsAfterOperation(ctx context.Context, accountID, listID, operationID string) ([]ListItem, error) {
+	if err := api.PollListBulkOperation(ctx, accountID, operationID); err != nil {
+		return []ListItem{}, err
+	}
+	return api.ListListItems(ctx, accountID, listID)
+}
+
+func (api *API) listRequest(ctx context.Context, method, uri string, params interface{}) (List, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return List{}, err
+	}
+
+	var r ListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return List{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func listURI(accountID, listID string) (string, error) {
+	if accountID == "" {
+		return "", errors.New(errMissingAccountID)
+	}
+	if listID == "" {
+		return "", errors.New("list ID cannot be empty")
+	}
+	return fmt.Sprintf("/accounts/%s/rules/lists/%s", accountID, listID), nil
+}
@@ -0,0 +1,442 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testListID = "2c0fc9fa937b11eaa1b71c4d701ab86e"
+
+func TestListLists(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+					"name": "list1",
+					"description": "This is a note.",
+					"kind": "ip",
+					"num_items": 10,
+					"num_referencing_filters": 2,
+					"created_on": "2020-01-01T08:00:00Z",
+					"modified_on": "2020-01-10T14:00:00Z"
+				}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists", handler)
+
+	createdOn, _ := time.Parse(time.RFC3339, "2020-01-01T08:00:00Z")
+	modifiedOn, _ := time.Parse(time.RFC3339, "2020-01-10T14:00:00Z")
+
+	actual, err := client.ListLists(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []List{{
+			ID:                    testListID,
+			Name:                  "list1",
+			Description:           "This is a note.",
+			Kind:                  ListTypeIP,
+			NumItems:              10,
+			NumReferencingFilters: 2,
+			CreatedOn:             &createdOn,
+			ModifiedOn:            &modifiedOn,
+		}}, actual)
+	}
+
+	_, err = client.ListLists(context.Background(), "")
+	assert.EqualError(t, err, errMissingAccountID)
+}
+
+func TestCreateList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "list1", "description": "This is a note.", "kind": "ip"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "list1", "description": "This is a note.", "kind": "ip"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists", handler)
+
+	actual, err := client.CreateList(context.Background(), testAccountID, ListCreateParams{Name: "list1", Description: "This is a note.", Kind: ListTypeIP})
+	if assert.NoError(t, err) {
+		assert.Equal(t, List{ID: testListID, Name: "list1", Description: "This is a note.", Kind: ListTypeIP}, actual)
+	}
+
+	_, err = client.CreateList(context.Background(), testAccountID, ListCreateParams{Kind: ListTypeIP})
+	assert.EqualError(t, err, "list name cannot be empty")
+
+	_, err = client.CreateList(context.Background(), testAccountID, ListCreateParams{Name: "list1"})
+	assert.EqualError(t, err, "list kind cannot be empty")
+
+	_, err = client.CreateList(context.Background(), testAccountID, ListCreateParams{Name: "list1", Kind: "url"})
+	assert.EqualError(t, err, `invalid list kind "url"`)
+}
+
+func TestGetList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "list1", "kind": "ip", "num_items": 3}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID, handler)
+
+	actual, err := client.GetList(context.Background(), testAccountID, testListID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, List{ID: testListID, Name: "list1", Kind: ListTypeIP, NumItems: 3}, actual)
+	}
+
+	_, err = client.GetList(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "list ID cannot be empty")
+}
+
+func TestUpdateList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"description": "Updated note."}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "list1", "description": "Updated note.", "kind": "ip"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID, handler)
+
+	actual, err := client.UpdateList(context.Background(), testAccountID, testListID, "Updated note.")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "Updated note.", actual.Description)
+	}
+}
+
+func TestDeleteList(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID, handler)
+
+	assert.NoError(t, client.DeleteList(context.Background(), testAccountID, testListID))
+}
+
+func TestListListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		if r.URL.Query().Get("cursor") == "" {
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": [{"id": "a", "ip": "192.0.2.1", "comment": "first"}],
+				"result_info": {"cursors": {"after": "yyy"}}
+			}`)
+			return
+		}
+		assert.Equal(t, "yyy", r.URL.Query().Get("cursor"))
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "b", "ip": "192.0.2.2", "comment": "second"}],
+			"result_info": {"cursors": {}}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", handler)
+
+	actual, err := client.ListListItems(context.Background(), testAccountID, testListID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ListItem{
+			{ID: "a", IP: "192.0.2.1", Comment: "first"},
+			{ID: "b", IP: "192.0.2.2", Comment: "second"},
+		}, actual)
+	}
+}
+
+func TestListListItemsTyped(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "a", "hostname": {"url_hostname": "example.com"}, "comment": ""},
+				{"id": "b", "asn": 13335, "comment": "Cloudflare"},
+				{
+					"id": "c",
+					"redirect": {
+						"source_url": "example.com/blog",
+						"target_url": "https://example.net/news",
+						"status_code": 301,
+						"include_subdomains": true,
+						"subpath_matching": true,
+						"preserve_query_string": false,
+						"preserve_path_suffix": true
+					},
+					"comment": ""
+				}
+			],
+			"result_info": {"cursors": {}}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", handler)
+
+	asn := uint32(13335)
+	status := 301
+	yes, no := true, false
+	actual, err := client.ListListItems(context.Background(), testAccountID, testListID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ListItem{
+			{ID: "a", Hostname: &ListItemHostname{URLHostname: "example.com"}},
+			{ID: "b", ASN: &asn, Comment: "Cloudflare"},
+			{ID: "c", Redirect: &ListItemRedirect{
+				SourceURL:           "example.com/blog",
+				TargetURL:           "https://example.net/news",
+				StatusCode:          &status,
+				IncludeSubdomains:   &yes,
+				SubpathMatching:     &yes,
+				PreserveQueryString: &no,
+				PreservePathSuffix:  &yes,
+			}},
+		}, actual)
+	}
+}
+
+func TestCreateListItemsAsyncRedirect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[{"redirect": {"source_url": "example.com/a", "target_url": "https://example.com/b", "preserve_query_string": true}, "comment": "moved"}]`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", handler)
+
+	preserveQueryString := true
+	_, err := client.CreateListItemsAsync(context.Background(), testAccountID, testListID, []ListItemCreateRequest{{
+		Redirect: &ListItemRedirect{SourceURL: "example.com/a", TargetURL: "https://example.com/b", PreserveQueryString: &preserveQueryString},
+		Comment:  "moved",
+	}})
+	assert.NoError(t, err)
+}
+
+func TestGetListItem(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "a", "ip": "192.0.2.1", "comment": "first"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items/a", handler)
+
+	actual, err := client.GetListItem(context.Background(), testAccountID, testListID, "a")
+	if assert.NoError(t, err) {
+		assert.Equal(t, ListItem{ID: "a", IP: "192.0.2.1", Comment: "first"}, actual)
+	}
+
+	_, err = client.GetListItem(context.Background(), testAccountID, testListID, "")
+	assert.EqualError(t, err, "list item ID cannot be empty")
+}
+
+func TestCreateListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	itemsHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			body, err := ioutil.ReadAll(r.Body)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `[{"ip": "192.0.2.1", "comment": "first"}]`, string(body))
+			}
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+		case http.MethodGet:
+			fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "a", "ip": "192.0.2.1", "comment": "first"}], "result_info": {}}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}
+	operationHandler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "4da8780eeb215e6cb7f48dd981c4ea02", "status": "completed"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", itemsHandler)
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/4da8780eeb215e6cb7f48dd981c4ea02", operationHandler)
+
+	actual, err := client.CreateListItems(context.Background(), testAccountID, testListID, []ListItemCreateRequest{{IP: "192.0.2.1", Comment: "first"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ListItem{{ID: "a", IP: "192.0.2.1", Comment: "first"}}, actual)
+	}
+}
+
+func TestReplaceListItemsAsync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `[]`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", handler)
+
+	actual, err := client.ReplaceListItemsAsync(context.Background(), testAccountID, testListID, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4da8780eeb215e6cb7f48dd981c4ea02", actual)
+	}
+}
+
+func TestDeleteListItemsAsync(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"items": [{"id": "a"}, {"id": "b"}]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"operation_id": "4da8780eeb215e6cb7f48dd981c4ea02"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/"+testListID+"/items", handler)
+
+	actual, err := client.DeleteListItemsAsync(context.Background(), testAccountID, testListID, []string{"a", "b"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "4da8780eeb215e6cb7f48dd981c4ea02", actual)
+	}
+
+	_, err = client.DeleteListItemsAsync(context.Background(), testAccountID, testListID, nil)
+	assert.EqualError(t, err, "list item IDs cannot be empty")
+}
+
+func TestPollListBulkOperation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	interval := listBulkOperationPollInterval
+	listBulkOperationPollInterval = time.Millisecond
+	defer func() { listBulkOperationPollInterval = interval }()
+
+	statuses := []string{"pending", "running", "completed"}
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "op", "status": "%s"}}`, statuses[calls])
+		calls++
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/op", handler)
+
+	assert.NoError(t, client.PollListBulkOperation(context.Background(), testAccountID, "op"))
+	assert.Equal(t, 3, calls)
+}
+
+func TestPollListBulkOperationFailed(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "op", "status": "failed", "error": "This list is at the maximum number of items"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/op", handler)
+
+	err := client.PollListBulkOperation(context.Background(), testAccountID, "op")
+	assert.EqualError(t, err, "This list is at the maximum number of items")
+}
+
+func TestPollListBulkOperationContextDone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "op", "status": "running"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/rules/lists/bulk_operations/op", handler)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := client.PollListBulkOperation(ctx, testAccountID, "op")
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -12,19 +13,21 @@ import (
 
 // LoadBalancerPool represents a load balancer pool's properties.
 type LoadBalancerPool struct {
-	ID                string                    `json:"id,omitempty"`
-	CreatedOn         *time.Time                `json:"created_on,omitempty"`
-	ModifiedOn        *time.Time                `json:"modified_on,omitempty"`
-	Description       string                    `json:"description"`
-	Name              string                    `json:"name"`
-	Enabled           bool                      `json:"enabled"`
-	MinimumOrigins    int                       `json:"minimum_origins,omitempty"`
-	Monitor           string                    `json:"monitor,omitempty"`
-	Origins           []LoadBalancerOrigin      `json:"origins"`
-	NotificationEmail string                    `json:"notification_email,omitempty"`
-	Latitude          *float32                  `json:"latitude,omitempty"`
-	Longitude         *float32                  `json:"longitude,omitempty"`
-	LoadShedding      *LoadBalancerLoadShedding `json:"load_shedding,omitempty"`
+	ID                 string                          `json:"id,omitempty"`
+	CreatedOn          *time.Time                      `json:"created_on,omitempty"`
+	ModifiedOn         *time.Time                      `json:"modified_on,omitempty"`
+	Description        string                          `json:"description"`
+	Name               string                          `json:"name"`
+	Enabled            bool                            `json:"enabled"`
+	MinimumOrigins     int                             `json:"minimum_origins,omitempty"`
+	Monitor            string                          `json:"monitor,omitempty"`
+	Origins            []LoadBalancerOrigin            `json:"origins"`
+	NotificationEmail  string                          `json:"notification_email,omitempty"`
+	Latitude           *float32                        `json:"latitude,omitempty"`
+	Longitude          *float32                        `json:"longitude,omitempty"`
+	LoadShedding       *LoadBalancerLoadShedding       `json:"load_shedding,omitempty"`
+	NotificationFilter *LoadBalancerNotificationFilter `json:"notification_filter,omitempty"`
+	OriginSteering     *LoadBalancerOriginSteering     `json:"origin_steering,omitempty"`
 
 	// CheckRegions defines the geographic region(s) from where to run health-checks from - e.g. "WNAM", "WEU", "SAF", "SAM".
 	// Providing a null/empty value means "all regions", which may not be available to all plan types.
@@ -38,6 +41,61 @@ type LoadBalancerOrigin struct {
 	Enabled bool                `json:"enabled"`
 	Weight  float64             `json:"weight"`
 	Header  map[string][]string `json:"header"`
+	// VirtualNetworkID is the virtual network subnet ID the origin belongs
+	// in, used for origins only reachable through a Cloudflare Tunnel.
+	VirtualNetworkID string `json:"virtual_network_id,omitempty"`
+}
+
+// LoadBalancerOriginSteering controls how traffic is distributed across the
+// origins of a pool.
+type LoadBalancerOriginSteering struct {
+	// Policy is one of the OriginSteering* constants. Origin weights are
+	// taken into account by all policies.
+	Policy OriginSteeringPolicy `json:"policy,omitempty"`
+}
+
+// OriginSteeringPolicy is the policy used to distribute traffic across the
+// origins of a pool.
+type OriginSteeringPolicy string
+
+// These constants represent all valid origin steering policies.
+const (
+	OriginSteeringRandom                   OriginSteeringPolicy = "random"
+	OriginSteeringHash                     OriginSteeringPolicy = "hash"
+	OriginSteeringLeastOutstandingRequests OriginSteeringPolicy = "least_outstanding_requests"
+	OriginSteeringLeastConnections         OriginSteeringPolicy = "least_connections"
+)
+
+// LoadBalancerNotificationFilter filters the health notifications sent for
+// a pool and its origins.
+type LoadBalancerNotificationFilter struct {
+	Origin *LoadBalancerNotificationFilterTarget `json:"origin,omitempty"`
+	Pool   *LoadBalancerNotificationFilterTarget `json:"pool,omitempty"`
+}
+
+// LoadBalancerNotificationFilterTarget configures notifications for a pool
+// or its origins. A nil Healthy sends notifications on any health change.
+type LoadBalancerNotificationFilterTarget struct {
+	Disable bool  `json:"disable,omitempty"`
+	Healthy *bool `json:"healthy,omitempty"`
+}
+
+// LoadBalancerPoolPatchParams holds the pool fields to change with
+// PatchLoadBalancerPool. Unset fields are left unchanged.
+type LoadBalancerPoolPatchParams struct {
+	Description        string                          `json:"description,omitempty"`
+	Name               string                          `json:"name,omitempty"`
+	Enabled            *bool                           `json:"enabled,omitempty"`
+	MinimumOrigins     *int                            `json:"minimum_origins,omitempty"`
+	Monitor            string                          `json:"monitor,omitempty"`
+	Origins            []LoadBalancerOrigin            `json:"origins,omitempty"`
+	NotificationEmail  string                          `json:"notification_email,omitempty"`
+	NotificationFilter *LoadBalancerNotificationFilter `json:"notification_filter,omitempty"`
+	OriginSteering     *LoadBalancerOriginSteering     `json:"origin_steering,omitempty"`
+	Latitude           *float32                        `json:"latitude,omitempty"`
+	Longitude          *float32                        `json:"longitude,omitempty"`
+	LoadShedding       *LoadBalancerLoadShedding       `json:"load_shedding,omitempty"`
+	CheckRegions       []string                        `json:"check_regions,omitempty"`
 }
 
 // LoadBalancerMonitor represents a load balancer monitor's properties.
@@ -59,35 +117,194 @@ type LoadBalancerMonitor struct {
 	FollowRedirects bool                `json:"follow_redirects"`
 	AllowInsecure   bool                `json:"allow_insecure"`
 	ProbeZone       string              `json:"probe_zone"`
-}
+
+	// ConsecutiveUp is the number of consecutive successful health checks
+	// required before an origin is marked healthy.
+	ConsecutiveUp int `json:"consecutive_up,omitempty"`
+	// ConsecutiveDown is the number of consecutive failed health checks
+	// required before an origin is marked unhealthy.
+	ConsecutiveDown int `json:"consecutive_down,omitempty"`
+}
+
+// These constants represent all valid load balancer monitor types. Path,
+// Header, ExpectedBody, ExpectedCodes, FollowRedirects and AllowInsecure only
+// apply to HTTP and HTTPS monitors. TCP monitors use the
+// "connection_established" method.
+const (
+	LoadBalancerMonitorTypeHTTP     = "http"
+	LoadBalancerMonitorTypeHTTPS    = "https"
+	LoadBalancerMonitorTypeTCP      = "tcp"
+	LoadBalancerMonitorTypeUDPICMP  = "udp_icmp"
+	LoadBalancerMonitorTypeICMPPing = "icmp_ping"
+	LoadBalancerMonitorTypeSMTP     = "smtp"
+)
 
 // LoadBalancer represents a load balancer's properties.
 type LoadBalancer struct {
-	ID                        string                     `json:"id,omitempty"`
-	CreatedOn                 *time.Time                 `json:"created_on,omitempty"`
-	ModifiedOn                *time.Time                 `json:"modified_on,omitempty"`
-	Description               string                     `json:"description"`
-	Name                      string                     `json:"name"`
-	TTL                       int                        `json:"ttl,omitempty"`
-	FallbackPool              string                     `json:"fallback_pool"`
-	DefaultPools              []string                   `json:"default_pools"`
-	RegionPools               map[string][]string        `json:"region_pools"`
-	PopPools                  map[string][]string        `json:"pop_pools"`
-	Proxied                   bool                       `json:"proxied"`
-	Enabled                   *bool                      `json:"enabled,omitempty"`
-	Persistence               string                     `json:"session_affinity,omitempty"`
-	PersistenceTTL            int                        `json:"session_affinity_ttl,omitempty"`
-	SessionAffinityAttributes *SessionAffinityAttributes `json:"session_affinity_attributes,omitempty"`
-	Rules                     []*LoadBalancerRule        `json:"rules,omitempty"`
-
-	// SteeringPolicy controls pool selection logic.
-	// "off" select pools in DefaultPools order
-	// "geo" select pools based on RegionPools/PopPools
-	// "dynamic_latency" select pools based on RTT (requires health checks)
-	// "random" selects pools in a random order
-	// "proximity" select pools based on 'distance' from request
-	// "" maps to "geo" if RegionPools or PopPools have entries otherwise "off"
-	SteeringPolicy string `json:"steering_policy,omitempty"`
+	ID                        string                        `json:"id,omitempty"`
+	CreatedOn                 *time.Time                    `json:"created_on,omitempty"`
+	ModifiedOn                *time.Time                    `json:"modified_on,omitempty"`
+	Description               string                        `json:"description"`
+	Name                      string                        `json:"name"`
+	TTL                       int                           `json:"ttl,omitempty"`
+	FallbackPool              string                        `json:"fallback_pool"`
+	DefaultPools              []string                      `json:"default_pools"`
+	RegionPools               map[string][]string           `json:"region_pools"`
+	PopPools                  map[string][]string           `json:"pop_pools"`
+	CountryPools              map[string][]string           `json:"country_pools,omitempty"`
+	Proxied                   bool                          `json:"proxied"`
+	Enabled                   *bool                         `json:"enabled,omitempty"`
+	Persistence               SessionAffinity               `json:"session_affinity,omitempty"`
+	PersistenceTTL            int                           `json:"session_affinity_ttl,omitempty"`
+	SessionAffinityAttributes *SessionAffinityAttributes    `json:"session_affinity_attributes,omitempty"`
+	Rules                     []*LoadBalancerRule           `json:"rules,omitempty"`
+	AdaptiveRouting           *LoadBalancerAdaptiveRouting  `json:"adaptive_routing,omitempty"`
+	LocationStrategy          *LoadBalancerLocationStrategy `json:"location_strategy,omitempty"`
+	RandomSteering            *LoadBalancerRandomSteering   `json:"random_steering,omitempty"`
+
+	// SteeringPolicy controls pool selection logic. It is one of the
+	// Steering* constants.
+	SteeringPolicy SteeringPolicy `json:"steering_policy,omitempty"`
+}
+
+// SteeringPolicy is the logic a load balancer uses to select pools.
+type SteeringPolicy string
+
+// These constants represent all valid load balancer steering policies.
+const (
+	// SteeringDefault maps to "geo" if RegionPools or PopPools have entries
+	// otherwise "off".
+	SteeringDefault SteeringPolicy = ""
+	// SteeringOff selects pools in DefaultPools order.
+	SteeringOff SteeringPolicy = "off"
+	// SteeringGeo selects pools based on RegionPools/PopPools/CountryPools.
+	SteeringGeo SteeringPolicy = "geo"
+	// SteeringDynamicLatency selects pools based on RTT (requires health
+	// checks).
+	SteeringDynamicLatency SteeringPolicy = "dynamic_latency"
+	// SteeringRandom selects pools in a random order, honouring
+	// RandomSteering weights.
+	SteeringRandom SteeringPolicy = "random"
+	// SteeringProximity selects pools based on their distance from the
+	// request.
+	SteeringProximity SteeringPolicy = "proximity"
+	// SteeringLeastOutstandingRequests selects pools by taking into
+	// consideration RandomSteering weights, as well as each pool's number
+	// of outstanding requests.
+	SteeringLeastOutstandingRequests SteeringPolicy = "least_outstanding_requests"
+	// SteeringLeastConnections selects pools by taking into consideration
+	// RandomSteering weights, as well as each pool's number of open
+	// connections.
+	SteeringLeastConnections SteeringPolicy = "least_connections"
+)
+
+// SessionAffinity is the type of session affinity a load balancer uses.
+type SessionAffinity string
+
+// These constants represent all valid load balancer session affinity types.
+const (
+	SessionAffinityDefault  SessionAffinity = ""
+	SessionAffinityNone     SessionAffinity = "none"
+	SessionAffinityCookie   SessionAffinity = "cookie"
+	SessionAffinityIPCookie SessionAffinity = "ip_cookie"
+	SessionAffinityHeader   SessionAffinity = "header"
+)
+
+func validateLoadBalancerSteeringPolicy(p SteeringPolicy) error {
+	switch p {
+	case SteeringDefault, SteeringOff, SteeringGeo, SteeringDynamicLatency, SteeringRandom,
+		SteeringProximity, SteeringLeastOutstandingRequests, SteeringLeastConnections:
+		return nil
+	}
+	return errors.Errorf("invalid steering policy %q", p)
+}
+
+func validateLoadBalancerSessionAffinity(a SessionAffinity) error {
+	switch a {
+	case SessionAffinityDefault, SessionAffinityNone, SessionAffinityCookie, SessionAffinityIPCookie, SessionAffinityHeader:
+		return nil
+	}
+	return errors.Errorf("invalid session affinity %q", a)
+}
+
+// Validate checks the load balancer for missing required fields and
+// invalid steering and session affinity settings. CreateLoadBalancer and
+// ModifyLoadBalancer call it before making a request.
+func (lb LoadBalancer) Validate() error {
+	if lb.Name == "" {
+		return errors.Errorf("load balancer name cannot be empty")
+	}
+	if lb.FallbackPool == "" {
+		return errors.Errorf("fallback pool cannot be empty")
+	}
+	if len(lb.DefaultPools) == 0 {
+		return errors.Errorf("default pools cannot be empty")
+	}
+	if err := validateLoadBalancerSteeringPolicy(lb.SteeringPolicy); err != nil {
+		return err
+	}
+	if err := validateLoadBalancerSessionAffinity(lb.Persistence); err != nil {
+		return err
+	}
+
+	var headers []string
+	if lb.SessionAffinityAttributes != nil {
+		headers = lb.SessionAffinityAttributes.Headers
+	}
+	if lb.Persistence == SessionAffinityHeader && len(headers) == 0 {
+		return errors.Errorf("session affinity %q requires at least one header", SessionAffinityHeader)
+	}
+	if lb.Persistence != SessionAffinityHeader && len(headers) > 0 {
+		return errors.Errorf("session affinity headers are only valid with session affinity %q", SessionAffinityHeader)
+	}
+
+	for _, rule := range lb.Rules {
+		if rule == nil {
+			continue
+		}
+		if rule.Name == "" {
+			return errors.Errorf("load balancer rule name cannot be empty")
+		}
+		if err := validateLoadBalancerSteeringPolicy(rule.Overrides.SteeringPolicy); err != nil {
+			return errors.Wrapf(err, "rule %q", rule.Name)
+		}
+		if err := validateLoadBalancerSessionAffinity(rule.Overrides.Persistence); err != nil {
+			return errors.Wrapf(err, "rule %q", rule.Name)
+		}
+	}
+
+	return nil
+}
+
+// LoadBalancerAdaptiveRouting controls features that modify the routing of
+// requests to pools and origins in response to dynamic conditions.
+type LoadBalancerAdaptiveRouting struct {
+	// FailoverAcrossPools extends zero-downtime failover of requests to
+	// healthy origins from alternate pools, when no healthy alternate exists
+	// in the same pool.
+	FailoverAcrossPools *bool `json:"failover_across_pools,omitempty"`
+}
+
+// LoadBalancerLocationStrategy controls location-based steering for
+// non-proxied requests.
+type LoadBalancerLocationStrategy struct {
+	// PreferECS determines whether the EDNS Client Subnet option is used
+	// over the resolver's IP address: "always", "never", "proximity" or
+	// "geo".
+	PreferECS string `json:"prefer_ecs,omitempty"`
+	// Mode determines the authoritative location when ECS is not preferred,
+	// does not exist in the request, or its GeoIP lookup is unsuccessful:
+	// "pop" or "resolver_ip".
+	Mode string `json:"mode,omitempty"`
+}
+
+// LoadBalancerRandomSteering configures pool weights for the "random",
+// "least_outstanding_requests" and "least_connections" steering policies.
+type LoadBalancerRandomSteering struct {
+	// DefaultWeight is the weight used for pools not listed in PoolWeights.
+	DefaultWeight float64 `json:"default_weight,omitempty"`
+	// PoolWeights maps pool IDs to their weight.
+	PoolWeights map[string]float64 `json:"pool_weights,omitempty"`
 }
 
 // LoadBalancerLoadShedding contains the settings for controlling load shedding
@@ -136,33 +353,45 @@ type LoadBalancerFixedResponseData struct {
 // LoadBalancerRuleOverrides are the set of field overridable by the rules system.
 type LoadBalancerRuleOverrides struct {
 	// session affinity
-	Persistence    string `json:"session_affinity,omitempty"`
-	PersistenceTTL *uint  `json:"session_affinity_ttl,omitempty"`
+	Persistence    SessionAffinity `json:"session_affinity,omitempty"`
+	PersistenceTTL *uint           `json:"session_affinity_ttl,omitempty"`
 
 	SessionAffinityAttrs *LoadBalancerRuleOverridesSessionAffinityAttrs `json:"session_affinity_attributes,omitempty"`
 
 	TTL uint `json:"ttl,omitempty"`
 
-	SteeringPolicy string `json:"steering_policy,omitempty"`
-	FallbackPool   string `json:"fallback_pool,omitempty"`
+	SteeringPolicy SteeringPolicy `json:"steering_policy,omitempty"`
+	FallbackPool   string         `json:"fallback_pool,omitempty"`
 
 	DefaultPools []string            `json:"default_pools,omitempty"`
 	PoPPools     map[string][]string `json:"pop_pools,omitempty"`
 	RegionPools  map[string][]string `json:"region_pools,omitempty"`
+	CountryPools map[string][]string `json:"country_pools,omitempty"`
+
+	AdaptiveRouting  *LoadBalancerAdaptiveRouting  `json:"adaptive_routing,omitempty"`
+	LocationStrategy *LoadBalancerLocationStrategy `json:"location_strategy,omitempty"`
+	RandomSteering   *LoadBalancerRandomSteering   `json:"random_steering,omitempty"`
 }
 
 // LoadBalancerRuleOverridesSessionAffinityAttrs mimics SessionAffinityAttributes without the
 // DrainDuration field as that field can not be overwritten via rules.
 type LoadBalancerRuleOverridesSessionAffinityAttrs struct {
-	SameSite string `json:"samesite,omitempty"`
-	Secure   string `json:"secure,omitempty"`
+	SameSite             string   `json:"samesite,omitempty"`
+	Secure               string   `json:"secure,omitempty"`
+	ZeroDowntimeFailover string   `json:"zero_downtime_failover,omitempty"`
+	Headers              []string `json:"headers,omitempty"`
+	RequireAllHeaders    *bool    `json:"require_all_headers,omitempty"`
 }
 
 // SessionAffinityAttributes represents the fields used to set attributes in a load balancer session affinity cookie.
+// Headers and RequireAllHeaders are only used with the "header" session affinity.
 type SessionAffinityAttributes struct {
-	SameSite      string `json:"samesite,omitempty"`
-	Secure        string `json:"secure,omitempty"`
-	DrainDuration int    `json:"drain_duration,omitempty"`
+	SameSite             string   `json:"samesite,omitempty"`
+	Secure               string   `json:"secure,omitempty"`
+	DrainDuration        int      `json:"drain_duration,omitempty"`
+	ZeroDowntimeFailover string   `json:"zero_downtime_failover,omitempty"`
+	Headers              []string `json:"headers,omitempty"`
+	RequireAllHeaders    *bool    `json:"require_all_headers,omitempty"`
 }
 
 // LoadBalancerOriginHealth represents the health of the origin.
@@ -185,6 +414,25 @@ type LoadBalancerPoolHealth struct {
 	PopHealth map[string]LoadBalancerPoolPopHealth `json:"pop_health,omitempty"`
 }
 
+// LoadBalancerPoolPreview identifies an on-demand health check of a pool's
+// origins. Pools maps the IDs of the pools being previewed to their names.
+type LoadBalancerPoolPreview struct {
+	PreviewID string            `json:"preview_id"`
+	Pools     map[string]string `json:"pools"`
+}
+
+// loadBalancerPoolPreviewResponse represents the response from the Preview Pool endpoint.
+type loadBalancerPoolPreviewResponse struct {
+	Response
+	Result LoadBalancerPoolPreview `json:"result"`
+}
+
+// loadBalancerPreviewResultResponse represents the response from the Preview Result endpoint.
+type loadBalancerPreviewResultResponse struct {
+	Response
+	Result map[string]LoadBalancerPoolPopHealth `json:"result"`
+}
+
 // loadBalancerPoolResponse represents the response from the load balancer pool endpoints.
 type loadBalancerPoolResponse struct {
 	Response
@@ -305,6 +553,89 @@ func (api *API) ModifyLoadBalancerPool(ctx context.Context, pool LoadBalancerPoo
 	return r.Result, nil
 }
 
+// PatchLoadBalancerPool applies a partial update to a load balancer pool.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-pools-patch-pool
+func (api *API) PatchLoadBalancerPool(ctx context.Context, poolID string, params LoadBalancerPoolPatchParams) (LoadBalancerPool, error) {
+	if poolID == "" {
+		return LoadBalancerPool{}, errors.Errorf("pool ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("%s/load_balancers/pools/%s", api.userBaseURL("/user"), poolID)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+	var r loadBalancerPoolResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return LoadBalancerPool{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// loadBalancerPoolLocks holds a *sync.Mutex per pool ID, serialising the
+// read-modify-write updates made to a pool's origins within this process.
+var loadBalancerPoolLocks sync.Map
+
+// SetLoadBalancerPoolOriginEnabled enables or disables a single origin,
+// identified by name, within a pool. The pool is fetched and only its origins
+// are patched back, leaving the other origins as they were.
+//
+// Calls for the same pool are serialised within this process. The API has no
+// conditional update, so a change made by another client between the fetch
+// and the patch can still be overwritten; the pool is fetched again after the
+// patch and an error is returned if it was modified again in the meantime.
+func (api *API) SetLoadBalancerPoolOriginEnabled(ctx context.Context, poolID, originName string, enabled bool) (LoadBalancerPool, error) {
+	if poolID == "" {
+		return LoadBalancerPool{}, errors.Errorf("pool ID cannot be empty")
+	}
+	if originName == "" {
+		return LoadBalancerPool{}, errors.Errorf("origin name cannot be empty")
+	}
+
+	lock, _ := loadBalancerPoolLocks.LoadOrStore(poolID, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	pool, err := api.LoadBalancerPoolDetails(ctx, poolID)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+
+	origins := make([]LoadBalancerOrigin, len(pool.Origins))
+	copy(origins, pool.Origins)
+
+	found := false
+	for i := range origins {
+		if origins[i].Name == originName {
+			if origins[i].Enabled == enabled {
+				return pool, nil
+			}
+			origins[i].Enabled = enabled
+			found = true
+			break
+		}
+	}
+	if !found {
+		return LoadBalancerPool{}, errors.Errorf("origin %q not found in pool %s", originName, poolID)
+	}
+
+	patched, err := api.PatchLoadBalancerPool(ctx, poolID, LoadBalancerPoolPatchParams{Origins: origins})
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+
+	current, err := api.LoadBalancerPoolDetails(ctx, poolID)
+	if err != nil {
+		return LoadBalancerPool{}, err
+	}
+	if patched.ModifiedOn != nil && current.ModifiedOn != nil && !current.ModifiedOn.Equal(*patched.ModifiedOn) {
+		return current, errors.Errorf("pool %s was modified while updating origin %q", poolID, originName)
+	}
+
+	return current, nil
+}
+
 // CreateLoadBalancerMonitor creates a new load balancer monitor.
 //
 // API reference: https://api.cloudflare.com/#load-balancer-monitors-create-monitor
@@ -380,10 +711,14 @@ func (api *API) ModifyLoadBalancerMonitor(ctx context.Context, monitor LoadBalan
 	return r.Result, nil
 }
 
-// CreateLoadBalancer creates a new load balancer.
+// CreateLoadBalancer creates a new load balancer. The load balancer is checked
+// with Validate before the request is made.
 //
 // API reference: https://api.cloudflare.com/#load-balancers-create-load-balancer
 func (api *API) CreateLoadBalancer(ctx context.Context, zoneID string, lb LoadBalancer) (LoadBalancer, error) {
+	if err := lb.Validate(); err != nil {
+		return LoadBalancer{}, err
+	}
 	uri := fmt.Sprintf("/zones/%s/load_balancers", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, lb)
 	if err != nil {
@@ -439,10 +774,14 @@ func (api *API) DeleteLoadBalancer(ctx context.Context, zoneID, lbID string) err
 	return nil
 }
 
-// ModifyLoadBalancer modifies a configured load balancer.
+// ModifyLoadBalancer modifies a configured load balancer. The load balancer is checked
+// with Validate before the request is made.
 //
 // API reference: https://api.cloudflare.com/#load-balancers-update-load-balancer
 func (api *API) ModifyLoadBalancer(ctx context.Context, zoneID string, lb LoadBalancer) (LoadBalancer, error) {
+	if err := lb.Validate(); err != nil {
+		return LoadBalancer{}, err
+	}
 	uri := fmt.Sprintf("/zones/%s/load_balancers/%s", zoneID, lb.ID)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, lb)
 	if err != nil {
@@ -470,3 +809,46 @@ func (api *API) PoolHealthDetails(ctx context.Context, poolID string) (LoadBalan
 	}
 	return r.Result, nil
 }
+
+// PreviewLoadBalancerPool runs an on-demand health check of a pool's origins
+// using the provided monitor configuration. The results are fetched with
+// LoadBalancerPreviewResult.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-pools-preview-pool
+func (api *API) PreviewLoadBalancerPool(ctx context.Context, poolID string, monitor LoadBalancerMonitor) (LoadBalancerPoolPreview, error) {
+	if poolID == "" {
+		return LoadBalancerPoolPreview{}, errors.Errorf("pool ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("%s/load_balancers/pools/%s/preview", api.userBaseURL("/user"), poolID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, monitor)
+	if err != nil {
+		return LoadBalancerPoolPreview{}, err
+	}
+	var r loadBalancerPoolPreviewResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return LoadBalancerPoolPreview{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// LoadBalancerPreviewResult fetches the results of a pool or monitor preview,
+// keyed by pool ID.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-monitors-preview-result
+func (api *API) LoadBalancerPreviewResult(ctx context.Context, previewID string) (map[string]LoadBalancerPoolPopHealth, error) {
+	if previewID == "" {
+		return nil, errors.Errorf("preview ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("%s/load_balancers/preview/%s", api.userBaseURL("/user"), previewID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	var r loadBalancerPreviewResultResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return nil, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
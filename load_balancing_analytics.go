@@ -0,0 +1,131 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LoadBalancingAnalyticsEvent represents a pool health change recorded by
+// the load balancing analytics.
+type LoadBalancingAnalyticsEvent struct {
+	ID        int                                 `json:"id"`
+	Timestamp *time.Time                          `json:"timestamp,omitempty"`
+	Pool      LoadBalancingAnalyticsEventPool     `json:"pool"`
+	Origins   []LoadBalancingAnalyticsEventOrigin `json:"origins"`
+}
+
+// LoadBalancingAnalyticsEventPool is the state of a pool at the time of a
+// load balancing analytics event.
+type LoadBalancingAnalyticsEventPool struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Healthy        bool   `json:"healthy"`
+	Changed        bool   `json:"changed"`
+	MinimumOrigins int    `json:"minimum_origins"`
+}
+
+// LoadBalancingAnalyticsEventOrigin is the state of an origin at the time of
+// a load balancing analytics event.
+type LoadBalancingAnalyticsEventOrigin struct {
+	Name          string `json:"name"`
+	Address       string `json:"address"`
+	IP            string `json:"ip"`
+	Enabled       bool   `json:"enabled"`
+	Healthy       bool   `json:"healthy"`
+	Changed       bool   `json:"changed"`
+	FailureReason string `json:"failure_reason"`
+}
+
+// LoadBalancingAnalyticsEventsParams holds the filters used when listing
+// load balancing analytics events.
+type LoadBalancingAnalyticsEventsParams struct {
+	PoolID        string
+	PoolName      string
+	OriginName    string
+	PoolHealthy   *bool
+	OriginHealthy *bool
+	// ChangedOnly restricts the results to events where the health of the
+	// pool or one of its origins changed.
+	ChangedOnly bool
+	Since       *time.Time
+	Until       *time.Time
+	PaginationOptions
+}
+
+// Encode encodes the load balancing analytics event parameters into a query
+// string.
+func (p LoadBalancingAnalyticsEventsParams) Encode() string {
+	v := url.Values{}
+
+	if p.PoolID != "" {
+		v.Set("identifier", p.PoolID)
+	}
+	if p.PoolName != "" {
+		v.Set("pool_name", p.PoolName)
+	}
+	if p.OriginName != "" {
+		v.Set("origin_name", p.OriginName)
+	}
+	if p.PoolHealthy != nil {
+		v.Set("pool_healthy", strconv.FormatBool(*p.PoolHealthy))
+	}
+	if p.OriginHealthy != nil {
+		v.Set("origin_healthy", strconv.FormatBool(*p.OriginHealthy))
+	}
+	if p.ChangedOnly {
+		v.Set("changed", "true")
+	}
+	if p.Since != nil {
+		v.Set("since", (*p.Since).UTC().Format(time.RFC3339))
+	}
+	if p.Until != nil {
+		v.Set("until", (*p.Until).UTC().Format(time.RFC3339))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// LoadBalancingAnalyticsEventsResponse is the API response, containing a
+// list of load balancing analytics events.
+type LoadBalancingAnalyticsEventsResponse struct {
+	Response
+	Result     []LoadBalancingAnalyticsEvent `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// LoadBalancingAnalyticsEvents returns the pool and origin health changes
+// recorded for the user's load balancers.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-healthcheck-events-list-healthcheck-events
+func (api *API) LoadBalancingAnalyticsEvents(ctx context.Context, params LoadBalancingAnalyticsEventsParams) ([]LoadBalancingAnalyticsEvent, ResultInfo, error) {
+	uri := fmt.Sprintf("%s/load_balancing_analytics/events", api.userBaseURL("/user"))
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []LoadBalancingAnalyticsEvent{}, ResultInfo{}, err
+	}
+
+	var eventsResponse LoadBalancingAnalyticsEventsResponse
+	err = json.Unmarshal(res, &eventsResponse)
+	if err != nil {
+		return []LoadBalancingAnalyticsEvent{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return eventsResponse.Result, eventsResponse.ResultInfo, nil
+}
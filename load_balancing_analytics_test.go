@@ -0,0 +1,102 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadBalancingAnalyticsEvents(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "17b5962d775c646f3f9725cbc7a53df4", r.URL.Query().Get("identifier"))
+		assert.Equal(t, "false", r.URL.Query().Get("origin_healthy"))
+		assert.Equal(t, "true", r.URL.Query().Get("changed"))
+		assert.Equal(t, "2023-06-01T00:00:00Z", r.URL.Query().Get("since"))
+		assert.Equal(t, "2023-06-02T00:00:00Z", r.URL.Query().Get("until"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": 1,
+					"timestamp": "2023-06-01T12:00:00Z",
+					"pool": {
+						"id": "17b5962d775c646f3f9725cbc7a53df4",
+						"name": "primary-dc-1",
+						"healthy": false,
+						"changed": true,
+						"minimum_origins": 1
+					},
+					"origins": [
+						{
+							"name": "app-server-1",
+							"address": "198.51.100.4",
+							"ip": "198.51.100.4",
+							"enabled": true,
+							"healthy": false,
+							"changed": true,
+							"failure_reason": "HTTP timeout occurred"
+						}
+					]
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/user/load_balancing_analytics/events", handler)
+
+	timestamp, _ := time.Parse(time.RFC3339, "2023-06-01T12:00:00Z")
+	want := []LoadBalancingAnalyticsEvent{{
+		ID:        1,
+		Timestamp: &timestamp,
+		Pool: LoadBalancingAnalyticsEventPool{
+			ID:             "17b5962d775c646f3f9725cbc7a53df4",
+			Name:           "primary-dc-1",
+			Healthy:        false,
+			Changed:        true,
+			MinimumOrigins: 1,
+		},
+		Origins: []LoadBalancingAnalyticsEventOrigin{{
+			Name:          "app-server-1",
+			Address:       "198.51.100.4",
+			IP:            "198.51.100.4",
+			Enabled:       true,
+			Healthy:       false,
+			Changed:       true,
+			FailureReason: "HTTP timeout occurred",
+		}},
+	}}
+
+	originHealthy := false
+	since, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-06-02T00:00:00Z")
+
+	actual, _, err := client.LoadBalancingAnalyticsEvents(context.Background(), LoadBalancingAnalyticsEventsParams{
+		PoolID:        "17b5962d775c646f3f9725cbc7a53df4",
+		OriginHealthy: &originHealthy,
+		ChangedOnly:   true,
+		Since:         &since,
+		Until:         &until,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
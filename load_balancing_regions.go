@@ -0,0 +1,119 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// LoadBalancerRegions describes the regions used for geo steering and the
+// countries each of them covers.
+type LoadBalancerRegions struct {
+	ISOStandard string               `json:"iso_standard"`
+	Regions     []LoadBalancerRegion `json:"regions"`
+}
+
+// LoadBalancerRegion is a single geo steering region, such as "WNAM".
+type LoadBalancerRegion struct {
+	RegionCode string                      `json:"region_code"`
+	Countries  []LoadBalancerRegionCountry `json:"countries"`
+}
+
+// LoadBalancerRegionCountry is a country belonging to a load balancer
+// region. Subdivisions are only listed for countries split across regions.
+type LoadBalancerRegionCountry struct {
+	CountryCodeA2       string                          `json:"country_code_a2"`
+	CountryName         string                          `json:"country_name"`
+	CountrySubdivisions []LoadBalancerRegionSubdivision `json:"country_subdivisions,omitempty"`
+}
+
+// LoadBalancerRegionSubdivision is a country subdivision, such as a US state,
+// belonging to a load balancer region.
+type LoadBalancerRegionSubdivision struct {
+	SubdivisionCodeA2 string `json:"subdivision_code_a2"`
+	SubdivisionName   string `json:"subdivision_name"`
+}
+
+// LoadBalancerRegionsParams holds the filters used when listing load
+// balancer regions.
+type LoadBalancerRegionsParams struct {
+	CountryCodeA2   string
+	SubdivisionCode string
+}
+
+// Encode encodes the load balancer region parameters into a query string.
+func (p LoadBalancerRegionsParams) Encode() string {
+	v := url.Values{}
+
+	if p.CountryCodeA2 != "" {
+		v.Set("country_code_a2", p.CountryCodeA2)
+	}
+	if p.SubdivisionCode != "" {
+		v.Set("subdivision_code", p.SubdivisionCode)
+	}
+
+	return v.Encode()
+}
+
+// RegionCodes returns the codes of all regions, as used for the keys of
+// LoadBalancer.RegionPools.
+func (r LoadBalancerRegions) RegionCodes() []string {
+	codes := make([]string, 0, len(r.Regions))
+	for _, region := range r.Regions {
+		codes = append(codes, region.RegionCode)
+	}
+	return codes
+}
+
+// LoadBalancerRegionsResponse is the API response, containing load balancer
+// regions.
+type LoadBalancerRegionsResponse struct {
+	Response
+	Result LoadBalancerRegions `json:"result"`
+}
+
+// ListLoadBalancerRegions returns the load balancer regions and the countries
+// they map to.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-regions-list-regions
+func (api *API) ListLoadBalancerRegions(ctx context.Context, accountID string, params LoadBalancerRegionsParams) (LoadBalancerRegions, error) {
+	uri := fmt.Sprintf("/%s/%s/load_balancers/regions", AccountRouteRoot, accountID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	return api.loadBalancerRegionsRequest(ctx, uri)
+}
+
+// LoadBalancerRegion returns a single load balancer region and the countries
+// it maps to.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-regions-get-region
+func (api *API) LoadBalancerRegion(ctx context.Context, accountID, regionCode string) (LoadBalancerRegions, error) {
+	if regionCode == "" {
+		return LoadBalancerRegions{}, errors.Errorf("region code cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/load_balancers/regions/%s", AccountRouteRoot, accountID, regionCode)
+
+	return api.loadBalancerRegionsRequest(ctx, uri)
+}
+
+func (api *API) loadBalancerRegionsRequest(ctx context.Context, uri string) (LoadBalancerRegions, error) {
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return LoadBalancerRegions{}, err
+	}
+
+	var regionsResponse LoadBalancerRegionsResponse
+	err = json.Unmarshal(res, &regionsResponse)
+	if err != nil {
+		return LoadBalancerRegions{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return regionsResponse.Result, nil
+}
@@ -0,0 +1,139 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListLoadBalancerRegions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "US", r.URL.Query().Get("country_code_a2"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"iso_standard": "Country and subdivision codes follow ISO 3166-1 alpha-2 and ISO 3166-2",
+				"regions": [
+					{
+						"region_code": "ENAM",
+						"countries": [
+							{
+								"country_code_a2": "US",
+								"country_name": "United States",
+								"country_subdivisions": [
+									{"subdivision_code_a2": "NY", "subdivision_name": "New York"}
+								]
+							}
+						]
+					},
+					{
+						"region_code": "WNAM",
+						"countries": [
+							{
+								"country_code_a2": "US",
+								"country_name": "United States",
+								"country_subdivisions": [
+									{"subdivision_code_a2": "CA", "subdivision_name": "California"}
+								]
+							}
+						]
+					}
+				]
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/regions", handler)
+
+	want := LoadBalancerRegions{
+		ISOStandard: "Country and subdivision codes follow ISO 3166-1 alpha-2 and ISO 3166-2",
+		Regions: []LoadBalancerRegion{
+			{
+				RegionCode: "ENAM",
+				Countries: []LoadBalancerRegionCountry{{
+					CountryCodeA2: "US",
+					CountryName:   "United States",
+					CountrySubdivisions: []LoadBalancerRegionSubdivision{
+						{SubdivisionCodeA2: "NY", SubdivisionName: "New York"},
+					},
+				}},
+			},
+			{
+				RegionCode: "WNAM",
+				Countries: []LoadBalancerRegionCountry{{
+					CountryCodeA2: "US",
+					CountryName:   "United States",
+					CountrySubdivisions: []LoadBalancerRegionSubdivision{
+						{SubdivisionCodeA2: "CA", SubdivisionName: "California"},
+					},
+				}},
+			},
+		},
+	}
+
+	actual, err := client.ListLoadBalancerRegions(context.Background(), testAccountID, LoadBalancerRegionsParams{CountryCodeA2: "US"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, []string{"ENAM", "WNAM"}, actual.RegionCodes())
+	}
+}
+
+func TestLoadBalancerRegion(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"iso_standard": "Country and subdivision codes follow ISO 3166-1 alpha-2 and ISO 3166-2",
+				"regions": [
+					{
+						"region_code": "SAF",
+						"countries": [
+							{"country_code_a2": "ZA", "country_name": "South Africa"}
+						]
+					}
+				]
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/load_balancers/regions/SAF", handler)
+
+	want := LoadBalancerRegions{
+		ISOStandard: "Country and subdivision codes follow ISO 3166-1 alpha-2 and ISO 3166-2",
+		Regions: []LoadBalancerRegion{{
+			RegionCode: "SAF",
+			Countries: []LoadBalancerRegionCountry{
+				{CountryCodeA2: "ZA", CountryName: "South Africa"},
+			},
+		}},
+	}
+
+	actual, err := client.LoadBalancerRegion(context.Background(), testAccountID, "SAF")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.LoadBalancerRegion(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "region code cannot be empty")
+}
@@ -0,0 +1,144 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// LoadBalancerReference describes a load balancing resource that references,
+// or is referenced by, another resource. ReferenceType is "referral" when the
+// resource uses the one being queried and "referrer" when it is used by it.
+type LoadBalancerReference struct {
+	ReferenceType string `json:"reference_type"`
+	ResourceID    string `json:"resource_id"`
+	ResourceName  string `json:"resource_name"`
+	ResourceType  string `json:"resource_type"`
+}
+
+// LoadBalancerSearchResource is a load balancing resource matched by a search,
+// along with the resources it is linked to.
+type LoadBalancerSearchResource struct {
+	LoadBalancerReference
+	References []LoadBalancerReference `json:"references"`
+}
+
+// LoadBalancerSearchParams holds the filters used when searching load
+// balancing resources.
+type LoadBalancerSearchParams struct {
+	// Query is matched against the name, ID and address of load balancers,
+	// pools, origins and monitors.
+	Query string
+	// References is one of "", "*", "referral" or "referrer" and controls
+	// which linked resources are included in the results.
+	References string
+	PaginationOptions
+}
+
+// Encode encodes the load balancer search parameters into a query string.
+func (p LoadBalancerSearchParams) Encode() string {
+	v := url.Values{}
+
+	if p.Query != "" {
+		v.Set("search_params[query]", p.Query)
+	}
+	if p.References != "" {
+		v.Set("search_params[references]", p.References)
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// LoadBalancerSearchResponse is the API response, containing the load
+// balancing resources matched by a search.
+type LoadBalancerSearchResponse struct {
+	Response
+	Result struct {
+		Resources []LoadBalancerSearchResource `json:"resources"`
+	} `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// LoadBalancerReferencesResponse is the API response, containing the
+// resources linked to a pool or monitor.
+type LoadBalancerReferencesResponse struct {
+	Response
+	Result []LoadBalancerReference `json:"result"`
+}
+
+// SearchLoadBalancerResources searches load balancers, pools and monitors.
+//
+// API reference: https://api.cloudflare.com/#load-balancers-search-search-resources
+func (api *API) SearchLoadBalancerResources(ctx context.Context, params LoadBalancerSearchParams) ([]LoadBalancerSearchResource, ResultInfo, error) {
+	uri := fmt.Sprintf("%s/load_balancers/search", api.userBaseURL("/user"))
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []LoadBalancerSearchResource{}, ResultInfo{}, err
+	}
+
+	var searchResponse LoadBalancerSearchResponse
+	err = json.Unmarshal(res, &searchResponse)
+	if err != nil {
+		return []LoadBalancerSearchResource{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return searchResponse.Result.Resources, searchResponse.ResultInfo, nil
+}
+
+// LoadBalancerPoolReferences returns the load balancers and monitors linked
+// to a pool. A pool can only be deleted once no load balancer references it.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-pools-list-pool-references
+func (api *API) LoadBalancerPoolReferences(ctx context.Context, poolID string) ([]LoadBalancerReference, error) {
+	if poolID == "" {
+		return []LoadBalancerReference{}, errors.Errorf("pool ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("%s/load_balancers/pools/%s/references", api.userBaseURL("/user"), poolID)
+
+	return api.loadBalancerReferencesRequest(ctx, uri)
+}
+
+// LoadBalancerMonitorReferences returns the pools linked to a monitor. A
+// monitor can only be deleted once no pool references it.
+//
+// API reference: https://api.cloudflare.com/#load-balancer-monitors-list-monitor-references
+func (api *API) LoadBalancerMonitorReferences(ctx context.Context, monitorID string) ([]LoadBalancerReference, error) {
+	if monitorID == "" {
+		return []LoadBalancerReference{}, errors.Errorf("monitor ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("%s/load_balancers/monitors/%s/references", api.userBaseURL("/user"), monitorID)
+
+	return api.loadBalancerReferencesRequest(ctx, uri)
+}
+
+func (api *API) loadBalancerReferencesRequest(ctx context.Context, uri string) ([]LoadBalancerReference, error) {
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []LoadBalancerReference{}, err
+	}
+
+	var referencesResponse LoadBalancerReferencesResponse
+	err = json.Unmarshal(res, &referencesResponse)
+	if err != nil {
+		return []LoadBalancerReference{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return referencesResponse.Result, nil
+}
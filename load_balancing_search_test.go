@@ -0,0 +1,173 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchLoadBalancerResources(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "primary", r.URL.Query().Get("search_params[query]"))
+		assert.Equal(t, "*", r.URL.Query().Get("search_params[references]"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"resources": [
+					{
+						"reference_type": "*",
+						"resource_id": "17b5962d775c646f3f9725cbc7a53df4",
+						"resource_name": "primary-dc-1",
+						"resource_type": "pool",
+						"references": [
+							{
+								"reference_type": "referrer",
+								"resource_id": "699d98642c564d2e855e9661899b7252",
+								"resource_name": "www.example.com",
+								"resource_type": "load_balancer"
+							}
+						]
+					}
+				]
+			},
+			"result_info": {
+				"page": 1,
+				"per_page": 25,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/search", handler)
+
+	want := []LoadBalancerSearchResource{{
+		LoadBalancerReference: LoadBalancerReference{
+			ReferenceType: "*",
+			ResourceID:    "17b5962d775c646f3f9725cbc7a53df4",
+			ResourceName:  "primary-dc-1",
+			ResourceType:  "pool",
+		},
+		References: []LoadBalancerReference{{
+			ReferenceType: "referrer",
+			ResourceID:    "699d98642c564d2e855e9661899b7252",
+			ResourceName:  "www.example.com",
+			ResourceType:  "load_balancer",
+		}},
+	}}
+
+	actual, _, err := client.SearchLoadBalancerResources(context.Background(), LoadBalancerSearchParams{Query: "primary", References: "*"})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestLoadBalancerPoolReferences(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"reference_type": "referrer",
+					"resource_id": "699d98642c564d2e855e9661899b7252",
+					"resource_name": "www.example.com",
+					"resource_type": "load_balancer"
+				},
+				{
+					"reference_type": "referral",
+					"resource_id": "f1aba936b94213e5b8dca0c0dbf1f9cc",
+					"resource_name": "Login page monitor",
+					"resource_type": "monitor"
+				}
+			]
+		}
+		`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4/references", handler)
+
+	want := []LoadBalancerReference{
+		{
+			ReferenceType: "referrer",
+			ResourceID:    "699d98642c564d2e855e9661899b7252",
+			ResourceName:  "www.example.com",
+			ResourceType:  "load_balancer",
+		},
+		{
+			ReferenceType: "referral",
+			ResourceID:    "f1aba936b94213e5b8dca0c0dbf1f9cc",
+			ResourceName:  "Login page monitor",
+			ResourceType:  "monitor",
+		},
+	}
+
+	actual, err := client.LoadBalancerPoolReferences(context.Background(), "17b5962d775c646f3f9725cbc7a53df4")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.LoadBalancerPoolReferences(context.Background(), "")
+	assert.EqualError(t, err, "pool ID cannot be empty")
+}
+
+func TestLoadBalancerMonitorReferences(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"reference_type": "referrer",
+					"resource_id": "17b5962d775c646f3f9725cbc7a53df4",
+					"resource_name": "primary-dc-1",
+					"resource_type": "pool"
+				}
+			]
+		}
+		`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/monitors/f1aba936b94213e5b8dca0c0dbf1f9cc/references", handler)
+
+	want := []LoadBalancerReference{{
+		ReferenceType: "referrer",
+		ResourceID:    "17b5962d775c646f3f9725cbc7a53df4",
+		ResourceName:  "primary-dc-1",
+		ResourceType:  "pool",
+	}}
+
+	actual, err := client.LoadBalancerMonitorReferences(context.Background(), "f1aba936b94213e5b8dca0c0dbf1f9cc")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.LoadBalancerMonitorReferences(context.Background(), "")
+	assert.EqualError(t, err, "monitor ID cannot be empty")
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"testing"
 	"time"
 
@@ -446,6 +447,219 @@ func TestModifyLoadBalancerPool(t *testing.T) {
 	}
 }
 
+func TestPatchLoadBalancerPool(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"enabled": true,
+				"minimum_origins": 1,
+				"origins": [
+					{
+						"name": "tunnel-origin",
+						"address": "10.0.0.1",
+						"enabled": true,
+						"weight": 0.5,
+						"header": null,
+						"virtual_network_id": "a5624d4e-044a-4ff0-b3e1-e2465353d4b4"
+					}
+				],
+				"notification_filter": {
+					"pool": {"healthy": false},
+					"origin": {"disable": true}
+				},
+				"origin_steering": {"policy": "least_connections"}
+			}`, string(b))
+		}
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "17b5962d775c646f3f9725cbc7a53df4",
+				"description": "Primary data center - Provider XYZZY",
+				"name": "primary-dc-1",
+				"enabled": true,
+				"minimum_origins": 1,
+				"origins": [
+					{
+						"name": "tunnel-origin",
+						"address": "10.0.0.1",
+						"enabled": true,
+						"weight": 0.5,
+						"virtual_network_id": "a5624d4e-044a-4ff0-b3e1-e2465353d4b4"
+					}
+				],
+				"notification_filter": {
+					"pool": {"healthy": false},
+					"origin": {"disable": true}
+				},
+				"origin_steering": {"policy": "least_connections"}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4", handler)
+
+	enabled := true
+	minimumOrigins := 1
+	healthy := false
+	origins := []LoadBalancerOrigin{
+		{
+			Name:             "tunnel-origin",
+			Address:          "10.0.0.1",
+			Enabled:          true,
+			Weight:           0.5,
+			VirtualNetworkID: "a5624d4e-044a-4ff0-b3e1-e2465353d4b4",
+		},
+	}
+	notificationFilter := &LoadBalancerNotificationFilter{
+		Pool:   &LoadBalancerNotificationFilterTarget{Healthy: &healthy},
+		Origin: &LoadBalancerNotificationFilterTarget{Disable: true},
+	}
+	want := LoadBalancerPool{
+		ID:                 "17b5962d775c646f3f9725cbc7a53df4",
+		Description:        "Primary data center - Provider XYZZY",
+		Name:               "primary-dc-1",
+		Enabled:            true,
+		MinimumOrigins:     1,
+		Origins:            origins,
+		NotificationFilter: notificationFilter,
+		OriginSteering:     &LoadBalancerOriginSteering{Policy: "least_connections"},
+	}
+
+	actual, err := client.PatchLoadBalancerPool(context.Background(), "17b5962d775c646f3f9725cbc7a53df4", LoadBalancerPoolPatchParams{
+		Enabled:            &enabled,
+		MinimumOrigins:     &minimumOrigins,
+		Origins:            origins,
+		NotificationFilter: notificationFilter,
+		OriginSteering:     &LoadBalancerOriginSteering{Policy: "least_connections"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.PatchLoadBalancerPool(context.Background(), "", LoadBalancerPoolPatchParams{})
+	assert.EqualError(t, err, "pool ID cannot be empty")
+}
+
+const (
+	setPoolOriginBefore = `{
+		"success": true,
+		"errors": [],
+		"messages": [],
+		"result": {
+			"id": "17b5962d775c646f3f9725cbc7a53df4",
+			"name": "primary-dc-1",
+			"enabled": true,
+			"modified_on": "2014-01-01T05:20:00.12345Z",
+			"origins": [
+				{"name": "app-server-1", "address": "198.51.100.4", "enabled": true, "weight": 1},
+				{"name": "app-server-2", "address": "198.51.100.5", "enabled": true, "weight": 1}
+			]
+		}
+	}`
+	setPoolOriginAfter = `{
+		"success": true,
+		"errors": [],
+		"messages": [],
+		"result": {
+			"id": "17b5962d775c646f3f9725cbc7a53df4",
+			"name": "primary-dc-1",
+			"enabled": true,
+			"modified_on": "2014-01-02T05:20:00.12345Z",
+			"origins": [
+				{"name": "app-server-1", "address": "198.51.100.4", "enabled": false, "weight": 1},
+				{"name": "app-server-2", "address": "198.51.100.5", "enabled": true, "weight": 1}
+			]
+		}
+	}`
+)
+
+func TestSetLoadBalancerPoolOriginEnabled(t *testing.T) {
+	setup()
+	defer teardown()
+
+	current := setPoolOriginBefore
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, current)
+		case http.MethodPatch:
+			b, err := ioutil.ReadAll(r.Body)
+			defer r.Body.Close()
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+					"origins": [
+						{"name": "app-server-1", "address": "198.51.100.4", "enabled": false, "weight": 1, "header": null},
+						{"name": "app-server-2", "address": "198.51.100.5", "enabled": true, "weight": 1, "header": null}
+					]
+				}`, string(b))
+			}
+			current = setPoolOriginAfter
+			fmt.Fprint(w, current)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	}
+
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4", handler)
+	modifiedOn, _ := time.Parse(time.RFC3339, "2014-01-02T05:20:00.12345Z")
+	want := LoadBalancerPool{
+		ID:         "17b5962d775c646f3f9725cbc7a53df4",
+		Name:       "primary-dc-1",
+		Enabled:    true,
+		ModifiedOn: &modifiedOn,
+		Origins: []LoadBalancerOrigin{
+			{Name: "app-server-1", Address: "198.51.100.4", Enabled: false, Weight: 1},
+			{Name: "app-server-2", Address: "198.51.100.5", Enabled: true, Weight: 1},
+		},
+	}
+
+	actual, err := client.SetLoadBalancerPoolOriginEnabled(context.Background(), "17b5962d775c646f3f9725cbc7a53df4", "app-server-1", false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.SetLoadBalancerPoolOriginEnabled(context.Background(), "17b5962d775c646f3f9725cbc7a53df4", "app-server-3", false)
+	assert.EqualError(t, err, `origin "app-server-3" not found in pool 17b5962d775c646f3f9725cbc7a53df4`)
+
+	_, err = client.SetLoadBalancerPoolOriginEnabled(context.Background(), "", "app-server-1", false)
+	assert.EqualError(t, err, "pool ID cannot be empty")
+}
+
+func TestSetLoadBalancerPoolOriginEnabledConcurrentModification(t *testing.T) {
+	setup()
+	defer teardown()
+
+	current := setPoolOriginBefore
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, current)
+		case http.MethodPatch:
+			fmt.Fprint(w, setPoolOriginAfter)
+			// Another client modifies the pool straight after the patch.
+			current = strings.Replace(setPoolOriginAfter, "2014-01-02", "2014-01-03", 1)
+		default:
+			t.Errorf("Unexpected method %s", r.Method)
+		}
+	}
+
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4", handler)
+
+	_, err := client.SetLoadBalancerPoolOriginEnabled(context.Background(), "17b5962d775c646f3f9725cbc7a53df4", "app-server-1", false)
+	assert.EqualError(t, err, `pool 17b5962d775c646f3f9725cbc7a53df4 was modified while updating origin "app-server-1"`)
+}
+
 func TestCreateLoadBalancerMonitor(t *testing.T) {
 	setup()
 	defer teardown()
@@ -560,6 +774,75 @@ func TestCreateLoadBalancerMonitor(t *testing.T) {
 	}
 }
 
+func TestCreateLoadBalancerTCPMonitor(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"type": "tcp",
+				"description": "TCP monitor",
+				"method": "connection_established",
+				"path": "",
+				"header": null,
+				"port": 8080,
+				"timeout": 5,
+				"retries": 2,
+				"interval": 60,
+				"expected_body": "",
+				"expected_codes": "",
+				"follow_redirects": false,
+				"allow_insecure": false,
+				"probe_zone": "",
+				"consecutive_up": 2,
+				"consecutive_down": 3
+			}`, string(b))
+		}
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "f1aba936b94213e5b8dca0c0dbf1f9cc",
+				"type": "tcp",
+				"description": "TCP monitor",
+				"method": "connection_established",
+				"port": 8080,
+				"timeout": 5,
+				"retries": 2,
+				"interval": 60,
+				"consecutive_up": 2,
+				"consecutive_down": 3
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/monitors", handler)
+	request := LoadBalancerMonitor{
+		Type:            LoadBalancerMonitorTypeTCP,
+		Description:     "TCP monitor",
+		Method:          "connection_established",
+		Port:            8080,
+		Timeout:         5,
+		Retries:         2,
+		Interval:        60,
+		ConsecutiveUp:   2,
+		ConsecutiveDown: 3,
+	}
+	want := request
+	want.ID = "f1aba936b94213e5b8dca0c0dbf1f9cc"
+
+	actual, err := client.CreateLoadBalancerMonitor(context.Background(), request)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
 func TestListLoadBalancerMonitors(t *testing.T) {
 	setup()
 	defer teardown()
@@ -1549,3 +1832,350 @@ func TestLoadBalancerPoolHealthDetails(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+func TestPreviewLoadBalancerPool(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"type": "http",
+				"description": "",
+				"method": "GET",
+				"path": "/health",
+				"header": null,
+				"timeout": 5,
+				"retries": 2,
+				"interval": 0,
+				"expected_body": "",
+				"expected_codes": "2xx",
+				"follow_redirects": false,
+				"allow_insecure": false,
+				"probe_zone": ""
+			}`, string(b))
+		}
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"preview_id": "f1aba936b94213e5b8dca0c0dbf1f9cc",
+				"pools": {
+					"17b5962d775c646f3f9725cbc7a53df4": "primary-dc-1"
+				}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/pools/17b5962d775c646f3f9725cbc7a53df4/preview", handler)
+	want := LoadBalancerPoolPreview{
+		PreviewID: "f1aba936b94213e5b8dca0c0dbf1f9cc",
+		Pools: map[string]string{
+			"17b5962d775c646f3f9725cbc7a53df4": "primary-dc-1",
+		},
+	}
+
+	actual, err := client.PreviewLoadBalancerPool(context.Background(), "17b5962d775c646f3f9725cbc7a53df4", LoadBalancerMonitor{
+		Type:          LoadBalancerMonitorTypeHTTP,
+		Method:        http.MethodGet,
+		Path:          "/health",
+		Timeout:       5,
+		Retries:       2,
+		ExpectedCodes: "2xx",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.PreviewLoadBalancerPool(context.Background(), "", LoadBalancerMonitor{})
+	assert.EqualError(t, err, "pool ID cannot be empty")
+}
+
+func TestLoadBalancerPreviewResult(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"17b5962d775c646f3f9725cbc7a53df4": {
+					"healthy": true,
+					"origins": [
+						{
+							"originone.example.com.": {
+								"healthy": true,
+								"rtt": "66ms",
+								"failure_reason": "No failures",
+								"response_code": 200
+							}
+						}
+					]
+				}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/user/load_balancers/preview/f1aba936b94213e5b8dca0c0dbf1f9cc", handler)
+	want := map[string]LoadBalancerPoolPopHealth{
+		"17b5962d775c646f3f9725cbc7a53df4": {
+			Healthy: true,
+			Origins: []map[string]LoadBalancerOriginHealth{
+				{
+					"originone.example.com.": {
+						Healthy:       true,
+						RTT:           Duration{66 * time.Millisecond},
+						FailureReason: "No failures",
+						ResponseCode:  200,
+					},
+				},
+			},
+		},
+	}
+
+	actual, err := client.LoadBalancerPreviewResult(context.Background(), "f1aba936b94213e5b8dca0c0dbf1f9cc")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.LoadBalancerPreviewResult(context.Background(), "")
+	assert.EqualError(t, err, "preview ID cannot be empty")
+}
+
+func TestCreateLoadBalancerWithSteeringFeatures(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "",
+				"name": "www.example.com",
+				"fallback_pool": "17b5962d775c646f3f9725cbc7a53df4",
+				"default_pools": ["de90f38ced07c2e2f4df50b1f61d4194", "17b5962d775c646f3f9725cbc7a53df4"],
+				"region_pools": null,
+				"pop_pools": null,
+				"proxied": true,
+				"session_affinity": "header",
+				"session_affinity_attributes": {
+					"zero_downtime_failover": "sticky",
+					"headers": ["x-session-id"],
+					"require_all_headers": true
+				},
+				"adaptive_routing": {"failover_across_pools": true},
+				"location_strategy": {"prefer_ecs": "always", "mode": "resolver_ip"},
+				"random_steering": {
+					"default_weight": 0.2,
+					"pool_weights": {"de90f38ced07c2e2f4df50b1f61d4194": 0.8}
+				},
+				"steering_policy": "least_outstanding_requests",
+				"rules": [
+					{
+						"name": "maintenance",
+						"condition": "http.request.uri.path contains \"/admin\"",
+						"priority": 0,
+						"disabled": false,
+						"overrides": {},
+						"fixed_response": {"message_body": "down for maintenance", "status_code": 503}
+					}
+				]
+			}`, string(b))
+		}
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "699d98642c564d2e855e9661899b7252",
+				"name": "www.example.com",
+				"fallback_pool": "17b5962d775c646f3f9725cbc7a53df4",
+				"default_pools": ["de90f38ced07c2e2f4df50b1f61d4194", "17b5962d775c646f3f9725cbc7a53df4"],
+				"proxied": true,
+				"session_affinity": "header",
+				"session_affinity_attributes": {
+					"zero_downtime_failover": "sticky",
+					"headers": ["x-session-id"],
+					"require_all_headers": true
+				},
+				"adaptive_routing": {"failover_across_pools": true},
+				"location_strategy": {"prefer_ecs": "always", "mode": "resolver_ip"},
+				"random_steering": {
+					"default_weight": 0.2,
+					"pool_weights": {"de90f38ced07c2e2f4df50b1f61d4194": 0.8}
+				},
+				"steering_policy": "least_outstanding_requests",
+				"rules": [
+					{
+						"name": "maintenance",
+						"condition": "http.request.uri.path contains \"/admin\"",
+						"overrides": {},
+						"fixed_response": {"message_body": "down for maintenance", "status_code": 503}
+					}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/199d98642c564d2e855e9661899b7252/load_balancers", handler)
+
+	failoverAcrossPools := true
+	requireAllHeaders := true
+	request := LoadBalancer{
+		Name:         "www.example.com",
+		FallbackPool: "17b5962d775c646f3f9725cbc7a53df4",
+		DefaultPools: []string{"de90f38ced07c2e2f4df50b1f61d4194", "17b5962d775c646f3f9725cbc7a53df4"},
+		Proxied:      true,
+		Persistence:  "header",
+		SessionAffinityAttributes: &SessionAffinityAttributes{
+			ZeroDowntimeFailover: "sticky",
+			Headers:              []string{"x-session-id"},
+			RequireAllHeaders:    &requireAllHeaders,
+		},
+		AdaptiveRouting:  &LoadBalancerAdaptiveRouting{FailoverAcrossPools: &failoverAcrossPools},
+		LocationStrategy: &LoadBalancerLocationStrategy{PreferECS: "always", Mode: "resolver_ip"},
+		RandomSteering: &LoadBalancerRandomSteering{
+			DefaultWeight: 0.2,
+			PoolWeights:   map[string]float64{"de90f38ced07c2e2f4df50b1f61d4194": 0.8},
+		},
+		SteeringPolicy: "least_outstanding_requests",
+		Rules: []*LoadBalancerRule{
+			{
+				Name:      "maintenance",
+				Condition: "http.request.uri.path contains \"/admin\"",
+				FixedResponse: &LoadBalancerFixedResponseData{
+					MessageBody: "down for maintenance",
+					StatusCode:  503,
+				},
+			},
+		},
+	}
+	want := request
+	want.ID = "699d98642c564d2e855e9661899b7252"
+
+	actual, err := client.CreateLoadBalancer(context.Background(), "199d98642c564d2e855e9661899b7252", request)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestLoadBalancerValidate(t *testing.T) {
+	valid := LoadBalancer{
+		Name:         "www.example.com",
+		FallbackPool: "17b5962d775c646f3f9725cbc7a53df4",
+		DefaultPools: []string{"17b5962d775c646f3f9725cbc7a53df4"},
+	}
+
+	tests := map[string]struct {
+		modify  func(lb *LoadBalancer)
+		wantErr string
+	}{
+		"valid": {
+			modify: func(lb *LoadBalancer) {
+				lb.SteeringPolicy = SteeringLeastConnections
+				lb.Persistence = SessionAffinityHeader
+				lb.SessionAffinityAttributes = &SessionAffinityAttributes{Headers: []string{"x-session-id"}}
+			},
+		},
+		"missing name": {
+			modify:  func(lb *LoadBalancer) { lb.Name = "" },
+			wantErr: "load balancer name cannot be empty",
+		},
+		"missing fallback pool": {
+			modify:  func(lb *LoadBalancer) { lb.FallbackPool = "" },
+			wantErr: "fallback pool cannot be empty",
+		},
+		"missing default pools": {
+			modify:  func(lb *LoadBalancer) { lb.DefaultPools = nil },
+			wantErr: "default pools cannot be empty",
+		},
+		"invalid steering policy": {
+			modify:  func(lb *LoadBalancer) { lb.SteeringPolicy = "fastest" },
+			wantErr: `invalid steering policy "fastest"`,
+		},
+		"invalid session affinity": {
+			modify:  func(lb *LoadBalancer) { lb.Persistence = "sticky" },
+			wantErr: `invalid session affinity "sticky"`,
+		},
+		"header affinity without headers": {
+			modify:  func(lb *LoadBalancer) { lb.Persistence = SessionAffinityHeader },
+			wantErr: `session affinity "header" requires at least one header`,
+		},
+		"headers without header affinity": {
+			modify: func(lb *LoadBalancer) {
+				lb.Persistence = SessionAffinityCookie
+				lb.SessionAffinityAttributes = &SessionAffinityAttributes{Headers: []string{"x-session-id"}}
+			},
+			wantErr: `session affinity headers are only valid with session affinity "header"`,
+		},
+		"invalid rule override": {
+			modify: func(lb *LoadBalancer) {
+				lb.Rules = []*LoadBalancerRule{{
+					Name:      "example rule",
+					Overrides: LoadBalancerRuleOverrides{SteeringPolicy: "fastest"},
+				}}
+			},
+			wantErr: `rule "example rule": invalid steering policy "fastest"`,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			lb := valid
+			tc.modify(&lb)
+			err := lb.Validate()
+			if tc.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateLoadBalancerInvalidSteeringPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/199d98642c564d2e855e9661899b7252/load_balancers", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+	})
+
+	_, err := client.CreateLoadBalancer(context.Background(), "199d98642c564d2e855e9661899b7252", LoadBalancer{
+		Name:           "www.example.com",
+		FallbackPool:   "17b5962d775c646f3f9725cbc7a53df4",
+		DefaultPools:   []string{"17b5962d775c646f3f9725cbc7a53df4"},
+		SteeringPolicy: "fastest",
+	})
+	assert.EqualError(t, err, `invalid steering policy "fastest"`)
+}
+
+func TestModifyLoadBalancerInvalidSessionAffinity(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/199d98642c564d2e855e9661899b7252/load_balancers/699d98642c564d2e855e9661899b7252", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("Unexpected request %s %s", r.Method, r.URL)
+	})
+
+	_, err := client.ModifyLoadBalancer(context.Background(), "199d98642c564d2e855e9661899b7252", LoadBalancer{
+		ID:           "699d98642c564d2e855e9661899b7252",
+		Name:         "www.example.com",
+		FallbackPool: "17b5962d775c646f3f9725cbc7a53df4",
+		DefaultPools: []string{"17b5962d775c646f3f9725cbc7a53df4"},
+		Persistence:  "sticky",
+	})
+	assert.EqualError(t, err, `invalid session affinity "sticky"`)
+}
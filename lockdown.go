@@ -127,11 +127,45 @@ func (api *API) ZoneLockdown(ctx context.Context, zoneID string, id string) (*Zo
 	return response, nil
 }
 
+// ZoneLockdownListParams holds the filters used when listing Zone Lockdown
+// rules.
+type ZoneLockdownListParams struct {
+	Description       string
+	DescriptionSearch string
+	URISearch         string
+	IPSearch          string
+	IPRangeSearch     string
+	Priority          int
+}
+
 // ListZoneLockdowns retrieves a list of Zone ZoneLockdown rules for a given
 // zone ID by page number.
 //
 // API reference: https://api.cloudflare.com/#zone-ZoneLockdown-list-ZoneLockdown-rules
 func (api *API) ListZoneLockdowns(ctx context.Context, zoneID string, page int) (*ZoneLockdownListResponse, error) {
+	return api.listZoneLockdowns(ctx, zoneID, ZoneLockdownListParams{}, page)
+}
+
+// ListAllZoneLockdowns retrieves all Zone ZoneLockdown rules for a given
+// zone ID matching params, fetching every page of results.
+//
+// API reference: https://api.cloudflare.com/#zone-ZoneLockdown-list-ZoneLockdown-rules
+func (api *API) ListAllZoneLockdowns(ctx context.Context, zoneID string, params ZoneLockdownListParams) ([]ZoneLockdown, error) {
+	var lockdowns []ZoneLockdown
+	for page := 1; ; page++ {
+		r, err := api.listZoneLockdowns(ctx, zoneID, params, page)
+		if err != nil {
+			return nil, err
+		}
+		lockdowns = append(lockdowns, r.Result...)
+		if len(r.Result) == 0 || r.ResultInfo.Page >= r.ResultInfo.TotalPages {
+			break
+		}
+	}
+	return lockdowns, nil
+}
+
+func (api *API) listZoneLockdowns(ctx context.Context, zoneID string, params ZoneLockdownListParams, page int) (*ZoneLockdownListResponse, error) {
 	v := url.Values{}
 	if page <= 0 {
 		page = 1
@@ -140,6 +174,25 @@ func (api *API) ListZoneLockdowns(ctx context.Context, zoneID string, page int)
 	v.Set("page", strconv.Itoa(page))
 	v.Set("per_page", strconv.Itoa(100))
 
+	if params.Description != "" {
+		v.Set("description", params.Description)
+	}
+	if params.DescriptionSearch != "" {
+		v.Set("description_search", params.DescriptionSearch)
+	}
+	if params.URISearch != "" {
+		v.Set("uri_search", params.URISearch)
+	}
+	if params.IPSearch != "" {
+		v.Set("ip_search", params.IPSearch)
+	}
+	if params.IPRangeSearch != "" {
+		v.Set("ip_range_search", params.IPRangeSearch)
+	}
+	if params.Priority > 0 {
+		v.Set("priority", strconv.Itoa(params.Priority))
+	}
+
 	uri := fmt.Sprintf("/zones/%s/firewall/lockdowns?%s", zoneID, v.Encode())
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
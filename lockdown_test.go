@@ -333,3 +333,45 @@ func TestListZoneLockdowns(t *testing.T) {
 	}
 	assert.Equal(t, want, actual)
 }
+
+func TestListAllZoneLockdowns(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "/api/*", r.URL.Query().Get("uri_search"))
+		assert.Equal(t, "198.51.100.4", r.URL.Query().Get("ip_search"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "lockdown-%[1]s",
+					"description": "",
+					"paused": false,
+					"urls": ["api.mysite.com/api/*"],
+					"configurations": [{"target": "ip", "value": "198.51.100.4"}]
+				}
+			],
+			"result_info": {"page": %[1]s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/firewall/lockdowns", handler)
+
+	actual, err := client.ListAllZoneLockdowns(context.Background(), testZoneID, ZoneLockdownListParams{
+		URISearch: "/api/*",
+		IPSearch:  "198.51.100.4",
+	})
+	require.NoError(t, err)
+
+	if assert.Len(t, actual, 2) {
+		assert.Equal(t, "lockdown-1", actual[0].ID)
+		assert.Equal(t, "lockdown-2", actual[1].ID)
+		assert.Equal(t, []ZoneLockdownConfig{{Target: "ip", Value: "198.51.100.4"}}, actual[1].Configurations)
+	}
+}
@@ -0,0 +1,192 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// LogExplorerDataset is a log dataset stored by Log Explorer for a zone or
+// account.
+type LogExplorerDataset struct {
+	ID         string     `json:"dataset_id,omitempty"`
+	Dataset    string     `json:"dataset"`
+	ObjectType string     `json:"object_type,omitempty"`
+	ObjectID   string     `json:"object_id,omitempty"`
+	Enabled    bool       `json:"enabled"`
+	CreatedAt  *time.Time `json:"created_at,omitempty"`
+	UpdatedAt  *time.Time `json:"updated_at,omitempty"`
+}
+
+// LogExplorerDatasetResponse represents the response from the Log Explorer
+// dataset endpoints that return a single dataset.
+type LogExplorerDatasetResponse struct {
+	Response
+	Result LogExplorerDataset `json:"result"`
+}
+
+// LogExplorerDatasetsResponse represents the response from the Log Explorer
+// dataset list endpoint.
+type LogExplorerDatasetsResponse struct {
+	Response
+	Result []LogExplorerDataset `json:"result"`
+}
+
+type logExplorerQueryResponse struct {
+	Response
+	Result json.RawMessage `json:"result"`
+}
+
+// LogExplorerDatasets returns the Log Explorer datasets of a zone.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) LogExplorerDatasets(ctx context.Context, zoneID string) ([]LogExplorerDataset, error) {
+	return api.logExplorerDatasets(ctx, ZoneRouteRoot, zoneID)
+}
+
+// AccountLogExplorerDatasets returns the Log Explorer datasets of an account.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) AccountLogExplorerDatasets(ctx context.Context, accountID string) ([]LogExplorerDataset, error) {
+	return api.logExplorerDatasets(ctx, AccountRouteRoot, accountID)
+}
+
+func (api *API) logExplorerDatasets(ctx context.Context, routeRoot RouteRoot, id string) ([]LogExplorerDataset, error) {
+	uri := fmt.Sprintf("/%s/%s/logs/explorer/datasets", routeRoot, id)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []LogExplorerDataset{}, err
+	}
+
+	var r LogExplorerDatasetsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []LogExplorerDataset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateLogExplorerDataset starts storing a dataset, e.g. "http_requests",
+// for a zone so it can be queried with QueryLogExplorer.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) CreateLogExplorerDataset(ctx context.Context, zoneID, dataset string) (LogExplorerDataset, error) {
+	return api.createLogExplorerDataset(ctx, ZoneRouteRoot, zoneID, dataset)
+}
+
+// CreateAccountLogExplorerDataset starts storing a dataset, e.g.
+// "audit_logs", for an account so it can be queried with
+// QueryAccountLogExplorer.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) CreateAccountLogExplorerDataset(ctx context.Context, accountID, dataset string) (LogExplorerDataset, error) {
+	return api.createLogExplorerDataset(ctx, AccountRouteRoot, accountID, dataset)
+}
+
+func (api *API) createLogExplorerDataset(ctx context.Context, routeRoot RouteRoot, id, dataset string) (LogExplorerDataset, error) {
+	if dataset == "" {
+		return LogExplorerDataset{}, errors.Errorf("dataset cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/logs/explorer/datasets", routeRoot, id)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogExplorerDataset{Dataset: dataset, Enabled: true})
+	if err != nil {
+		return LogExplorerDataset{}, err
+	}
+
+	var r LogExplorerDatasetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogExplorerDataset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateLogExplorerDataset enables or disables the storage of a zone's Log
+// Explorer dataset.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) UpdateLogExplorerDataset(ctx context.Context, zoneID, datasetID string, enabled bool) (LogExplorerDataset, error) {
+	return api.updateLogExplorerDataset(ctx, ZoneRouteRoot, zoneID, datasetID, enabled)
+}
+
+// UpdateAccountLogExplorerDataset enables or disables the storage of an
+// account's Log Explorer dataset.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) UpdateAccountLogExplorerDataset(ctx context.Context, accountID, datasetID string, enabled bool) (LogExplorerDataset, error) {
+	return api.updateLogExplorerDataset(ctx, AccountRouteRoot, accountID, datasetID, enabled)
+}
+
+func (api *API) updateLogExplorerDataset(ctx context.Context, routeRoot RouteRoot, id, datasetID string, enabled bool) (LogExplorerDataset, error) {
+	if datasetID == "" {
+		return LogExplorerDataset{}, errors.Errorf("dataset ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/logs/explorer/datasets/%s", routeRoot, id, datasetID)
+	params := struct {
+		Enabled bool `json:"enabled"`
+	}{enabled}
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	if err != nil {
+		return LogExplorerDataset{}, err
+	}
+
+	var r LogExplorerDatasetResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return LogExplorerDataset{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// QueryLogExplorer runs a SQL query against the stored logs of a zone and
+// decodes the returned rows into rows, which should be a pointer to a slice
+// of structs or maps keyed by field name.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) QueryLogExplorer(ctx context.Context, zoneID, query string, rows interface{}) error {
+	return api.queryLogExplorer(ctx, ZoneRouteRoot, zoneID, query, rows)
+}
+
+// QueryAccountLogExplorer runs a SQL query against the stored logs of an
+// account and decodes the returned rows into rows.
+//
+// API reference: https://developers.cloudflare.com/logs/log-explorer/
+func (api *API) QueryAccountLogExplorer(ctx context.Context, accountID, query string, rows interface{}) error {
+	return api.queryLogExplorer(ctx, AccountRouteRoot, accountID, query, rows)
+}
+
+func (api *API) queryLogExplorer(ctx context.Context, routeRoot RouteRoot, id, query string, rows interface{}) error {
+	if query == "" {
+		return errors.Errorf("query cannot be empty")
+	}
+
+	v := url.Values{}
+	v.Set("query", query)
+	uri := fmt.Sprintf("/%s/%s/logs/explorer/query/sql?%s", routeRoot, id, v.Encode())
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	var r logExplorerQueryResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	if rows == nil || len(r.Result) == 0 {
+		return nil
+	}
+
+	err = json.Unmarshal(r.Result, rows)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const serverLogExplorerDataset = `{
+	"dataset_id": "01906f5a-2bb7-7bd1-b1a4-2bd7a5aa2a2f",
+	"dataset": "http_requests",
+	"object_type": "zone",
+	"object_id": "%s",
+	"enabled": %t,
+	"created_at": "2024-06-01T00:00:00Z",
+	"updated_at": "2024-06-01T00:00:00Z"
+}`
+
+var testLogExplorerTimestamp, _ = time.Parse(time.RFC3339, "2024-06-01T00:00:00Z")
+
+func expectedLogExplorerDataset(enabled bool) LogExplorerDataset {
+	return LogExplorerDataset{
+		ID:         "01906f5a-2bb7-7bd1-b1a4-2bd7a5aa2a2f",
+		Dataset:    "http_requests",
+		ObjectType: "zone",
+		ObjectID:   testZoneID,
+		Enabled:    enabled,
+		CreatedAt:  &testLogExplorerTimestamp,
+		UpdatedAt:  &testLogExplorerTimestamp,
+	}
+}
+
+func TestLogExplorerDatasets(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [%s],
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`, fmt.Sprintf(serverLogExplorerDataset, testZoneID, true))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/explorer/datasets", handler)
+
+	actual, err := client.LogExplorerDatasets(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []LogExplorerDataset{expectedLogExplorerDataset(true)}, actual)
+	}
+}
+
+func TestCreateLogExplorerDataset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"dataset":"http_requests","enabled":true}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": %s,
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`, fmt.Sprintf(serverLogExplorerDataset, testZoneID, true))
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/explorer/datasets", handler)
+
+	actual, err := client.CreateLogExplorerDataset(context.Background(), testZoneID, LogpushDatasetHTTPRequests)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedLogExplorerDataset(true), actual)
+	}
+
+	_, err = client.CreateLogExplorerDataset(context.Background(), testZoneID, "")
+	assert.EqualError(t, err, "dataset cannot be empty")
+}
+
+func TestUpdateAccountLogExplorerDataset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"enabled":false}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": %s,
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`, fmt.Sprintf(serverLogExplorerDataset, testZoneID, false))
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logs/explorer/datasets/01906f5a-2bb7-7bd1-b1a4-2bd7a5aa2a2f", handler)
+
+	actual, err := client.UpdateAccountLogExplorerDataset(context.Background(), testAccountID, "01906f5a-2bb7-7bd1-b1a4-2bd7a5aa2a2f", false)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedLogExplorerDataset(false), actual)
+	}
+
+	_, err = client.UpdateAccountLogExplorerDataset(context.Background(), testAccountID, "", false)
+	assert.EqualError(t, err, "dataset ID cannot be empty")
+}
+
+func TestQueryLogExplorer(t *testing.T) {
+	setup()
+	defer teardown()
+
+	query := "SELECT clientRequestPath, edgeResponseStatus FROM http_requests WHERE edgeResponseStatus >= 500 LIMIT 2"
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, query, r.URL.Query().Get("query"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [
+				{"clientRequestPath": "/api/login", "edgeResponseStatus": 502},
+				{"clientRequestPath": "/", "edgeResponseStatus": 500}
+			],
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/explorer/query/sql", handler)
+
+	type row struct {
+		ClientRequestPath  string `json:"clientRequestPath"`
+		EdgeResponseStatus int    `json:"edgeResponseStatus"`
+	}
+	var rows []row
+	err := client.QueryLogExplorer(context.Background(), testZoneID, query, &rows)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []row{
+			{ClientRequestPath: "/api/login", EdgeResponseStatus: 502},
+			{ClientRequestPath: "/", EdgeResponseStatus: 500},
+		}, rows)
+	}
+
+	err = client.QueryLogExplorer(context.Background(), testZoneID, "", &rows)
+	assert.EqualError(t, err, "query cannot be empty")
+}
@@ -1,10 +1,18 @@
 package cloudflare
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -57,3 +65,133 @@ func (api *API) SetLogpullRetentionFlag(ctx context.Context, zoneID string, enab
 	}
 	return &r.Result, nil
 }
+
+// LogpullReceivedParams holds the time window and options used when
+// retrieving logs with Logpull.
+type LogpullReceivedParams struct {
+	// Start is inclusive and End is exclusive. Both are required.
+	Start time.Time
+	End   time.Time
+	// Fields lists the fields to return. When empty only a default set of
+	// fields is returned.
+	Fields []string
+	// Sample is the fraction of records to return, between 0.001 and 1.
+	Sample float64
+	// Count limits the number of records returned.
+	Count int
+	// Timestamps is the timestamp format: "unix", "unixnano" (the
+	// default) or "rfc3339".
+	Timestamps string
+}
+
+// Encode encodes the Logpull parameters into a query string.
+func (p LogpullReceivedParams) Encode() string {
+	v := url.Values{}
+
+	v.Set("start", p.Start.UTC().Format(time.RFC3339))
+	v.Set("end", p.End.UTC().Format(time.RFC3339))
+	if len(p.Fields) > 0 {
+		v.Set("fields", strings.Join(p.Fields, ","))
+	}
+	if p.Sample > 0 {
+		v.Set("sample", strconv.FormatFloat(p.Sample, 'f', -1, 64))
+	}
+	if p.Count > 0 {
+		v.Set("count", strconv.Itoa(p.Count))
+	}
+	if p.Timestamps != "" {
+		v.Set("timestamps", p.Timestamps)
+	}
+
+	return v.Encode()
+}
+
+// LogpullRecord is a single log record returned by Logpull, keyed by field
+// name. Numbers are decoded as json.Number so nanosecond timestamps keep
+// their precision.
+type LogpullRecord map[string]interface{}
+
+// LogpullReceived streams the logs received for a zone within a time window
+// and calls fn for each record as it is decoded, so large windows are never
+// held in memory. Returning an error from fn stops the stream and returns
+// that error.
+//
+// API reference: https://developers.cloudflare.com/logs/logpull/requesting-logs/
+func (api *API) LogpullReceived(ctx context.Context, zoneID string, params LogpullReceivedParams, fn func(LogpullRecord) error) error {
+	body, err := api.logpullReceived(ctx, zoneID, params)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	dec := json.NewDecoder(bufio.NewReader(body))
+	dec.UseNumber()
+	for {
+		var record LogpullRecord
+		err := dec.Decode(&record)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, errUnmarshalError)
+		}
+		if err := fn(record); err != nil {
+			return err
+		}
+	}
+}
+
+// LogpullReceivedWriter streams the raw NDJSON logs received for a zone
+// within a time window to w.
+//
+// API reference: https://developers.cloudflare.com/logs/logpull/requesting-logs/
+func (api *API) LogpullReceivedWriter(ctx context.Context, zoneID string, params LogpullReceivedParams, w io.Writer) error {
+	body, err := api.logpullReceived(ctx, zoneID, params)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	if _, err := io.Copy(w, body); err != nil {
+		return errors.Wrap(err, "could not read response body")
+	}
+	return nil
+}
+
+// logpullReceived performs the Logpull request and returns the unread
+// response body. Unlike makeRequestContext the body is not buffered and the
+// request is not retried, as it may be arbitrarily large.
+func (api *API) logpullReceived(ctx context.Context, zoneID string, params LogpullReceivedParams) (io.ReadCloser, error) {
+	if params.Start.IsZero() || params.End.IsZero() {
+		return nil, errors.Errorf("start and end time cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/logs/received?%s", zoneID, params.Encode())
+
+	if err := api.rateLimiter.Wait(ctx); err != nil {
+		return nil, errors.Wrap(err, "Error caused by request rate limiting")
+	}
+	resp, err := api.request(ctx, http.MethodGet, uri, nil, api.authType, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		respBody, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not read response body")
+		}
+
+		errBody := &Response{}
+		if err := json.Unmarshal(respBody, &errBody); err != nil || len(errBody.Errors) == 0 {
+			return nil, errors.Errorf("HTTP status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+		}
+		return nil, &APIRequestError{
+			StatusCode: resp.StatusCode,
+			Errors:     errBody.Errors,
+		}
+	}
+
+	return resp.Body, nil
+}
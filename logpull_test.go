@@ -1,10 +1,13 @@
 package cloudflare
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -60,3 +63,100 @@ func TestSetLogpullRetentionFlag(t *testing.T) {
 		assert.Equal(t, want, actual)
 	}
 }
+
+const testLogpullReceivedNDJSON = `{"ClientIP":"198.51.100.1","EdgeStartTimestamp":1686000000123456789,"RayID":"7d2a1c6d3f6a0b1c"}
+{"ClientIP":"198.51.100.2","EdgeStartTimestamp":1686000000223456789,"RayID":"7d2a1c6d3f6a0b1d"}
+`
+
+func testLogpullReceivedParams() LogpullReceivedParams {
+	start, _ := time.Parse(time.RFC3339, "2023-06-05T21:20:00Z")
+	end, _ := time.Parse(time.RFC3339, "2023-06-05T21:21:00Z")
+
+	return LogpullReceivedParams{
+		Start:  start,
+		End:    end,
+		Fields: []string{"ClientIP", "EdgeStartTimestamp", "RayID"},
+		Sample: 0.1,
+	}
+}
+
+func TestLogpullReceived(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2023-06-05T21:20:00Z", r.URL.Query().Get("start"))
+		assert.Equal(t, "2023-06-05T21:21:00Z", r.URL.Query().Get("end"))
+		assert.Equal(t, "ClientIP,EdgeStartTimestamp,RayID", r.URL.Query().Get("fields"))
+		assert.Equal(t, "0.1", r.URL.Query().Get("sample"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, testLogpullReceivedNDJSON)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	want := []LogpullRecord{
+		{"ClientIP": "198.51.100.1", "EdgeStartTimestamp": json.Number("1686000000123456789"), "RayID": "7d2a1c6d3f6a0b1c"},
+		{"ClientIP": "198.51.100.2", "EdgeStartTimestamp": json.Number("1686000000223456789"), "RayID": "7d2a1c6d3f6a0b1d"},
+	}
+
+	var actual []LogpullRecord
+	err := client.LogpullReceived(context.Background(), testZoneID, testLogpullReceivedParams(), func(record LogpullRecord) error {
+		actual = append(actual, record)
+		return nil
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	err = client.LogpullReceived(context.Background(), testZoneID, LogpullReceivedParams{}, nil)
+	assert.EqualError(t, err, "start and end time cannot be empty")
+}
+
+func TestLogpullReceivedWriter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, testLogpullReceivedNDJSON)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	var buf bytes.Buffer
+	err := client.LogpullReceivedWriter(context.Background(), testZoneID, testLogpullReceivedParams(), &buf)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, testLogpullReceivedNDJSON, buf.String())
+	}
+}
+
+func TestLogpullReceivedError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 1010, "message": "bad query: end must be at least one minute earlier than now"}],
+			"messages": [],
+			"result": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logs/received", handler)
+
+	err := client.LogpullReceivedWriter(context.Background(), testZoneID, testLogpullReceivedParams(), &bytes.Buffer{})
+	if assert.Error(t, err) {
+		apiErr, ok := err.(*APIRequestError)
+		if assert.True(t, ok) {
+			assert.Equal(t, http.StatusBadRequest, apiErr.StatusCode)
+		}
+	}
+}
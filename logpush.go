@@ -10,18 +10,68 @@ import (
 	"github.com/pkg/errors"
 )
 
+// These constants represent the datasets Logpush jobs can be created for.
+// Zone scoped datasets are used with the zone methods, account scoped
+// datasets with the Account methods.
+const (
+	LogpushDatasetHTTPRequests       = "http_requests"
+	LogpushDatasetFirewallEvents     = "firewall_events"
+	LogpushDatasetSpectrumEvents     = "spectrum_events"
+	LogpushDatasetDNSLogs            = "dns_logs"
+	LogpushDatasetNELReports         = "nel_reports"
+	LogpushDatasetAuditLogs          = "audit_logs"
+	LogpushDatasetGatewayDNS         = "gateway_dns"
+	LogpushDatasetGatewayHTTP        = "gateway_http"
+	LogpushDatasetGatewayNetwork     = "gateway_network"
+	LogpushDatasetAccessRequests     = "access_requests"
+	LogpushDatasetWorkersTraceEvents = "workers_trace_events"
+)
+
 // LogpushJob describes a Logpush job.
 type LogpushJob struct {
-	ID                 int        `json:"id,omitempty"`
-	Dataset            string     `json:"dataset"`
-	Enabled            bool       `json:"enabled"`
-	Name               string     `json:"name"`
-	LogpullOptions     string     `json:"logpull_options"`
-	DestinationConf    string     `json:"destination_conf"`
-	OwnershipChallenge string     `json:"ownership_challenge,omitempty"`
-	LastComplete       *time.Time `json:"last_complete,omitempty"`
-	LastError          *time.Time `json:"last_error,omitempty"`
-	ErrorMessage       string     `json:"error_message,omitempty"`
+	ID                 int                   `json:"id,omitempty"`
+	Dataset            string                `json:"dataset"`
+	Enabled            bool                  `json:"enabled"`
+	Name               string                `json:"name"`
+	LogpullOptions     string                `json:"logpull_options,omitempty"`
+	OutputOptions      *LogpushOutputOptions `json:"output_options,omitempty"`
+	DestinationConf    string                `json:"destination_conf"`
+	OwnershipChallenge string                `json:"ownership_challenge,omitempty"`
+	LastComplete       *time.Time            `json:"last_complete,omitempty"`
+	LastError          *time.Time            `json:"last_error,omitempty"`
+	ErrorMessage       string                `json:"error_message,omitempty"`
+	// Frequency is "high" (the default) for smaller files pushed more
+	// often, or "low" for larger files pushed less often.
+	Frequency string `json:"frequency,omitempty"`
+	// Kind is "edge" for Instant Logs jobs and empty otherwise.
+	Kind string `json:"kind,omitempty"`
+	// MaxUploadBytes, MaxUploadRecords and MaxUploadIntervalSeconds bound
+	// the size of each file pushed to the destination. Zero values use the
+	// API defaults.
+	MaxUploadBytes           int `json:"max_upload_bytes,omitempty"`
+	MaxUploadRecords         int `json:"max_upload_records,omitempty"`
+	MaxUploadIntervalSeconds int `json:"max_upload_interval_seconds,omitempty"`
+	// Filter restricts the logs pushed by the job to those matching it.
+	Filter *LogpushJobFilters `json:"filter,omitempty"`
+}
+
+// LogpushOutputOptions describes the fields and formatting of the logs
+// pushed by a job. It replaces LogpullOptions.
+type LogpushOutputOptions struct {
+	FieldNames      []string `json:"field_names,omitempty"`
+	OutputType      string   `json:"output_type,omitempty"`
+	BatchPrefix     string   `json:"batch_prefix,omitempty"`
+	BatchSuffix     string   `json:"batch_suffix,omitempty"`
+	RecordPrefix    string   `json:"record_prefix,omitempty"`
+	RecordSuffix    string   `json:"record_suffix,omitempty"`
+	RecordTemplate  string   `json:"record_template,omitempty"`
+	RecordDelimiter string   `json:"record_delimiter,omitempty"`
+	FieldDelimiter  string   `json:"field_delimiter,omitempty"`
+	TimestampFormat string   `json:"timestamp_format,omitempty"`
+	SampleRate      float64  `json:"sample_rate,omitempty"`
+	// CVE202144228 replaces occurrences of "${" with "x{" to protect
+	// downstream systems from CVE-2021-44228.
+	CVE202144228 *bool `json:"CVE-2021-44228,omitempty"`
 }
 
 // LogpushJobsResponse is the API response, containing an array of Logpush Jobs.
@@ -96,7 +146,24 @@ type LogpushDestinationExistsRequest struct {
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-create-logpush-job
 func (api *API) CreateLogpushJob(ctx context.Context, zoneID string, job LogpushJob) (*LogpushJob, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/jobs", zoneID)
+	return api.createLogpushJob(ctx, ZoneRouteRoot, zoneID, job)
+}
+
+// CreateAccountLogpushJob creates a new LogpushJob for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-create-logpush-job
+func (api *API) CreateAccountLogpushJob(ctx context.Context, accountID string, job LogpushJob) (*LogpushJob, error) {
+	return api.createLogpushJob(ctx, AccountRouteRoot, accountID, job)
+}
+
+func (api *API) createLogpushJob(ctx context.Context, routeRoot RouteRoot, id string, job LogpushJob) (*LogpushJob, error) {
+	if job.Filter != nil {
+		if err := job.Filter.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	uri := fmt.Sprintf("/%s/%s/logpush/jobs", routeRoot, id)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, job)
 	if err != nil {
 		return nil, err
@@ -114,16 +181,15 @@ func (api *API) CreateLogpushJob(ctx context.Context, zoneID string, job Logpush
 // API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs
 func (api *API) LogpushJobs(ctx context.Context, zoneID string) ([]LogpushJob, error) {
 	uri := fmt.Sprintf("/zones/%s/logpush/jobs", zoneID)
-	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
-	if err != nil {
-		return []LogpushJob{}, err
-	}
-	var r LogpushJobsResponse
-	err = json.Unmarshal(res, &r)
-	if err != nil {
-		return []LogpushJob{}, errors.Wrap(err, errUnmarshalError)
-	}
-	return r.Result, nil
+	return api.logpushJobs(ctx, uri)
+}
+
+// AccountLogpushJobs returns all Logpush Jobs for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-list-logpush-jobs
+func (api *API) AccountLogpushJobs(ctx context.Context, accountID string) ([]LogpushJob, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/jobs", AccountRouteRoot, accountID)
+	return api.logpushJobs(ctx, uri)
 }
 
 // LogpushJobsForDataset returns all Logpush Jobs for a dataset in a zone.
@@ -131,6 +197,19 @@ func (api *API) LogpushJobs(ctx context.Context, zoneID string) ([]LogpushJob, e
 // API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs-for-a-dataset
 func (api *API) LogpushJobsForDataset(ctx context.Context, zoneID, dataset string) ([]LogpushJob, error) {
 	uri := fmt.Sprintf("/zones/%s/logpush/datasets/%s/jobs", zoneID, dataset)
+	return api.logpushJobs(ctx, uri)
+}
+
+// AccountLogpushJobsForDataset returns all Logpush Jobs for a dataset in an
+// account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-list-logpush-jobs-for-a-dataset
+func (api *API) AccountLogpushJobsForDataset(ctx context.Context, accountID, dataset string) ([]LogpushJob, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/datasets/%s/jobs", AccountRouteRoot, accountID, dataset)
+	return api.logpushJobs(ctx, uri)
+}
+
+func (api *API) logpushJobs(ctx context.Context, uri string) ([]LogpushJob, error) {
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return []LogpushJob{}, err
@@ -147,7 +226,18 @@ func (api *API) LogpushJobsForDataset(ctx context.Context, zoneID, dataset strin
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-list-logpush-jobs
 func (api *API) LogpushFields(ctx context.Context, zoneID, dataset string) (LogpushFields, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/datasets/%s/fields", zoneID, dataset)
+	return api.logpushFields(ctx, ZoneRouteRoot, zoneID, dataset)
+}
+
+// AccountLogpushFields returns fields for a given account scoped dataset.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-list-fields
+func (api *API) AccountLogpushFields(ctx context.Context, accountID, dataset string) (LogpushFields, error) {
+	return api.logpushFields(ctx, AccountRouteRoot, accountID, dataset)
+}
+
+func (api *API) logpushFields(ctx context.Context, routeRoot RouteRoot, id, dataset string) (LogpushFields, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/datasets/%s/fields", routeRoot, id, dataset)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return LogpushFields{}, err
@@ -164,7 +254,18 @@ func (api *API) LogpushFields(ctx context.Context, zoneID, dataset string) (Logp
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-logpush-job-details
 func (api *API) LogpushJob(ctx context.Context, zoneID string, jobID int) (LogpushJob, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/jobs/%d", zoneID, jobID)
+	return api.logpushJob(ctx, ZoneRouteRoot, zoneID, jobID)
+}
+
+// AccountLogpushJob fetches detail about one Logpush Job for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-get-logpush-job-details
+func (api *API) AccountLogpushJob(ctx context.Context, accountID string, jobID int) (LogpushJob, error) {
+	return api.logpushJob(ctx, AccountRouteRoot, accountID, jobID)
+}
+
+func (api *API) logpushJob(ctx context.Context, routeRoot RouteRoot, id string, jobID int) (LogpushJob, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/jobs/%d", routeRoot, id, jobID)
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
 		return LogpushJob{}, err
@@ -181,7 +282,24 @@ func (api *API) LogpushJob(ctx context.Context, zoneID string, jobID int) (Logpu
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-update-logpush-job
 func (api *API) UpdateLogpushJob(ctx context.Context, zoneID string, jobID int, job LogpushJob) error {
-	uri := fmt.Sprintf("/zones/%s/logpush/jobs/%d", zoneID, jobID)
+	return api.updateLogpushJob(ctx, ZoneRouteRoot, zoneID, jobID, job)
+}
+
+// UpdateAccountLogpushJob lets you update a Logpush Job for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-update-logpush-job
+func (api *API) UpdateAccountLogpushJob(ctx context.Context, accountID string, jobID int, job LogpushJob) error {
+	return api.updateLogpushJob(ctx, AccountRouteRoot, accountID, jobID, job)
+}
+
+func (api *API) updateLogpushJob(ctx context.Context, routeRoot RouteRoot, id string, jobID int, job LogpushJob) error {
+	if job.Filter != nil {
+		if err := job.Filter.Validate(); err != nil {
+			return err
+		}
+	}
+
+	uri := fmt.Sprintf("/%s/%s/logpush/jobs/%d", routeRoot, id, jobID)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, job)
 	if err != nil {
 		return err
@@ -198,7 +316,18 @@ func (api *API) UpdateLogpushJob(ctx context.Context, zoneID string, jobID int,
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-delete-logpush-job
 func (api *API) DeleteLogpushJob(ctx context.Context, zoneID string, jobID int) error {
-	uri := fmt.Sprintf("/zones/%s/logpush/jobs/%d", zoneID, jobID)
+	return api.deleteLogpushJob(ctx, ZoneRouteRoot, zoneID, jobID)
+}
+
+// DeleteAccountLogpushJob deletes a Logpush Job for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-delete-logpush-job
+func (api *API) DeleteAccountLogpushJob(ctx context.Context, accountID string, jobID int) error {
+	return api.deleteLogpushJob(ctx, AccountRouteRoot, accountID, jobID)
+}
+
+func (api *API) deleteLogpushJob(ctx context.Context, routeRoot RouteRoot, id string, jobID int) error {
+	uri := fmt.Sprintf("/%s/%s/logpush/jobs/%d", routeRoot, id, jobID)
 	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
 	if err != nil {
 		return err
@@ -215,7 +344,19 @@ func (api *API) DeleteLogpushJob(ctx context.Context, zoneID string, jobID int)
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-get-ownership-challenge
 func (api *API) GetLogpushOwnershipChallenge(ctx context.Context, zoneID, destinationConf string) (*LogpushGetOwnershipChallenge, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/ownership", zoneID)
+	return api.getLogpushOwnershipChallenge(ctx, ZoneRouteRoot, zoneID, destinationConf)
+}
+
+// GetAccountLogpushOwnershipChallenge returns ownership challenge for an
+// account scoped destination.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-get-ownership-challenge
+func (api *API) GetAccountLogpushOwnershipChallenge(ctx context.Context, accountID, destinationConf string) (*LogpushGetOwnershipChallenge, error) {
+	return api.getLogpushOwnershipChallenge(ctx, AccountRouteRoot, accountID, destinationConf)
+}
+
+func (api *API) getLogpushOwnershipChallenge(ctx context.Context, routeRoot RouteRoot, id, destinationConf string) (*LogpushGetOwnershipChallenge, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/ownership", routeRoot, id)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogpushGetOwnershipChallengeRequest{
 		DestinationConf: destinationConf,
 	})
@@ -239,7 +380,19 @@ func (api *API) GetLogpushOwnershipChallenge(ctx context.Context, zoneID, destin
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-validate-ownership-challenge
 func (api *API) ValidateLogpushOwnershipChallenge(ctx context.Context, zoneID, destinationConf, ownershipChallenge string) (bool, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/ownership/validate", zoneID)
+	return api.validateLogpushOwnershipChallenge(ctx, ZoneRouteRoot, zoneID, destinationConf, ownershipChallenge)
+}
+
+// ValidateAccountLogpushOwnershipChallenge returns ownership challenge
+// validation result for an account scoped destination.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-validate-ownership-challenge
+func (api *API) ValidateAccountLogpushOwnershipChallenge(ctx context.Context, accountID, destinationConf, ownershipChallenge string) (bool, error) {
+	return api.validateLogpushOwnershipChallenge(ctx, AccountRouteRoot, accountID, destinationConf, ownershipChallenge)
+}
+
+func (api *API) validateLogpushOwnershipChallenge(ctx context.Context, routeRoot RouteRoot, id, destinationConf, ownershipChallenge string) (bool, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/ownership/validate", routeRoot, id)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogpushValidateOwnershipChallengeRequest{
 		DestinationConf:    destinationConf,
 		OwnershipChallenge: ownershipChallenge,
@@ -259,7 +412,19 @@ func (api *API) ValidateLogpushOwnershipChallenge(ctx context.Context, zoneID, d
 //
 // API reference: https://api.cloudflare.com/#logpush-jobs-check-destination-exists
 func (api *API) CheckLogpushDestinationExists(ctx context.Context, zoneID, destinationConf string) (bool, error) {
-	uri := fmt.Sprintf("/zones/%s/logpush/validate/destination/exists", zoneID)
+	return api.checkLogpushDestinationExists(ctx, ZoneRouteRoot, zoneID, destinationConf)
+}
+
+// CheckAccountLogpushDestinationExists returns destination exists check
+// result for an account.
+//
+// API reference: https://api.cloudflare.com/#logpush-jobs-for-an-account-check-destination-exists
+func (api *API) CheckAccountLogpushDestinationExists(ctx context.Context, accountID, destinationConf string) (bool, error) {
+	return api.checkLogpushDestinationExists(ctx, AccountRouteRoot, accountID, destinationConf)
+}
+
+func (api *API) checkLogpushDestinationExists(ctx context.Context, routeRoot RouteRoot, id, destinationConf string) (bool, error) {
+	uri := fmt.Sprintf("/%s/%s/logpush/validate/destination/exists", routeRoot, id)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, LogpushDestinationExistsRequest{
 		DestinationConf: destinationConf,
 	})
@@ -0,0 +1,175 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"github.com/pkg/errors"
+)
+
+// LogpushFilterOperator is a comparison used in a Logpush job filter.
+type LogpushFilterOperator string
+
+// These constants represent the operators supported by Logpush job filters.
+const (
+	LogpushFilterEqual              LogpushFilterOperator = "eq"
+	LogpushFilterNotEqual           LogpushFilterOperator = "!eq"
+	LogpushFilterLessThan           LogpushFilterOperator = "lt"
+	LogpushFilterLessThanOrEqual    LogpushFilterOperator = "leq"
+	LogpushFilterGreaterThan        LogpushFilterOperator = "gt"
+	LogpushFilterGreaterThanOrEqual LogpushFilterOperator = "geq"
+	LogpushFilterStartsWith         LogpushFilterOperator = "startsWith"
+	LogpushFilterEndsWith           LogpushFilterOperator = "endsWith"
+	LogpushFilterNotStartsWith      LogpushFilterOperator = "!startsWith"
+	LogpushFilterNotEndsWith        LogpushFilterOperator = "!endsWith"
+	LogpushFilterContains           LogpushFilterOperator = "contains"
+	LogpushFilterNotContains        LogpushFilterOperator = "!contains"
+	LogpushFilterValueIsIn          LogpushFilterOperator = "in"
+	LogpushFilterValueIsNotIn       LogpushFilterOperator = "!in"
+)
+
+func (o LogpushFilterOperator) valid() bool {
+	switch o {
+	case LogpushFilterEqual, LogpushFilterNotEqual,
+		LogpushFilterLessThan, LogpushFilterLessThanOrEqual,
+		LogpushFilterGreaterThan, LogpushFilterGreaterThanOrEqual,
+		LogpushFilterStartsWith, LogpushFilterEndsWith,
+		LogpushFilterNotStartsWith, LogpushFilterNotEndsWith,
+		LogpushFilterContains, LogpushFilterNotContains,
+		LogpushFilterValueIsIn, LogpushFilterValueIsNotIn:
+		return true
+	}
+	return false
+}
+
+// LogpushJobFilters is the filter of a Logpush job. The API expects it as a
+// JSON encoded string, which is handled when marshalling and unmarshalling.
+type LogpushJobFilters struct {
+	Where LogpushJobFilter `json:"where"`
+}
+
+// LogpushJobFilter is either a single condition comparing Key to Value, or
+// a group of filters combined with And or Or.
+type LogpushJobFilter struct {
+	And      []LogpushJobFilter    `json:"and,omitempty"`
+	Or       []LogpushJobFilter    `json:"or,omitempty"`
+	Key      string                `json:"key,omitempty"`
+	Operator LogpushFilterOperator `json:"operator,omitempty"`
+	Value    interface{}           `json:"value,omitempty"`
+}
+
+// NewLogpushJobFilters returns the filters matching logs for which where
+// holds.
+func NewLogpushJobFilters(where LogpushJobFilter) *LogpushJobFilters {
+	return &LogpushJobFilters{Where: where}
+}
+
+// LogpushFilterCondition returns a filter comparing the log field key to
+// value using operator.
+func LogpushFilterCondition(key string, operator LogpushFilterOperator, value interface{}) LogpushJobFilter {
+	return LogpushJobFilter{Key: key, Operator: operator, Value: value}
+}
+
+// LogpushFilterAnd returns a filter matching logs that match all filters.
+func LogpushFilterAnd(filters ...LogpushJobFilter) LogpushJobFilter {
+	return LogpushJobFilter{And: append([]LogpushJobFilter{}, filters...)}
+}
+
+// LogpushFilterOr returns a filter matching logs that match any of filters.
+func LogpushFilterOr(filters ...LogpushJobFilter) LogpushJobFilter {
+	return LogpushJobFilter{Or: append([]LogpushJobFilter{}, filters...)}
+}
+
+// LogpushFilterEdgeStatusAtLeast returns a filter matching HTTP requests
+// answered with a status code of at least status, e.g. 500 for server
+// errors.
+func LogpushFilterEdgeStatusAtLeast(status int) LogpushJobFilter {
+	return LogpushFilterCondition("EdgeResponseStatus", LogpushFilterGreaterThanOrEqual, status)
+}
+
+// LogpushFilterPathPrefix returns a filter matching HTTP requests whose path
+// starts with prefix.
+func LogpushFilterPathPrefix(prefix string) LogpushJobFilter {
+	return LogpushFilterCondition("ClientRequestPath", LogpushFilterStartsWith, prefix)
+}
+
+// Validate checks that f is well formed.
+func (f LogpushJobFilters) Validate() error {
+	return f.Where.Validate()
+}
+
+// Validate checks that f is either a condition with a key, a supported
+// operator and a value, or a non-empty group of valid filters.
+func (f LogpushJobFilter) Validate() error {
+	isCondition := f.Key != "" || f.Operator != "" || f.Value != nil
+	groups := 0
+	if f.And != nil {
+		groups++
+	}
+	if f.Or != nil {
+		groups++
+	}
+
+	switch {
+	case groups > 1 || (groups == 1 && isCondition):
+		return errors.New("filter must be exactly one of and, or, or a condition")
+	case groups == 1:
+		filters := f.And
+		if f.Or != nil {
+			filters = f.Or
+		}
+		if len(filters) == 0 {
+			return errors.New("filter group cannot be empty")
+		}
+		for _, filter := range filters {
+			if err := filter.Validate(); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if f.Key == "" {
+		return errors.New("filter key cannot be empty")
+	}
+	if !f.Operator.valid() {
+		return errors.Errorf("filter on %s has unsupported operator %q", f.Key, f.Operator)
+	}
+	if f.Value == nil {
+		return errors.Errorf("filter on %s has no value", f.Key)
+	}
+
+	isList := reflect.ValueOf(f.Value).Kind() == reflect.Slice
+	if (f.Operator == LogpushFilterValueIsIn || f.Operator == LogpushFilterValueIsNotIn) != isList {
+		if isList {
+			return errors.Errorf("filter on %s must use %q or %q with a list value", f.Key, LogpushFilterValueIsIn, LogpushFilterValueIsNotIn)
+		}
+		return errors.Errorf("filter on %s must use a list value with %q", f.Key, f.Operator)
+	}
+	return nil
+}
+
+// MarshalJSON encodes the filters as a JSON string.
+func (f LogpushJobFilters) MarshalJSON() ([]byte, error) {
+	type filters LogpushJobFilters
+	b, err := json.Marshal(filters(f))
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(b))
+}
+
+// UnmarshalJSON decodes filters from a JSON string. An empty string leaves
+// the filters empty.
+func (f *LogpushJobFilters) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		return nil
+	}
+
+	type filters LogpushJobFilters
+	return json.Unmarshal([]byte(s), (*filters)(f))
+}
@@ -0,0 +1,155 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLogpushJobFiltersJSON(t *testing.T) {
+	filters := NewLogpushJobFilters(LogpushFilterAnd(
+		LogpushFilterEdgeStatusAtLeast(500),
+		LogpushFilterPathPrefix("/api/"),
+	))
+
+	b, err := json.Marshal(LogpushJob{Dataset: LogpushDatasetHTTPRequests, Filter: filters})
+	if assert.NoError(t, err) {
+		var job map[string]interface{}
+		if assert.NoError(t, json.Unmarshal(b, &job)) {
+			assert.JSONEq(t, `{"where":{"and":[
+				{"key":"EdgeResponseStatus","operator":"geq","value":500},
+				{"key":"ClientRequestPath","operator":"startsWith","value":"/api/"}
+			]}}`, job["filter"].(string))
+		}
+	}
+
+	var job LogpushJob
+	if assert.NoError(t, json.Unmarshal(b, &job)) {
+		assert.Equal(t, &LogpushJobFilters{Where: LogpushJobFilter{And: []LogpushJobFilter{
+			{Key: "EdgeResponseStatus", Operator: LogpushFilterGreaterThanOrEqual, Value: float64(500)},
+			{Key: "ClientRequestPath", Operator: LogpushFilterStartsWith, Value: "/api/"},
+		}}}, job.Filter)
+	}
+
+	job = LogpushJob{}
+	if assert.NoError(t, json.Unmarshal([]byte(`{"filter":""}`), &job)) {
+		assert.Equal(t, &LogpushJobFilters{}, job.Filter)
+	}
+}
+
+func TestLogpushJobFilterValidate(t *testing.T) {
+	testCases := map[string]struct {
+		filter LogpushJobFilter
+		err    string
+	}{
+		"condition": {
+			filter: LogpushFilterEdgeStatusAtLeast(500),
+		},
+		"nested groups": {
+			filter: LogpushFilterOr(
+				LogpushFilterPathPrefix("/api/"),
+				LogpushFilterAnd(
+					LogpushFilterCondition("ClientRequestHost", LogpushFilterValueIsIn, []string{"example.com", "example.org"}),
+					LogpushFilterCondition("ClientRequestMethod", LogpushFilterNotEqual, "GET"),
+				),
+			),
+		},
+		"empty": {
+			err: "filter key cannot be empty",
+		},
+		"empty group": {
+			filter: LogpushFilterAnd(),
+			err:    "filter group cannot be empty",
+		},
+		"group and condition": {
+			filter: LogpushJobFilter{And: []LogpushJobFilter{LogpushFilterPathPrefix("/")}, Key: "ClientIP"},
+			err:    "filter must be exactly one of and, or, or a condition",
+		},
+		"unsupported operator": {
+			filter: LogpushFilterCondition("ClientIP", "like", "192.0.2.%"),
+			err:    `filter on ClientIP has unsupported operator "like"`,
+		},
+		"missing value": {
+			filter: LogpushFilterCondition("ClientIP", LogpushFilterEqual, nil),
+			err:    "filter on ClientIP has no value",
+		},
+		"in without list": {
+			filter: LogpushFilterCondition("ClientIP", LogpushFilterValueIsIn, "192.0.2.1"),
+			err:    `filter on ClientIP must use a list value with "in"`,
+		},
+		"list without in": {
+			filter: LogpushFilterCondition("ClientIP", LogpushFilterEqual, []string{"192.0.2.1"}),
+			err:    `filter on ClientIP must use "in" or "!in" with a list value`,
+		},
+		"invalid nested": {
+			filter: LogpushFilterAnd(LogpushFilterPathPrefix("/"), LogpushFilterCondition("", LogpushFilterEqual, "x")),
+			err:    "filter key cannot be empty",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.filter.Validate()
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestCreateLogpushJobWithFilter(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.Contains(t, string(body), `"filter":"{\"where\":{\"key\":\"EdgeResponseStatus\",\"operator\":\"geq\",\"value\":500}}"`)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"id": 1,
+				"dataset": "http_requests",
+				"enabled": true,
+				"name": "errors",
+				"destination_conf": "s3://mybucket/logs?region=us-west-2",
+				"filter": "{\"where\":{\"key\":\"EdgeResponseStatus\",\"operator\":\"geq\",\"value\":500}}"
+			},
+			"success": true,
+			"errors": null,
+			"messages": null
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/logpush/jobs", handler)
+
+	job := LogpushJob{
+		Dataset:         LogpushDatasetHTTPRequests,
+		Enabled:         true,
+		Name:            "errors",
+		DestinationConf: "s3://mybucket/logs?region=us-west-2",
+		Filter:          NewLogpushJobFilters(LogpushFilterEdgeStatusAtLeast(500)),
+	}
+
+	actual, err := client.CreateLogpushJob(context.Background(), testZoneID, job)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &LogpushJobFilters{Where: LogpushJobFilter{
+			Key:      "EdgeResponseStatus",
+			Operator: LogpushFilterGreaterThanOrEqual,
+			Value:    float64(500),
+		}}, actual.Filter)
+	}
+
+	job.Filter = NewLogpushJobFilters(LogpushFilterCondition("EdgeResponseStatus", "above", 500))
+	_, err = client.CreateLogpushJob(context.Background(), testZoneID, job)
+	assert.EqualError(t, err, `filter on EdgeResponseStatus has unsupported operator "above"`)
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strconv"
 	"testing"
@@ -215,6 +216,137 @@ func TestDeleteLogpushJob(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCreateAccountLogpushJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	cve := true
+	newJob := LogpushJob{
+		Dataset:         LogpushDatasetAuditLogs,
+		Enabled:         true,
+		Name:            "audit",
+		DestinationConf: "s3://mybucket/logs?region=us-west-2",
+		OutputOptions: &LogpushOutputOptions{
+			FieldNames:      []string{"ActorEmail", "When"},
+			OutputType:      "ndjson",
+			TimestampFormat: "rfc3339",
+			SampleRate:      0.5,
+			CVE202144228:    &cve,
+		},
+		Frequency:                "low",
+		MaxUploadBytes:           5000000,
+		MaxUploadRecords:         1000,
+		MaxUploadIntervalSeconds: 30,
+	}
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"dataset": "audit_logs",
+				"enabled": true,
+				"name": "audit",
+				"destination_conf": "s3://mybucket/logs?region=us-west-2",
+				"output_options": {
+					"field_names": ["ActorEmail", "When"],
+					"output_type": "ndjson",
+					"timestamp_format": "rfc3339",
+					"sample_rate": 0.5,
+					"CVE-2021-44228": true
+				},
+				"frequency": "low",
+				"max_upload_bytes": 5000000,
+				"max_upload_records": 1000,
+				"max_upload_interval_seconds": 30
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+		  "result": {
+			"id": %d,
+			"dataset": "audit_logs",
+			"enabled": true,
+			"name": "audit",
+			"destination_conf": "s3://mybucket/logs?region=us-west-2",
+			"output_options": {
+				"field_names": ["ActorEmail", "When"],
+				"output_type": "ndjson",
+				"timestamp_format": "rfc3339",
+				"sample_rate": 0.5,
+				"CVE-2021-44228": true
+			},
+			"frequency": "low",
+			"max_upload_bytes": 5000000,
+			"max_upload_records": 1000,
+			"max_upload_interval_seconds": 30
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`, jobID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/jobs", handler)
+	want := newJob
+	want.ID = jobID
+
+	actual, err := client.CreateAccountLogpushJob(context.Background(), testAccountID, newJob)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &want, actual)
+	}
+}
+
+func TestAccountLogpushJobsForDataset(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+		  "result": [
+			%s
+		  ],
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`, fmt.Sprintf(serverLogpushJobDescription, jobID, testLogpushTimestamp.Format(time.RFC3339Nano)))
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/datasets/http_requests/jobs", handler)
+	want := []LogpushJob{expectedLogpushJobStruct}
+
+	actual, err := client.AccountLogpushJobsForDataset(context.Background(), testAccountID, LogpushDatasetHTTPRequests)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteAccountLogpushJob(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": null,
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/jobs/"+strconv.Itoa(jobID), handler)
+
+	err := client.DeleteAccountLogpushJob(context.Background(), testAccountID, jobID)
+	assert.NoError(t, err)
+}
+
 func TestGetLogpushOwnershipChallenge(t *testing.T) {
 	setup()
 	defer teardown()
@@ -344,3 +476,93 @@ func TestCheckLogpushDestinationExists(t *testing.T) {
 		})
 	}
 }
+
+func TestAccountLogpushOwnershipFlow(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/ownership", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"destination_conf":"s3://mybucket/logs?region=us-west-2"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+		  "result": %s,
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`, serverLogpushGetOwnershipChallengeDescription)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/ownership/validate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"destination_conf":"s3://mybucket/logs?region=us-west-2","ownership_challenge":"challenge"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"valid": true
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/validate/destination/exists", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"exists": false
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/logpush/datasets/audit_logs/fields", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+		  "result": {
+			"ActorEmail": "string; email of the actor",
+			"When": "int or string; when the change happened"
+		  },
+		  "success": true,
+		  "errors": null,
+		  "messages": null
+		}
+		`)
+	})
+
+	destinationConf := "s3://mybucket/logs?region=us-west-2"
+
+	exists, err := client.CheckAccountLogpushDestinationExists(context.Background(), testAccountID, destinationConf)
+	if assert.NoError(t, err) {
+		assert.False(t, exists)
+	}
+
+	challenge, err := client.GetAccountLogpushOwnershipChallenge(context.Background(), testAccountID, destinationConf)
+	if assert.NoError(t, err) {
+		assert.Equal(t, &expectedLogpushGetOwnershipChallengeStruct, challenge)
+	}
+
+	valid, err := client.ValidateAccountLogpushOwnershipChallenge(context.Background(), testAccountID, destinationConf, "challenge")
+	if assert.NoError(t, err) {
+		assert.True(t, valid)
+	}
+
+	fields, err := client.AccountLogpushFields(context.Background(), testAccountID, LogpushDatasetAuditLogs)
+	if assert.NoError(t, err) {
+		assert.Equal(t, LogpushFields{
+			"ActorEmail": "string; email of the actor",
+			"When":       "int or string; when the change happened",
+		}, fields)
+	}
+}
@@ -22,6 +22,9 @@ const (
 
 	// MagicFirewallRulesetRuleActionBlock specifies a block action
 	MagicFirewallRulesetRuleActionBlock MagicFirewallRulesetRuleAction = "block"
+
+	// MagicFirewallRulesetSkipCurrent skips the remaining rules of the current ruleset
+	MagicFirewallRulesetSkipCurrent = "current"
 )
 
 // MagicFirewallRulesetRuleAction specifies the action for a Firewall rule
@@ -96,6 +99,29 @@ type UpdateMagicFirewallRulesetResponse struct {
 	Result MagicFirewallRuleset `json:"result"`
 }
 
+// NewMagicFirewallAllowRule returns an enabled rule allowing packets that
+// match expression by skipping the remaining rules of the ruleset
+func NewMagicFirewallAllowRule(description, expression string) MagicFirewallRulesetRule {
+	return MagicFirewallRulesetRule{
+		Action:           MagicFirewallRulesetRuleActionSkip,
+		ActionParameters: &MagicFirewallRulesetRuleActionParameters{Ruleset: MagicFirewallRulesetSkipCurrent},
+		Expression:       expression,
+		Description:      description,
+		Enabled:          true,
+	}
+}
+
+// NewMagicFirewallBlockRule returns an enabled rule blocking packets that
+// match expression
+func NewMagicFirewallBlockRule(description, expression string) MagicFirewallRulesetRule {
+	return MagicFirewallRulesetRule{
+		Action:      MagicFirewallRulesetRuleActionBlock,
+		Expression:  expression,
+		Description: description,
+		Enabled:     true,
+	}
+}
+
 // ListMagicFirewallRulesets lists all Rulesets for a given account
 //
 // API reference: https://api.cloudflare.com/#rulesets-list-rulesets
@@ -215,6 +241,53 @@ func (api *API) UpdateMagicFirewallRuleset(ctx context.Context, id string, descr
 	return result.Result, nil
 }
 
+// GetMagicFirewallEntrypointRuleset returns the entrypoint ruleset of the
+// magic_transit phase, which holds the Magic Firewall rules of the account
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-get-an-account-entry-point-ruleset
+func (api *API) GetMagicFirewallEntrypointRuleset(ctx context.Context) (MagicFirewallRuleset, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicFirewallRuleset{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/rulesets/phases/%s/entrypoint", api.AccountID, MagicFirewallRulesetPhaseMagicTransit)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicFirewallRuleset{}, err
+	}
+
+	result := GetMagicFirewallRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicFirewallRuleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// UpdateMagicFirewallEntrypointRuleset replaces the rules of the
+// magic_transit phase entrypoint ruleset, creating it if needed
+//
+// API reference: https://api.cloudflare.com/#account-rulesets-update-an-account-entry-point-ruleset
+func (api *API) UpdateMagicFirewallEntrypointRuleset(ctx context.Context, description string, rules []MagicFirewallRulesetRule) (MagicFirewallRuleset, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicFirewallRuleset{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/rulesets/phases/%s/entrypoint", api.AccountID, MagicFirewallRulesetPhaseMagicTransit)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri,
+		UpdateMagicFirewallRulesetRequest{Description: description, Rules: rules})
+	if err != nil {
+		return MagicFirewallRuleset{}, err
+	}
+
+	result := UpdateMagicFirewallRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicFirewallRuleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
 func (api *API) checkAccountID() error {
 	if api.AccountID == "" {
 		return fmt.Errorf("account ID must not be empty")
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -316,3 +317,86 @@ func TestDeleteMagicFirewallRuleset(t *testing.T) {
 	err := client.DeleteMagicFirewallRuleset(context.Background(), "2c0fc9fa937b11eaa1b71c4d701ab86e")
 	assert.NoError(t, err)
 }
+
+func TestUpdateMagicFirewallEntrypointRuleset(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "Magic Firewall",
+				"rules": [
+					{
+						"action": "skip",
+						"action_parameters": {"ruleset": "current"},
+						"expression": "tcp.dstport in { 443 }",
+						"description": "Allow HTTPS",
+						"enabled": true
+					},
+					{
+						"action": "block",
+						"expression": "ip.src in { 192.0.2.0/24 }",
+						"description": "Block bad network",
+						"enabled": true
+					}
+				]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"result": {
+				"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+				"name": "default",
+				"description": "Magic Firewall",
+				"kind": "root",
+				"version": "2",
+				"phase": "magic_transit",
+				"rules": [
+					{
+						"id": "62449e2e0de149619edb35e59c10d801",
+						"version": "1",
+						"action": "skip",
+						"action_parameters": {"ruleset": "current"},
+						"expression": "tcp.dstport in { 443 }",
+						"description": "Allow HTTPS",
+						"enabled": true
+					},
+					{
+						"id": "62449e2e0de149619edb35e59c10d802",
+						"version": "1",
+						"action": "block",
+						"expression": "ip.src in { 192.0.2.0/24 }",
+						"description": "Block bad network",
+						"enabled": true
+					}
+				]
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/rulesets/phases/magic_transit/entrypoint", handler)
+
+	allow := NewMagicFirewallAllowRule("Allow HTTPS", "tcp.dstport in { 443 }")
+	block := NewMagicFirewallBlockRule("Block bad network", "ip.src in { 192.0.2.0/24 }")
+
+	actual, err := client.UpdateMagicFirewallEntrypointRuleset(context.Background(), "Magic Firewall", []MagicFirewallRulesetRule{allow, block})
+	if assert.NoError(t, err) {
+		allow.ID, allow.Version = "62449e2e0de149619edb35e59c10d801", "1"
+		block.ID, block.Version = "62449e2e0de149619edb35e59c10d802", "1"
+		assert.Equal(t, MagicFirewallRuleset{
+			ID:          "2c0fc9fa937b11eaa1b71c4d701ab86e",
+			Name:        "default",
+			Description: "Magic Firewall",
+			Kind:        MagicFirewallRulesetKindRoot,
+			Version:     "2",
+			Phase:       MagicFirewallRulesetPhaseMagicTransit,
+			Rules:       []MagicFirewallRulesetRule{allow, block},
+		}, actual)
+	}
+}
@@ -0,0 +1,212 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// MagicNetworkMonitoringConfig contains the Magic Network Monitoring configuration of an account
+type MagicNetworkMonitoringConfig struct {
+	Name string `json:"name"`
+	// DefaultSampling is the sampling rate of the flow data sent by the routers, e.g. 1 in 1000 packets.
+	DefaultSampling float64  `json:"default_sampling"`
+	RouterIPs       []string `json:"router_ips"`
+}
+
+// MagicNetworkMonitoringRule contains information about a Magic Network Monitoring rule
+type MagicNetworkMonitoringRule struct {
+	ID       string   `json:"id,omitempty"`
+	Name     string   `json:"name"`
+	Prefixes []string `json:"prefixes"`
+	// AutomaticAdvertisement advertises the prefixes through Magic Transit when the rule is triggered.
+	AutomaticAdvertisement *bool `json:"automatic_advertisement,omitempty"`
+	// Duration is how long the threshold must be exceeded to trigger the rule, e.g. "1m".
+	Duration           string   `json:"duration,omitempty"`
+	BandwidthThreshold *float64 `json:"bandwidth_threshold,omitempty"`
+	PacketThreshold    *float64 `json:"packet_threshold,omitempty"`
+}
+
+// MagicNetworkMonitoringConfigResponse contains a response including the Magic Network Monitoring configuration
+type MagicNetworkMonitoringConfigResponse struct {
+	Response
+	Result MagicNetworkMonitoringConfig `json:"result"`
+}
+
+// MagicNetworkMonitoringRuleResponse contains a response including exactly one Magic Network Monitoring rule
+type MagicNetworkMonitoringRuleResponse struct {
+	Response
+	Result MagicNetworkMonitoringRule `json:"result"`
+}
+
+// MagicNetworkMonitoringRulesResponse contains a response including Magic Network Monitoring rules
+type MagicNetworkMonitoringRulesResponse struct {
+	Response
+	Result []MagicNetworkMonitoringRule `json:"result"`
+}
+
+// GetMagicNetworkMonitoringConfig returns the Magic Network Monitoring configuration of the account
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-configuration-list-account-configuration
+func (api *API) GetMagicNetworkMonitoringConfig(ctx context.Context) (MagicNetworkMonitoringConfig, error) {
+	return api.magicNetworkMonitoringConfigRequest(ctx, http.MethodGet, nil)
+}
+
+// CreateMagicNetworkMonitoringConfig creates the Magic Network Monitoring configuration of the account
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-configuration-create-account-configuration
+func (api *API) CreateMagicNetworkMonitoringConfig(ctx context.Context, config MagicNetworkMonitoringConfig) (MagicNetworkMonitoringConfig, error) {
+	return api.magicNetworkMonitoringConfigRequest(ctx, http.MethodPost, config)
+}
+
+// UpdateMagicNetworkMonitoringConfig replaces the Magic Network Monitoring configuration of the account
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-configuration-update-an-entire-account-configuration
+func (api *API) UpdateMagicNetworkMonitoringConfig(ctx context.Context, config MagicNetworkMonitoringConfig) (MagicNetworkMonitoringConfig, error) {
+	return api.magicNetworkMonitoringConfigRequest(ctx, http.MethodPut, config)
+}
+
+// DeleteMagicNetworkMonitoringConfig deletes the Magic Network Monitoring configuration of the account
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-configuration-delete-account-and-network-configuration
+func (api *API) DeleteMagicNetworkMonitoringConfig(ctx context.Context) (MagicNetworkMonitoringConfig, error) {
+	return api.magicNetworkMonitoringConfigRequest(ctx, http.MethodDelete, nil)
+}
+
+func (api *API) magicNetworkMonitoringConfigRequest(ctx context.Context, method string, params interface{}) (MagicNetworkMonitoringConfig, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicNetworkMonitoringConfig{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/config", api.AccountID)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return MagicNetworkMonitoringConfig{}, err
+	}
+
+	result := MagicNetworkMonitoringConfigResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicNetworkMonitoringConfig{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// ListMagicNetworkMonitoringRules lists all Magic Network Monitoring rules of the account
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-list-rules
+func (api *API) ListMagicNetworkMonitoringRules(ctx context.Context) ([]MagicNetworkMonitoringRule, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicNetworkMonitoringRule{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/rules", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicNetworkMonitoringRule{}, err
+	}
+
+	result := MagicNetworkMonitoringRulesResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicNetworkMonitoringRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// GetMagicNetworkMonitoringRule returns exactly one Magic Network Monitoring rule
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-get-rule
+func (api *API) GetMagicNetworkMonitoringRule(ctx context.Context, id string) (MagicNetworkMonitoringRule, error) {
+	if id == "" {
+		return MagicNetworkMonitoringRule{}, errors.Errorf("rule ID cannot be empty")
+	}
+
+	return api.magicNetworkMonitoringRuleRequest(ctx, http.MethodGet, fmt.Sprintf("rules/%s", id), nil)
+}
+
+// CreateMagicNetworkMonitoringRule creates a Magic Network Monitoring rule
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-create-rules
+func (api *API) CreateMagicNetworkMonitoringRule(ctx context.Context, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if err := rule.validate(); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	return api.magicNetworkMonitoringRuleRequest(ctx, http.MethodPost, "rules", rule)
+}
+
+// UpdateMagicNetworkMonitoringRule replaces a Magic Network Monitoring rule, identified by rule.ID
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-update-rules
+func (api *API) UpdateMagicNetworkMonitoringRule(ctx context.Context, rule MagicNetworkMonitoringRule) (MagicNetworkMonitoringRule, error) {
+	if rule.ID == "" {
+		return MagicNetworkMonitoringRule{}, errors.Errorf("rule ID cannot be empty")
+	}
+	if err := rule.validate(); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	return api.magicNetworkMonitoringRuleRequest(ctx, http.MethodPut, "rules", rule)
+}
+
+// DeleteMagicNetworkMonitoringRule deletes a Magic Network Monitoring rule
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-delete-rule
+func (api *API) DeleteMagicNetworkMonitoringRule(ctx context.Context, id string) (MagicNetworkMonitoringRule, error) {
+	if id == "" {
+		return MagicNetworkMonitoringRule{}, errors.Errorf("rule ID cannot be empty")
+	}
+
+	return api.magicNetworkMonitoringRuleRequest(ctx, http.MethodDelete, fmt.Sprintf("rules/%s", id), nil)
+}
+
+// UpdateMagicNetworkMonitoringRuleAdvertisement turns the automatic advertisement of a rule's prefixes on or off
+//
+// API reference: https://api.cloudflare.com/#magic-network-monitoring-rules-update-advertisement-for-rule
+func (api *API) UpdateMagicNetworkMonitoringRuleAdvertisement(ctx context.Context, id string, automaticAdvertisement bool) (MagicNetworkMonitoringRule, error) {
+	if id == "" {
+		return MagicNetworkMonitoringRule{}, errors.Errorf("rule ID cannot be empty")
+	}
+
+	params := struct {
+		AutomaticAdvertisement bool `json:"automatic_advertisement"`
+	}{automaticAdvertisement}
+	return api.magicNetworkMonitoringRuleRequest(ctx, http.MethodPatch, fmt.Sprintf("rules/%s/advertisement", id), params)
+}
+
+func (api *API) magicNetworkMonitoringRuleRequest(ctx context.Context, method, path string, params interface{}) (MagicNetworkMonitoringRule, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/mnm/%s", api.AccountID, path)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return MagicNetworkMonitoringRule{}, err
+	}
+
+	result := MagicNetworkMonitoringRuleResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicNetworkMonitoringRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// validate checks that a rule has a name, prefixes and exactly one threshold.
+func (r MagicNetworkMonitoringRule) validate() error {
+	if r.Name == "" {
+		return errors.Errorf("rule name cannot be empty")
+	}
+	if len(r.Prefixes) == 0 {
+		return errors.Errorf("rule prefixes cannot be empty")
+	}
+	if (r.BandwidthThreshold == nil) == (r.PacketThreshold == nil) {
+		return errors.Errorf("rule must set exactly one of bandwidth threshold or packet threshold")
+	}
+	return nil
+}
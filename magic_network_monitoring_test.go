@@ -0,0 +1,181 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateMagicNetworkMonitoringConfig(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name":"cloudflare user's account","default_sampling":1000,"router_ips":["203.0.113.1"]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "name": "cloudflare user's account",
+        "default_sampling": 1000,
+        "router_ips": ["203.0.113.1"]
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/mnm/config", handler)
+
+	want := MagicNetworkMonitoringConfig{
+		Name:            "cloudflare user's account",
+		DefaultSampling: 1000,
+		RouterIPs:       []string{"203.0.113.1"},
+	}
+
+	actual, err := client.UpdateMagicNetworkMonitoringConfig(context.Background(), want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListMagicNetworkMonitoringRules(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": [
+        {
+          "id": "2890e6fa406311ed9b5a23f70f6fb8cf",
+          "name": "my_rule_1",
+          "prefixes": ["203.0.113.0/24"],
+          "automatic_advertisement": true,
+          "duration": "1m",
+          "bandwidth_threshold": 1000000000
+        }
+      ]
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/mnm/rules", handler)
+
+	advertise := true
+	threshold := float64(1000000000)
+	want := []MagicNetworkMonitoringRule{{
+		ID:                     "2890e6fa406311ed9b5a23f70f6fb8cf",
+		Name:                   "my_rule_1",
+		Prefixes:               []string{"203.0.113.0/24"},
+		AutomaticAdvertisement: &advertise,
+		Duration:               "1m",
+		BandwidthThreshold:     &threshold,
+	}}
+
+	actual, err := client.ListMagicNetworkMonitoringRules(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateMagicNetworkMonitoringRule(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name":"my_rule_1","prefixes":["203.0.113.0/24"],"duration":"5m","packet_threshold":10000}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "id": "2890e6fa406311ed9b5a23f70f6fb8cf",
+        "name": "my_rule_1",
+        "prefixes": ["203.0.113.0/24"],
+        "automatic_advertisement": false,
+        "duration": "5m",
+        "packet_threshold": 10000
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/mnm/rules", handler)
+
+	threshold := float64(10000)
+	rule := MagicNetworkMonitoringRule{
+		Name:            "my_rule_1",
+		Prefixes:        []string{"203.0.113.0/24"},
+		Duration:        "5m",
+		PacketThreshold: &threshold,
+	}
+
+	actual, err := client.CreateMagicNetworkMonitoringRule(context.Background(), rule)
+	if assert.NoError(t, err) {
+		advertise := false
+		rule.ID = "2890e6fa406311ed9b5a23f70f6fb8cf"
+		rule.AutomaticAdvertisement = &advertise
+		assert.Equal(t, rule, actual)
+	}
+
+	rule.BandwidthThreshold = &threshold
+	_, err = client.CreateMagicNetworkMonitoringRule(context.Background(), rule)
+	assert.EqualError(t, err, "rule must set exactly one of bandwidth threshold or packet threshold")
+
+	_, err = client.CreateMagicNetworkMonitoringRule(context.Background(), MagicNetworkMonitoringRule{Name: "my_rule_2"})
+	assert.EqualError(t, err, "rule prefixes cannot be empty")
+}
+
+func TestUpdateMagicNetworkMonitoringRuleAdvertisement(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"automatic_advertisement":true}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "id": "2890e6fa406311ed9b5a23f70f6fb8cf",
+        "name": "my_rule_1",
+        "prefixes": ["203.0.113.0/24"],
+        "automatic_advertisement": true,
+        "duration": "1m",
+        "bandwidth_threshold": 1000
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/mnm/rules/2890e6fa406311ed9b5a23f70f6fb8cf/advertisement", handler)
+
+	actual, err := client.UpdateMagicNetworkMonitoringRuleAdvertisement(context.Background(), "2890e6fa406311ed9b5a23f70f6fb8cf", true)
+	if assert.NoError(t, err) {
+		advertise := true
+		assert.Equal(t, &advertise, actual.AutomaticAdvertisement)
+	}
+
+	_, err = client.UpdateMagicNetworkMonitoringRuleAdvertisement(context.Background(), "", true)
+	assert.EqualError(t, err, "rule ID cannot be empty")
+}
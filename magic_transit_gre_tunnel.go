@@ -0,0 +1,251 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Magic Transit GRE Tunnel Error messages
+const (
+	errMagicTransitGRETunnelNotModified = "When trying to modify GRE tunnel, API returned modified: false"
+	errMagicTransitGRETunnelNotDeleted  = "When trying to delete GRE tunnel, API returned deleted: false"
+)
+
+// MagicTransitTunnelHealthcheck contains information about a tunnel health check
+type MagicTransitTunnelHealthcheck struct {
+	Enabled bool `json:"enabled"`
+	// Target is the destination address of the health check, defaulting
+	// to the customer side of the tunnel.
+	Target string `json:"target,omitempty"`
+	// Type is either "request" or "reply".
+	Type string `json:"type,omitempty"`
+	// Rate is how often the health check runs: "low", "mid" or "high".
+	Rate string `json:"rate,omitempty"`
+}
+
+// MagicTransitGRETunnel contains information about a GRE tunnel
+type MagicTransitGRETunnel struct {
+	ID                    string                         `json:"id,omitempty"`
+	CreatedOn             *time.Time                     `json:"created_on,omitempty"`
+	ModifiedOn            *time.Time                     `json:"modified_on,omitempty"`
+	Name                  string                         `json:"name"`
+	CustomerGREEndpoint   string                         `json:"customer_gre_endpoint"`
+	CloudflareGREEndpoint string                         `json:"cloudflare_gre_endpoint"`
+	InterfaceAddress      string                         `json:"interface_address"`
+	Description           string                         `json:"description,omitempty"`
+	TTL                   uint8                          `json:"ttl,omitempty"`
+	MTU                   uint16                         `json:"mtu,omitempty"`
+	HealthCheck           *MagicTransitTunnelHealthcheck `json:"health_check,omitempty"`
+}
+
+// ListMagicTransitGRETunnelsResponse contains a response including GRE tunnels
+type ListMagicTransitGRETunnelsResponse struct {
+	Response
+	Result struct {
+		GRETunnels []MagicTransitGRETunnel `json:"gre_tunnels"`
+	} `json:"result"`
+}
+
+// GetMagicTransitGRETunnelResponse contains a response including exactly one GRE tunnel
+type GetMagicTransitGRETunnelResponse struct {
+	Response
+	Result struct {
+		GRETunnel MagicTransitGRETunnel `json:"gre_tunnel"`
+	} `json:"result"`
+}
+
+// UpdateMagicTransitGRETunnelResponse contains a GRE tunnel update response
+type UpdateMagicTransitGRETunnelResponse struct {
+	Response
+	Result struct {
+		Modified          bool                  `json:"modified"`
+		ModifiedGRETunnel MagicTransitGRETunnel `json:"modified_gre_tunnel"`
+	} `json:"result"`
+}
+
+// UpdateMagicTransitGRETunnelsResponse contains a bulk GRE tunnel update response
+type UpdateMagicTransitGRETunnelsResponse struct {
+	Response
+	Result struct {
+		Modified           bool                    `json:"modified"`
+		ModifiedGRETunnels []MagicTransitGRETunnel `json:"modified_gre_tunnels"`
+	} `json:"result"`
+}
+
+// DeleteMagicTransitGRETunnelResponse contains a GRE tunnel deletion response
+type DeleteMagicTransitGRETunnelResponse struct {
+	Response
+	Result struct {
+		Deleted          bool                  `json:"deleted"`
+		DeletedGRETunnel MagicTransitGRETunnel `json:"deleted_gre_tunnel"`
+	} `json:"result"`
+}
+
+// MagicTransitGRETunnelsRequest is an array of GRE tunnels to create or update
+type MagicTransitGRETunnelsRequest struct {
+	GRETunnels []MagicTransitGRETunnel `json:"gre_tunnels"`
+}
+
+// ListMagicTransitGRETunnels lists all GRE tunnels for a given account
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-list-gre-tunnels
+func (api *API) ListMagicTransitGRETunnels(ctx context.Context) ([]MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	result := ListMagicTransitGRETunnelsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result.GRETunnels, nil
+}
+
+// GetMagicTransitGRETunnel returns exactly one GRE tunnel
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-gre-tunnel-details
+func (api *API) GetMagicTransitGRETunnel(ctx context.Context, id string) (MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels/%s", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	result := GetMagicTransitGRETunnelResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result.GRETunnel, nil
+}
+
+// CreateMagicTransitGRETunnels creates one or more GRE tunnels
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-create-gre-tunnels
+func (api *API) CreateMagicTransitGRETunnels(ctx context.Context, tunnels []MagicTransitGRETunnel) ([]MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, MagicTransitGRETunnelsRequest{
+		GRETunnels: tunnels,
+	})
+
+	if err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	result := ListMagicTransitGRETunnelsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result.GRETunnels, nil
+}
+
+// UpdateMagicTransitGRETunnel updates a GRE tunnel
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-update-gre-tunnel
+func (api *API) UpdateMagicTransitGRETunnel(ctx context.Context, id string, tunnel MagicTransitGRETunnel) (MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels/%s", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, tunnel)
+
+	if err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	result := UpdateMagicTransitGRETunnelResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	if !result.Result.Modified {
+		return MagicTransitGRETunnel{}, errors.New(errMagicTransitGRETunnelNotModified)
+	}
+
+	return result.Result.ModifiedGRETunnel, nil
+}
+
+// UpdateMagicTransitGRETunnels updates several GRE tunnels at once. Each
+// tunnel must have its ID set.
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-update-multiple-gre-tunnels
+func (api *API) UpdateMagicTransitGRETunnels(ctx context.Context, tunnels []MagicTransitGRETunnel) ([]MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	for _, tunnel := range tunnels {
+		if tunnel.ID == "" {
+			return []MagicTransitGRETunnel{}, errors.Errorf("GRE tunnel ID cannot be empty")
+		}
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, MagicTransitGRETunnelsRequest{
+		GRETunnels: tunnels,
+	})
+
+	if err != nil {
+		return []MagicTransitGRETunnel{}, err
+	}
+
+	result := UpdateMagicTransitGRETunnelsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	if !result.Result.Modified {
+		return []MagicTransitGRETunnel{}, errors.New(errMagicTransitGRETunnelNotModified)
+	}
+
+	return result.Result.ModifiedGRETunnels, nil
+}
+
+// DeleteMagicTransitGRETunnel deletes a GRE tunnel
+//
+// API reference: https://api.cloudflare.com/#magic-gre-tunnels-delete-gre-tunnel
+func (api *API) DeleteMagicTransitGRETunnel(ctx context.Context, id string) (MagicTransitGRETunnel, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/gre_tunnels/%s", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+
+	if err != nil {
+		return MagicTransitGRETunnel{}, err
+	}
+
+	result := DeleteMagicTransitGRETunnelResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitGRETunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	if !result.Result.Deleted {
+		return MagicTransitGRETunnel{}, errors.New(errMagicTransitGRETunnelNotDeleted)
+	}
+
+	return result.Result.DeletedGRETunnel, nil
+}
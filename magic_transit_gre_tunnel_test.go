@@ -0,0 +1,223 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMagicTransitGRETunnelJSON = `{
+  "id": "c4a7362d577a6c3019a474fd6f485821",
+  "created_on": "2017-06-14T00:00:00Z",
+  "modified_on": "2017-06-14T05:20:00Z",
+  "name": "GRE_1",
+  "customer_gre_endpoint": "203.0.113.1",
+  "cloudflare_gre_endpoint": "203.0.113.2",
+  "interface_address": "192.0.2.0/31",
+  "description": "Tunnel for ISP X",
+  "ttl": 64,
+  "mtu": 1476,
+  "health_check": {
+    "enabled": true,
+    "target": "203.0.113.1",
+    "type": "request",
+    "rate": "mid"
+  }
+}`
+
+func testMagicTransitGRETunnel() MagicTransitGRETunnel {
+	createdOn, _ := time.Parse(time.RFC3339, "2017-06-14T00:00:00Z")
+	modifiedOn, _ := time.Parse(time.RFC3339, "2017-06-14T05:20:00Z")
+
+	return MagicTransitGRETunnel{
+		ID:                    "c4a7362d577a6c3019a474fd6f485821",
+		CreatedOn:             &createdOn,
+		ModifiedOn:            &modifiedOn,
+		Name:                  "GRE_1",
+		CustomerGREEndpoint:   "203.0.113.1",
+		CloudflareGREEndpoint: "203.0.113.2",
+		InterfaceAddress:      "192.0.2.0/31",
+		Description:           "Tunnel for ISP X",
+		TTL:                   64,
+		MTU:                   1476,
+		HealthCheck: &MagicTransitTunnelHealthcheck{
+			Enabled: true,
+			Target:  "203.0.113.1",
+			Type:    "request",
+			Rate:    "mid",
+		},
+	}
+}
+
+func TestListMagicTransitGRETunnels(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "gre_tunnels": [%s]
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels", handler)
+
+	want := []MagicTransitGRETunnel{testMagicTransitGRETunnel()}
+
+	actual, err := client.ListMagicTransitGRETunnels(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestGetMagicTransitGRETunnel(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "gre_tunnel": %s
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels/c4a7362d577a6c3019a474fd6f485821", handler)
+
+	actual, err := client.GetMagicTransitGRETunnel(context.Background(), "c4a7362d577a6c3019a474fd6f485821")
+	if assert.NoError(t, err) {
+		assert.Equal(t, testMagicTransitGRETunnel(), actual)
+	}
+}
+
+func TestCreateMagicTransitGRETunnels(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "gre_tunnels": [%s]
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels", handler)
+
+	want := testMagicTransitGRETunnel()
+
+	actual, err := client.CreateMagicTransitGRETunnels(context.Background(), []MagicTransitGRETunnel{want})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []MagicTransitGRETunnel{want}, actual)
+	}
+}
+
+func TestUpdateMagicTransitGRETunnel(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "modified": true,
+        "modified_gre_tunnel": %s
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels/c4a7362d577a6c3019a474fd6f485821", handler)
+
+	want := testMagicTransitGRETunnel()
+
+	actual, err := client.UpdateMagicTransitGRETunnel(context.Background(), "c4a7362d577a6c3019a474fd6f485821", want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateMagicTransitGRETunnels(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.Contains(t, string(body), `"gre_tunnels":[{"id":"c4a7362d577a6c3019a474fd6f485821"`)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "modified": true,
+        "modified_gre_tunnels": [%s]
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels", handler)
+
+	want := testMagicTransitGRETunnel()
+
+	actual, err := client.UpdateMagicTransitGRETunnels(context.Background(), []MagicTransitGRETunnel{want})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []MagicTransitGRETunnel{want}, actual)
+	}
+
+	_, err = client.UpdateMagicTransitGRETunnels(context.Background(), []MagicTransitGRETunnel{{Name: "GRE_2"}})
+	assert.EqualError(t, err, "GRE tunnel ID cannot be empty")
+}
+
+func TestDeleteMagicTransitGRETunnel(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "deleted": true,
+        "deleted_gre_tunnel": %s
+      }
+    }`, testMagicTransitGRETunnelJSON)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/gre_tunnels/c4a7362d577a6c3019a474fd6f485821", handler)
+
+	actual, err := client.DeleteMagicTransitGRETunnel(context.Background(), "c4a7362d577a6c3019a474fd6f485821")
+	if assert.NoError(t, err) {
+		assert.Equal(t, testMagicTransitGRETunnel(), actual)
+	}
+}
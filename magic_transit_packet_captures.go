@@ -0,0 +1,339 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Packet capture types and systems
+const (
+	// MagicTransitPacketCaptureTypeSimple stores a sample of packets in Cloudflare
+	MagicTransitPacketCaptureTypeSimple = "simple"
+
+	// MagicTransitPacketCaptureTypeFull stores all packets in a customer bucket
+	MagicTransitPacketCaptureTypeFull = "full"
+
+	// MagicTransitPacketCaptureSystemMagicTransit captures Magic Transit traffic
+	MagicTransitPacketCaptureSystemMagicTransit = "magic-transit"
+)
+
+// MagicTransitPacketCaptureFilter restricts the packets captured
+type MagicTransitPacketCaptureFilter struct {
+	SourceAddress      string `json:"source_address,omitempty"`
+	SourcePort         int    `json:"source_port,omitempty"`
+	DestinationAddress string `json:"destination_address,omitempty"`
+	DestinationPort    int    `json:"destination_port,omitempty"`
+	Protocol           int    `json:"protocol,omitempty"`
+}
+
+// MagicTransitPacketCapture contains information about a packet capture
+type MagicTransitPacketCapture struct {
+	ID        string     `json:"id,omitempty"`
+	Type      string     `json:"type"`
+	System    string     `json:"system"`
+	Status    string     `json:"status,omitempty"`
+	Submitted *time.Time `json:"submitted,omitempty"`
+	// TimeLimit is the maximum duration of the capture in seconds.
+	TimeLimit   int `json:"time_limit"`
+	PacketLimit int `json:"packet_limit,omitempty"`
+	// ByteLimit and DestinationConf are only used by full captures, which
+	// are stored in a bucket whose ownership has been validated.
+	ByteLimit       int                              `json:"byte_limit,omitempty"`
+	ColoName        string                           `json:"colo_name,omitempty"`
+	DestinationConf string                           `json:"destination_conf,omitempty"`
+	FilterV1        *MagicTransitPacketCaptureFilter `json:"filter_v1,omitempty"`
+}
+
+// MagicTransitPacketCaptureOwnership contains information about a bucket used to store full packet captures
+type MagicTransitPacketCaptureOwnership struct {
+	ID              string     `json:"id"`
+	DestinationConf string     `json:"destination_conf"`
+	Filename        string     `json:"filename"`
+	Status          string     `json:"status"`
+	Submitted       *time.Time `json:"submitted,omitempty"`
+	Validated       *time.Time `json:"validated,omitempty"`
+}
+
+// ListMagicTransitPacketCapturesResponse contains a response including packet captures
+type ListMagicTransitPacketCapturesResponse struct {
+	Response
+	Result []MagicTransitPacketCapture `json:"result"`
+}
+
+// MagicTransitPacketCaptureResponse contains a response including exactly one packet capture
+type MagicTransitPacketCaptureResponse struct {
+	Response
+	Result MagicTransitPacketCapture `json:"result"`
+}
+
+// ListMagicTransitPacketCaptureOwnershipsResponse contains a response including packet capture buckets
+type ListMagicTransitPacketCaptureOwnershipsResponse struct {
+	Response
+	Result []MagicTransitPacketCaptureOwnership `json:"result"`
+}
+
+// MagicTransitPacketCaptureOwnershipResponse contains a response including exactly one packet capture bucket
+type MagicTransitPacketCaptureOwnershipResponse struct {
+	Response
+	Result MagicTransitPacketCaptureOwnership `json:"result"`
+}
+
+// ListMagicTransitPacketCaptures lists all packet captures for a given account
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-list-packet-capture-requests
+func (api *API) ListMagicTransitPacketCaptures(ctx context.Context) ([]MagicTransitPacketCapture, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicTransitPacketCapture{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicTransitPacketCapture{}, err
+	}
+
+	result := ListMagicTransitPacketCapturesResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitPacketCapture{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// GetMagicTransitPacketCapture returns exactly one packet capture
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-get-pcap-request
+func (api *API) GetMagicTransitPacketCapture(ctx context.Context, id string) (MagicTransitPacketCapture, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitPacketCapture{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/%s", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return MagicTransitPacketCapture{}, err
+	}
+
+	result := MagicTransitPacketCaptureResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitPacketCapture{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// CreateMagicTransitPacketCapture starts a new packet capture
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-create-pcap-request
+func (api *API) CreateMagicTransitPacketCapture(ctx context.Context, pcap MagicTransitPacketCapture) (MagicTransitPacketCapture, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitPacketCapture{}, err
+	}
+
+	if pcap.Type == MagicTransitPacketCaptureTypeFull && pcap.DestinationConf == "" {
+		return MagicTransitPacketCapture{}, errors.Errorf("destination conf cannot be empty for full packet captures")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, pcap)
+	if err != nil {
+		return MagicTransitPacketCapture{}, err
+	}
+
+	result := MagicTransitPacketCaptureResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitPacketCapture{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// DownloadMagicTransitPacketCapture returns the pcap file of a finished simple packet capture
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-download-simple-pcap
+func (api *API) DownloadMagicTransitPacketCapture(ctx context.Context, id string) ([]byte, error) {
+	if err := api.checkAccountID(); err != nil {
+		return nil, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/%s/download", api.AccountID, id)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// ListMagicTransitPacketCaptureOwnerships lists the buckets configured for full packet captures
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-list-pcaps-bucket-ownership
+func (api *API) ListMagicTransitPacketCaptureOwnerships(ctx context.Context) ([]MagicTransitPacketCaptureOwnership, error) {
+	if err := api.checkAccountID(); err != nil {
+		return []MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/ownership", api.AccountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	result := ListMagicTransitPacketCaptureOwnershipsResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return []MagicTransitPacketCaptureOwnership{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// AddMagicTransitPacketCaptureOwnership adds a bucket for full packet
+// captures. An ownership challenge file named Filename is written to the
+// bucket, and its content must be passed to
+// ValidateMagicTransitPacketCaptureOwnership.
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-add-buckets-for-full-packet-captures
+func (api *API) AddMagicTransitPacketCaptureOwnership(ctx context.Context, destinationConf string) (MagicTransitPacketCaptureOwnership, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/ownership", api.AccountID)
+	params := struct {
+		DestinationConf string `json:"destination_conf"`
+	}{destinationConf}
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	result := MagicTransitPacketCaptureOwnershipResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitPacketCaptureOwnership{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// ValidateMagicTransitPacketCaptureOwnership validates a bucket for full packet captures using the ownership challenge written to it
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-validate-buckets-for-full-packet-captures
+func (api *API) ValidateMagicTransitPacketCaptureOwnership(ctx context.Context, destinationConf, ownershipChallenge string) (MagicTransitPacketCaptureOwnership, error) {
+	if err := api.checkAccountID(); err != nil {
+		return MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/ownership/validate", api.AccountID)
+	params := struct {
+		DestinationConf    string `json:"destination_conf"`
+		OwnershipChallenge string `json:"ownership_challenge"`
+	}{destinationConf, ownershipChallenge}
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return MagicTransitPacketCaptureOwnership{}, err
+	}
+
+	result := MagicTransitPacketCaptureOwnershipResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return MagicTransitPacketCaptureOwnership{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// DeleteMagicTransitPacketCaptureOwnership removes a bucket for full packet captures
+//
+// API reference: https://api.cloudflare.com/#magic-pcap-collection-delete-buckets-for-full-packet-captures
+func (api *API) DeleteMagicTransitPacketCaptureOwnership(ctx context.Context, id string) error {
+	if err := api.checkAccountID(); err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pcaps/ownership/%s", api.AccountID, id)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Packet capture statuses reported while waiting for a capture
+const (
+	// MagicTransitPacketCaptureStatusSuccess is the status of a finished capture
+	MagicTransitPacketCaptureStatusSuccess = "success"
+
+	// MagicTransitPacketCaptureStatusFailed is the status of a capture that could not complete
+	MagicTransitPacketCaptureStatusFailed = "failed"
+)
+
+// defaultPcapPollInterval is used by WaitForPcap when no interval is given.
+const defaultPcapPollInterval = 5 * time.Second
+
+// WaitForPcap polls a packet capture every pollInterval until it has
+// finished. Simple captures are then downloaded and returned; for full
+// captures, which are written to a bucket, it checks that the bucket's
+// ownership has been validated and returns no data. Polling stops with the
+// context's error when ctx is cancelled.
+func (api *API) WaitForPcap(ctx context.Context, id string, pollInterval time.Duration) (MagicTransitPacketCapture, []byte, error) {
+	if id == "" {
+		return MagicTransitPacketCapture{}, nil, errors.Errorf("packet capture ID cannot be empty")
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultPcapPollInterval
+	}
+
+	for {
+		pcap, err := api.GetMagicTransitPacketCapture(ctx, id)
+		if err != nil {
+			return MagicTransitPacketCapture{}, nil, err
+		}
+
+		switch pcap.Status {
+		case MagicTransitPacketCaptureStatusSuccess:
+			if pcap.Type == MagicTransitPacketCaptureTypeFull {
+				return pcap, nil, api.checkPcapBucket(ctx, pcap.DestinationConf)
+			}
+			data, err := api.DownloadMagicTransitPacketCapture(ctx, id)
+			if err != nil {
+				return pcap, nil, err
+			}
+			return pcap, data, nil
+		case MagicTransitPacketCaptureStatusFailed:
+			return pcap, nil, errors.Errorf("packet capture %s failed", id)
+		}
+
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return pcap, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// checkPcapBucket returns an error unless destinationConf is a bucket whose
+// ownership has been validated, i.e. one full captures are delivered to.
+func (api *API) checkPcapBucket(ctx context.Context, destinationConf string) error {
+	ownerships, err := api.ListMagicTransitPacketCaptureOwnerships(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, o := range ownerships {
+		if o.DestinationConf == destinationConf {
+			if o.Status != MagicTransitPacketCaptureStatusSuccess {
+				return errors.Errorf("packet capture bucket %s has not been validated", destinationConf)
+			}
+			return nil
+		}
+	}
+
+	return errors.Errorf("packet capture bucket %s not found", destinationConf)
+}
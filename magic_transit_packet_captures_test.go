@@ -0,0 +1,270 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateMagicTransitPacketCapture(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"type": "simple",
+				"system": "magic-transit",
+				"time_limit": 300,
+				"packet_limit": 10000,
+				"filter_v1": {"destination_address": "203.0.113.10", "destination_port": 443, "protocol": 6}
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {
+        "id": "66802ca5668e47a2b82c2e6746e45037",
+        "type": "simple",
+        "system": "magic-transit",
+        "status": "pending",
+        "submitted": "2023-06-01T00:00:00Z",
+        "time_limit": 300,
+        "packet_limit": 10000,
+        "filter_v1": {"destination_address": "203.0.113.10", "destination_port": 443, "protocol": 6}
+      }
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/pcaps", handler)
+
+	pcap := MagicTransitPacketCapture{
+		Type:        MagicTransitPacketCaptureTypeSimple,
+		System:      MagicTransitPacketCaptureSystemMagicTransit,
+		TimeLimit:   300,
+		PacketLimit: 10000,
+		FilterV1: &MagicTransitPacketCaptureFilter{
+			DestinationAddress: "203.0.113.10",
+			DestinationPort:    443,
+			Protocol:           6,
+		},
+	}
+
+	actual, err := client.CreateMagicTransitPacketCapture(context.Background(), pcap)
+	if assert.NoError(t, err) {
+		submitted, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+		pcap.ID = "66802ca5668e47a2b82c2e6746e45037"
+		pcap.Status = "pending"
+		pcap.Submitted = &submitted
+		assert.Equal(t, pcap, actual)
+	}
+
+	_, err = client.CreateMagicTransitPacketCapture(context.Background(), MagicTransitPacketCapture{
+		Type:      MagicTransitPacketCaptureTypeFull,
+		System:    MagicTransitPacketCaptureSystemMagicTransit,
+		TimeLimit: 300,
+	})
+	assert.EqualError(t, err, "destination conf cannot be empty for full packet captures")
+}
+
+func TestListMagicTransitPacketCaptures(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": [
+        {
+          "id": "66802ca5668e47a2b82c2e6746e45037",
+          "type": "full",
+          "system": "magic-transit",
+          "status": "success",
+          "time_limit": 300,
+          "byte_limit": 500000,
+          "colo_name": "ord02",
+          "destination_conf": "s3://pcaps-bucket?region=us-east-1"
+        }
+      ]
+    }`)
+	}
+
+	mux.HandleFunc("/accounts/foo/pcaps", handler)
+
+	want := []MagicTransitPacketCapture{{
+		ID:              "66802ca5668e47a2b82c2e6746e45037",
+		Type:            MagicTransitPacketCaptureTypeFull,
+		System:          MagicTransitPacketCaptureSystemMagicTransit,
+		Status:          "success",
+		TimeLimit:       300,
+		ByteLimit:       500000,
+		ColoName:        "ord02",
+		DestinationConf: "s3://pcaps-bucket?region=us-east-1",
+	}}
+
+	actual, err := client.ListMagicTransitPacketCaptures(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDownloadMagicTransitPacketCapture(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/vnd.tcpdump.pcap")
+		fmt.Fprint(w, "\xd4\xc3\xb2\xa1pcap")
+	}
+
+	mux.HandleFunc("/accounts/foo/pcaps/66802ca5668e47a2b82c2e6746e45037/download", handler)
+
+	actual, err := client.DownloadMagicTransitPacketCapture(context.Background(), "66802ca5668e47a2b82c2e6746e45037")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("\xd4\xc3\xb2\xa1pcap"), actual)
+	}
+}
+
+func TestMagicTransitPacketCaptureOwnership(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	ownership := `{
+        "id": "9883874ecac311ec8475433579a6bf5f",
+        "destination_conf": "s3://pcaps-bucket?region=us-east-1",
+        "filename": "ownership-challenge-9883874ecac311ec.txt",
+        "status": "%s"
+      }`
+
+	mux.HandleFunc("/accounts/foo/pcaps/ownership", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"destination_conf":"s3://pcaps-bucket?region=us-east-1"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, fmt.Sprintf(ownership, "pending"))
+	})
+	mux.HandleFunc("/accounts/foo/pcaps/ownership/validate", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"destination_conf":"s3://pcaps-bucket?region=us-east-1","ownership_challenge":"challenge"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, fmt.Sprintf(ownership, "success"))
+	})
+
+	added, err := client.AddMagicTransitPacketCaptureOwnership(context.Background(), "s3://pcaps-bucket?region=us-east-1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, MagicTransitPacketCaptureOwnership{
+			ID:              "9883874ecac311ec8475433579a6bf5f",
+			DestinationConf: "s3://pcaps-bucket?region=us-east-1",
+			Filename:        "ownership-challenge-9883874ecac311ec.txt",
+			Status:          "pending",
+		}, added)
+	}
+
+	validated, err := client.ValidateMagicTransitPacketCaptureOwnership(context.Background(), "s3://pcaps-bucket?region=us-east-1", "challenge")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "success", validated.Status)
+	}
+}
+
+func TestWaitForPcap(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	polls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		polls++
+		status := "running"
+		if polls > 2 {
+			status = "success"
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {"id": "66802ca5668e47a2b82c2e6746e45037", "type": "simple", "system": "magic-transit", "status": "%s", "time_limit": 300}
+    }`, status)
+	}
+
+	mux.HandleFunc("/accounts/foo/pcaps/66802ca5668e47a2b82c2e6746e45037", handler)
+	mux.HandleFunc("/accounts/foo/pcaps/66802ca5668e47a2b82c2e6746e45037/download", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/vnd.tcpdump.pcap")
+		fmt.Fprint(w, "pcap")
+	})
+
+	pcap, data, err := client.WaitForPcap(context.Background(), "66802ca5668e47a2b82c2e6746e45037", time.Millisecond)
+	if assert.NoError(t, err) {
+		assert.Equal(t, 3, polls)
+		assert.Equal(t, "success", pcap.Status)
+		assert.Equal(t, []byte("pcap"), data)
+	}
+}
+
+func TestWaitForPcapFull(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	mux.HandleFunc("/accounts/foo/pcaps/66802ca5668e47a2b82c2e6746e45037", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {"id": "66802ca5668e47a2b82c2e6746e45037", "type": "full", "system": "magic-transit", "status": "success", "time_limit": 300, "destination_conf": "s3://pcaps-bucket?region=us-east-1"}
+    }`)
+	})
+	mux.HandleFunc("/accounts/foo/pcaps/ownership", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": [{"id": "9883874ecac311ec8475433579a6bf5f", "destination_conf": "s3://pcaps-bucket?region=us-east-1", "filename": "ownership-challenge-9883874ecac311ec8475433579a6bf5f.txt", "status": "pending"}]
+    }`)
+	})
+
+	pcap, data, err := client.WaitForPcap(context.Background(), "66802ca5668e47a2b82c2e6746e45037", time.Millisecond)
+	assert.EqualError(t, err, "packet capture bucket s3://pcaps-bucket?region=us-east-1 has not been validated")
+	assert.Equal(t, MagicTransitPacketCaptureTypeFull, pcap.Type)
+	assert.Nil(t, data)
+}
+
+func TestWaitForPcapContextDone(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	mux.HandleFunc("/accounts/foo/pcaps/66802ca5668e47a2b82c2e6746e45037", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+      "success": true,
+      "errors": [],
+      "messages": [],
+      "result": {"id": "66802ca5668e47a2b82c2e6746e45037", "type": "simple", "system": "magic-transit", "status": "pending", "time_limit": 300}
+    }`)
+	})
+
+	_, _, err := client.WaitForPcap(ctx, "66802ca5668e47a2b82c2e6746e45037", time.Hour)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
@@ -0,0 +1,455 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MagicWANSiteLocation contains the coordinates of a Magic WAN site
+type MagicWANSiteLocation struct {
+	Lat string `json:"lat,omitempty"`
+	Lon string `json:"lon,omitempty"`
+}
+
+// MagicWANSite contains information about a Magic WAN site, a branch location connected through a connector
+type MagicWANSite struct {
+	ID                   string                `json:"id,omitempty"`
+	Name                 string                `json:"name"`
+	Description          string                `json:"description,omitempty"`
+	ConnectorID          string                `json:"connector_id,omitempty"`
+	SecondaryConnectorID string                `json:"secondary_connector_id,omitempty"`
+	HAMode               *bool                 `json:"ha_mode,omitempty"`
+	Location             *MagicWANSiteLocation `json:"location,omitempty"`
+}
+
+// MagicWANNat contains the NAT configuration of a LAN or routed subnet
+type MagicWANNat struct {
+	StaticPrefix string `json:"static_prefix,omitempty"`
+}
+
+// MagicWANRoutedSubnet contains information about a subnet routed through a LAN
+type MagicWANRoutedSubnet struct {
+	Prefix  string       `json:"prefix"`
+	NextHop string       `json:"next_hop"`
+	Nat     *MagicWANNat `json:"nat,omitempty"`
+}
+
+// MagicWANDHCPServer contains the DHCP server configuration of a LAN
+type MagicWANDHCPServer struct {
+	DHCPPoolStart string            `json:"dhcp_pool_start,omitempty"`
+	DHCPPoolEnd   string            `json:"dhcp_pool_end,omitempty"`
+	DNSServer     string            `json:"dns_server,omitempty"`
+	Reservations  map[string]string `json:"reservations,omitempty"`
+}
+
+// MagicWANDHCPRelay contains the DHCP relay configuration of a LAN
+type MagicWANDHCPRelay struct {
+	ServerAddresses []string `json:"server_addresses,omitempty"`
+}
+
+// MagicWANLANStaticAddressing contains the static addressing of a LAN
+type MagicWANLANStaticAddressing struct {
+	Address          string              `json:"address"`
+	SecondaryAddress string              `json:"secondary_address,omitempty"`
+	VirtualAddress   string              `json:"virtual_address,omitempty"`
+	DHCPRelay        *MagicWANDHCPRelay  `json:"dhcp_relay,omitempty"`
+	DHCPServer       *MagicWANDHCPServer `json:"dhcp_server,omitempty"`
+}
+
+// MagicWANLAN contains information about a LAN of a Magic WAN site
+type MagicWANLAN struct {
+	ID               string                       `json:"id,omitempty"`
+	SiteID           string                       `json:"site_id,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Physport         int                          `json:"physport"`
+	VlanTag          int                          `json:"vlan_tag,omitempty"`
+	HALink           *bool                        `json:"ha_link,omitempty"`
+	Nat              *MagicWANNat                 `json:"nat,omitempty"`
+	RoutedSubnets    []MagicWANRoutedSubnet       `json:"routed_subnets,omitempty"`
+	StaticAddressing *MagicWANLANStaticAddressing `json:"static_addressing,omitempty"`
+}
+
+// MagicWANWANStaticAddressing contains the static addressing of a WAN. WANs without it use DHCP.
+type MagicWANWANStaticAddressing struct {
+	Address          string `json:"address"`
+	GatewayAddress   string `json:"gateway_address"`
+	SecondaryAddress string `json:"secondary_address,omitempty"`
+}
+
+// MagicWANWAN contains information about a WAN uplink of a Magic WAN site
+type MagicWANWAN struct {
+	ID               string                       `json:"id,omitempty"`
+	SiteID           string                       `json:"site_id,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Physport         int                          `json:"physport"`
+	Priority         int                          `json:"priority,omitempty"`
+	VlanTag          int                          `json:"vlan_tag,omitempty"`
+	StaticAddressing *MagicWANWANStaticAddressing `json:"static_addressing,omitempty"`
+}
+
+// MagicWANConnectorDevice contains information about the hardware or virtual appliance of a connector
+type MagicWANConnectorDevice struct {
+	ID           string `json:"id"`
+	SerialNumber string `json:"serial_number,omitempty"`
+}
+
+// MagicWANConnector contains information about a Magic WAN connector
+type MagicWANConnector struct {
+	ID        string `json:"id,omitempty"`
+	Activated *bool  `json:"activated,omitempty"`
+	// InterruptWindowDurationHours and InterruptWindowHourOfDay define
+	// when the connector may restart to apply updates, in Timezone.
+	// InterruptWindowHourOfDay is a pointer so that midnight (0) can be set.
+	InterruptWindowDurationHours int                      `json:"interrupt_window_duration_hours,omitempty"`
+	InterruptWindowHourOfDay     *int                     `json:"interrupt_window_hour_of_day,omitempty"`
+	Timezone                     string                   `json:"timezone,omitempty"`
+	Notes                        string                   `json:"notes,omitempty"`
+	Device                       *MagicWANConnectorDevice `json:"device,omitempty"`
+	LastUpdated                  *time.Time               `json:"last_updated,omitempty"`
+	LastSeenVersion              string                   `json:"last_seen_version,omitempty"`
+}
+
+// MagicWANSiteResponse contains a response including exactly one Magic WAN site
+type MagicWANSiteResponse struct {
+	Response
+	Result MagicWANSite `json:"result"`
+}
+
+// MagicWANSitesResponse contains a response including Magic WAN sites
+type MagicWANSitesResponse struct {
+	Response
+	Result []MagicWANSite `json:"result"`
+}
+
+// MagicWANLANResponse contains a response including exactly one Magic WAN LAN
+type MagicWANLANResponse struct {
+	Response
+	Result MagicWANLAN `json:"result"`
+}
+
+// MagicWANLANsResponse contains a response including Magic WAN LANs
+type MagicWANLANsResponse struct {
+	Response
+	Result []MagicWANLAN `json:"result"`
+}
+
+// MagicWANWANResponse contains a response including exactly one Magic WAN WAN
+type MagicWANWANResponse struct {
+	Response
+	Result MagicWANWAN `json:"result"`
+}
+
+// MagicWANWANsResponse contains a response including Magic WAN WANs
+type MagicWANWANsResponse struct {
+	Response
+	Result []MagicWANWAN `json:"result"`
+}
+
+// MagicWANConnectorResponse contains a response including exactly one Magic WAN connector
+type MagicWANConnectorResponse struct {
+	Response
+	Result MagicWANConnector `json:"result"`
+}
+
+// MagicWANConnectorsResponse contains a response including Magic WAN connectors
+type MagicWANConnectorsResponse struct {
+	Response
+	Result []MagicWANConnector `json:"result"`
+}
+
+// ListMagicWANSites lists all Magic WAN sites for a given account
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) ListMagicWANSites(ctx context.Context) ([]MagicWANSite, error) {
+	result := MagicWANSitesResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, "sites", nil, &result); err != nil {
+		return []MagicWANSite{}, err
+	}
+	return result.Result, nil
+}
+
+// GetMagicWANSite returns exactly one Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) GetMagicWANSite(ctx context.Context, siteID string) (MagicWANSite, error) {
+	if siteID == "" {
+		return MagicWANSite{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANSiteResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, "sites/"+siteID, nil, &result); err != nil {
+		return MagicWANSite{}, err
+	}
+	return result.Result, nil
+}
+
+// CreateMagicWANSite creates a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) CreateMagicWANSite(ctx context.Context, site MagicWANSite) (MagicWANSite, error) {
+	result := MagicWANSiteResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPost, "sites", site, &result); err != nil {
+		return MagicWANSite{}, err
+	}
+	return result.Result, nil
+}
+
+// UpdateMagicWANSite replaces a Magic WAN site, identified by site.ID
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) UpdateMagicWANSite(ctx context.Context, site MagicWANSite) (MagicWANSite, error) {
+	if site.ID == "" {
+		return MagicWANSite{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANSiteResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPut, "sites/"+site.ID, site, &result); err != nil {
+		return MagicWANSite{}, err
+	}
+	return result.Result, nil
+}
+
+// DeleteMagicWANSite deletes a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) DeleteMagicWANSite(ctx context.Context, siteID string) (MagicWANSite, error) {
+	if siteID == "" {
+		return MagicWANSite{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANSiteResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodDelete, "sites/"+siteID, nil, &result); err != nil {
+		return MagicWANSite{}, err
+	}
+	return result.Result, nil
+}
+
+// ListMagicWANLANs lists all LANs of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) ListMagicWANLANs(ctx context.Context, siteID string) ([]MagicWANLAN, error) {
+	if siteID == "" {
+		return []MagicWANLAN{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANLANsResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, fmt.Sprintf("sites/%s/lans", siteID), nil, &result); err != nil {
+		return []MagicWANLAN{}, err
+	}
+	return result.Result, nil
+}
+
+// GetMagicWANLAN returns exactly one LAN of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) GetMagicWANLAN(ctx context.Context, siteID, lanID string) (MagicWANLAN, error) {
+	if siteID == "" || lanID == "" {
+		return MagicWANLAN{}, errors.Errorf("site ID and LAN ID cannot be empty")
+	}
+
+	result := MagicWANLANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, fmt.Sprintf("sites/%s/lans/%s", siteID, lanID), nil, &result); err != nil {
+		return MagicWANLAN{}, err
+	}
+	return result.Result, nil
+}
+
+// CreateMagicWANLAN creates a LAN for a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) CreateMagicWANLAN(ctx context.Context, siteID string, lan MagicWANLAN) (MagicWANLAN, error) {
+	if siteID == "" {
+		return MagicWANLAN{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANLANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPost, fmt.Sprintf("sites/%s/lans", siteID), lan, &result); err != nil {
+		return MagicWANLAN{}, err
+	}
+	return result.Result, nil
+}
+
+// UpdateMagicWANLAN replaces a LAN of a Magic WAN site, identified by lan.ID
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) UpdateMagicWANLAN(ctx context.Context, siteID string, lan MagicWANLAN) (MagicWANLAN, error) {
+	if siteID == "" || lan.ID == "" {
+		return MagicWANLAN{}, errors.Errorf("site ID and LAN ID cannot be empty")
+	}
+
+	result := MagicWANLANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPut, fmt.Sprintf("sites/%s/lans/%s", siteID, lan.ID), lan, &result); err != nil {
+		return MagicWANLAN{}, err
+	}
+	return result.Result, nil
+}
+
+// DeleteMagicWANLAN deletes a LAN of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) DeleteMagicWANLAN(ctx context.Context, siteID, lanID string) (MagicWANLAN, error) {
+	if siteID == "" || lanID == "" {
+		return MagicWANLAN{}, errors.Errorf("site ID and LAN ID cannot be empty")
+	}
+
+	result := MagicWANLANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodDelete, fmt.Sprintf("sites/%s/lans/%s", siteID, lanID), nil, &result); err != nil {
+		return MagicWANLAN{}, err
+	}
+	return result.Result, nil
+}
+
+// ListMagicWANWANs lists all WANs of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) ListMagicWANWANs(ctx context.Context, siteID string) ([]MagicWANWAN, error) {
+	if siteID == "" {
+		return []MagicWANWAN{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANWANsResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, fmt.Sprintf("sites/%s/wans", siteID), nil, &result); err != nil {
+		return []MagicWANWAN{}, err
+	}
+	return result.Result, nil
+}
+
+// GetMagicWANWAN returns exactly one WAN of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) GetMagicWANWAN(ctx context.Context, siteID, wanID string) (MagicWANWAN, error) {
+	if siteID == "" || wanID == "" {
+		return MagicWANWAN{}, errors.Errorf("site ID and WAN ID cannot be empty")
+	}
+
+	result := MagicWANWANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, fmt.Sprintf("sites/%s/wans/%s", siteID, wanID), nil, &result); err != nil {
+		return MagicWANWAN{}, err
+	}
+	return result.Result, nil
+}
+
+// CreateMagicWANWAN creates a WAN for a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) CreateMagicWANWAN(ctx context.Context, siteID string, wan MagicWANWAN) (MagicWANWAN, error) {
+	if siteID == "" {
+		return MagicWANWAN{}, errors.Errorf("site ID cannot be empty")
+	}
+
+	result := MagicWANWANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPost, fmt.Sprintf("sites/%s/wans", siteID), wan, &result); err != nil {
+		return MagicWANWAN{}, err
+	}
+	return result.Result, nil
+}
+
+// UpdateMagicWANWAN replaces a WAN of a Magic WAN site, identified by wan.ID
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) UpdateMagicWANWAN(ctx context.Context, siteID string, wan MagicWANWAN) (MagicWANWAN, error) {
+	if siteID == "" || wan.ID == "" {
+		return MagicWANWAN{}, errors.Errorf("site ID and WAN ID cannot be empty")
+	}
+
+	result := MagicWANWANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPut, fmt.Sprintf("sites/%s/wans/%s", siteID, wan.ID), wan, &result); err != nil {
+		return MagicWANWAN{}, err
+	}
+	return result.Result, nil
+}
+
+// DeleteMagicWANWAN deletes a WAN of a Magic WAN site
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) DeleteMagicWANWAN(ctx context.Context, siteID, wanID string) (MagicWANWAN, error) {
+	if siteID == "" || wanID == "" {
+		return MagicWANWAN{}, errors.Errorf("site ID and WAN ID cannot be empty")
+	}
+
+	result := MagicWANWANResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodDelete, fmt.Sprintf("sites/%s/wans/%s", siteID, wanID), nil, &result); err != nil {
+		return MagicWANWAN{}, err
+	}
+	return result.Result, nil
+}
+
+// ListMagicWANConnectors lists all Magic WAN connectors for a given account
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) ListMagicWANConnectors(ctx context.Context) ([]MagicWANConnector, error) {
+	result := MagicWANConnectorsResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, "connectors", nil, &result); err != nil {
+		return []MagicWANConnector{}, err
+	}
+	return result.Result, nil
+}
+
+// GetMagicWANConnector returns exactly one Magic WAN connector
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) GetMagicWANConnector(ctx context.Context, connectorID string) (MagicWANConnector, error) {
+	if connectorID == "" {
+		return MagicWANConnector{}, errors.Errorf("connector ID cannot be empty")
+	}
+
+	result := MagicWANConnectorResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodGet, "connectors/"+connectorID, nil, &result); err != nil {
+		return MagicWANConnector{}, err
+	}
+	return result.Result, nil
+}
+
+// UpdateMagicWANConnector updates the activation, update window and notes of a connector, identified by connector.ID
+//
+// API reference: https://developers.cloudflare.com/magic-wan/
+func (api *API) UpdateMagicWANConnector(ctx context.Context, connector MagicWANConnector) (MagicWANConnector, error) {
+	if connector.ID == "" {
+		return MagicWANConnector{}, errors.Errorf("connector ID cannot be empty")
+	}
+
+	params := struct {
+		Activated                    *bool  `json:"activated,omitempty"`
+		InterruptWindowDurationHours int    `json:"interrupt_window_duration_hours,omitempty"`
+		InterruptWindowHourOfDay     *int   `json:"interrupt_window_hour_of_day,omitempty"`
+		Timezone                     string `json:"timezone,omitempty"`
+		Notes                        string `json:"notes,omitempty"`
+	}{
+		Activated:                    connector.Activated,
+		InterruptWindowDurationHours: connector.InterruptWindowDurationHours,
+		InterruptWindowHourOfDay:     connector.InterruptWindowHourOfDay,
+		Timezone:                     connector.Timezone,
+		Notes:                        connector.Notes,
+	}
+
+	result := MagicWANConnectorResponse{}
+	if err := api.magicWANRequest(ctx, http.MethodPatch, "connectors/"+connector.ID, params, &result); err != nil {
+		return MagicWANConnector{}, err
+	}
+	return result.Result, nil
+}
+
+// magicWANRequest sends a request to the Magic WAN endpoint at path and
+// decodes the response into result.
+func (api *API) magicWANRequest(ctx context.Context, method, path string, params, result interface{}) error {
+	if err := api.checkAccountID(); err != nil {
+		return err
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/magic/%s", api.AccountID, path)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(res, result); err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+
+	return nil
+}
@@ -0,0 +1,300 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListMagicWANSites(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "023e105f4ecef8ad9ca31a8372d0c353",
+					"name": "branch-lisbon",
+					"description": "Lisbon office",
+					"connector_id": "ac60d3d0435248289d446cedd870bcf4",
+					"ha_mode": false,
+					"location": {"lat": "38.7223", "lon": "-9.1393"}
+				}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/sites", handler)
+
+	haMode := false
+	want := []MagicWANSite{{
+		ID:          "023e105f4ecef8ad9ca31a8372d0c353",
+		Name:        "branch-lisbon",
+		Description: "Lisbon office",
+		ConnectorID: "ac60d3d0435248289d446cedd870bcf4",
+		HAMode:      &haMode,
+		Location:    &MagicWANSiteLocation{Lat: "38.7223", Lon: "-9.1393"},
+	}}
+
+	actual, err := client.ListMagicWANSites(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateMagicWANSite(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "branch-lisbon", "connector_id": "ac60d3d0435248289d446cedd870bcf4"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "023e105f4ecef8ad9ca31a8372d0c353",
+				"name": "branch-lisbon",
+				"connector_id": "ac60d3d0435248289d446cedd870bcf4"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/sites", handler)
+
+	actual, err := client.CreateMagicWANSite(context.Background(), MagicWANSite{
+		Name:        "branch-lisbon",
+		ConnectorID: "ac60d3d0435248289d446cedd870bcf4",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, MagicWANSite{
+			ID:          "023e105f4ecef8ad9ca31a8372d0c353",
+			Name:        "branch-lisbon",
+			ConnectorID: "ac60d3d0435248289d446cedd870bcf4",
+		}, actual)
+	}
+
+	_, err = client.UpdateMagicWANSite(context.Background(), MagicWANSite{Name: "branch-lisbon"})
+	assert.EqualError(t, err, "site ID cannot be empty")
+}
+
+func TestCreateMagicWANLAN(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"name": "office",
+				"physport": 2,
+				"vlan_tag": 10,
+				"routed_subnets": [{"prefix": "192.168.2.0/24", "next_hop": "192.168.1.2"}],
+				"static_addressing": {
+					"address": "192.168.1.1/24",
+					"dhcp_server": {"dhcp_pool_start": "192.168.1.100", "dhcp_pool_end": "192.168.1.200"}
+				}
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "b7d9f7d4d4f34ed2a1e7c1b5f0e07f53",
+				"site_id": "023e105f4ecef8ad9ca31a8372d0c353",
+				"name": "office",
+				"physport": 2,
+				"vlan_tag": 10
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/sites/023e105f4ecef8ad9ca31a8372d0c353/lans", handler)
+
+	actual, err := client.CreateMagicWANLAN(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", MagicWANLAN{
+		Name:          "office",
+		Physport:      2,
+		VlanTag:       10,
+		RoutedSubnets: []MagicWANRoutedSubnet{{Prefix: "192.168.2.0/24", NextHop: "192.168.1.2"}},
+		StaticAddressing: &MagicWANLANStaticAddressing{
+			Address:    "192.168.1.1/24",
+			DHCPServer: &MagicWANDHCPServer{DHCPPoolStart: "192.168.1.100", DHCPPoolEnd: "192.168.1.200"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, MagicWANLAN{
+			ID:       "b7d9f7d4d4f34ed2a1e7c1b5f0e07f53",
+			SiteID:   "023e105f4ecef8ad9ca31a8372d0c353",
+			Name:     "office",
+			Physport: 2,
+			VlanTag:  10,
+		}, actual)
+	}
+
+	_, err = client.CreateMagicWANLAN(context.Background(), "", MagicWANLAN{})
+	assert.EqualError(t, err, "site ID cannot be empty")
+}
+
+func TestUpdateMagicWANWAN(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"id": "c4a7362d577a6c3019a474fd6f485823",
+				"name": "uplink",
+				"physport": 1,
+				"priority": 1,
+				"static_addressing": {"address": "203.0.113.2/30", "gateway_address": "203.0.113.1"}
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "c4a7362d577a6c3019a474fd6f485823",
+				"site_id": "023e105f4ecef8ad9ca31a8372d0c353",
+				"name": "uplink",
+				"physport": 1,
+				"priority": 1,
+				"static_addressing": {"address": "203.0.113.2/30", "gateway_address": "203.0.113.1"}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/sites/023e105f4ecef8ad9ca31a8372d0c353/wans/c4a7362d577a6c3019a474fd6f485823", handler)
+
+	wan := MagicWANWAN{
+		ID:               "c4a7362d577a6c3019a474fd6f485823",
+		Name:             "uplink",
+		Physport:         1,
+		Priority:         1,
+		StaticAddressing: &MagicWANWANStaticAddressing{Address: "203.0.113.2/30", GatewayAddress: "203.0.113.1"},
+	}
+	actual, err := client.UpdateMagicWANWAN(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", wan)
+	if assert.NoError(t, err) {
+		wan.SiteID = "023e105f4ecef8ad9ca31a8372d0c353"
+		assert.Equal(t, wan, actual)
+	}
+
+	_, err = client.UpdateMagicWANWAN(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", MagicWANWAN{})
+	assert.EqualError(t, err, "site ID and WAN ID cannot be empty")
+}
+
+func TestUpdateMagicWANConnector(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"activated": true, "interrupt_window_hour_of_day": 3, "timezone": "Europe/Lisbon"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ac60d3d0435248289d446cedd870bcf4",
+				"activated": true,
+				"interrupt_window_duration_hours": 1,
+				"interrupt_window_hour_of_day": 3,
+				"timezone": "Europe/Lisbon",
+				"device": {"id": "d8f4f1b4e0e24ff0a0d5e3b0c8a4a6c9", "serial_number": "CF0001"},
+				"last_updated": "2023-01-01T05:20:00Z",
+				"last_seen_version": "2023.1.0"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/connectors/ac60d3d0435248289d446cedd870bcf4", handler)
+
+	activated := true
+	hourOfDay := 3
+	lastUpdated, _ := time.Parse(time.RFC3339, "2023-01-01T05:20:00Z")
+	actual, err := client.UpdateMagicWANConnector(context.Background(), MagicWANConnector{
+		ID:                       "ac60d3d0435248289d446cedd870bcf4",
+		Activated:                &activated,
+		InterruptWindowHourOfDay: &hourOfDay,
+		Timezone:                 "Europe/Lisbon",
+		LastSeenVersion:          "ignored",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, MagicWANConnector{
+			ID:                           "ac60d3d0435248289d446cedd870bcf4",
+			Activated:                    &activated,
+			InterruptWindowDurationHours: 1,
+			InterruptWindowHourOfDay:     &hourOfDay,
+			Timezone:                     "Europe/Lisbon",
+			Device:                       &MagicWANConnectorDevice{ID: "d8f4f1b4e0e24ff0a0d5e3b0c8a4a6c9", SerialNumber: "CF0001"},
+			LastUpdated:                  &lastUpdated,
+			LastSeenVersion:              "2023.1.0",
+		}, actual)
+	}
+
+	_, err = client.GetMagicWANConnector(context.Background(), "")
+	assert.EqualError(t, err, "connector ID cannot be empty")
+}
+
+func TestUpdateMagicWANConnectorMidnightInterruptWindow(t *testing.T) {
+	setup(UsingAccount("foo"))
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"interrupt_window_hour_of_day": 0}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ac60d3d0435248289d446cedd870bcf4",
+				"interrupt_window_hour_of_day": 0
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/foo/magic/connectors/ac60d3d0435248289d446cedd870bcf4", handler)
+
+	midnight := 0
+	actual, err := client.UpdateMagicWANConnector(context.Background(), MagicWANConnector{
+		ID:                       "ac60d3d0435248289d446cedd870bcf4",
+		InterruptWindowHourOfDay: &midnight,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, MagicWANConnector{
+			ID:                       "ac60d3d0435248289d446cedd870bcf4",
+			InterruptWindowHourOfDay: &midnight,
+		}, actual)
+	}
+}
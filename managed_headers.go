@@ -0,0 +1,82 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ManagedHeaders holds the managed request and response header transforms
+// of a zone.
+type ManagedHeaders struct {
+	ManagedRequestHeaders  []ManagedHeader `json:"managed_request_headers"`
+	ManagedResponseHeaders []ManagedHeader `json:"managed_response_headers"`
+}
+
+// ManagedHeader is a single managed transform, such as
+// "add_true_client_ip_headers" or "remove_x-powered-by_header".
+//
+// HasConflict and ConflictsWith are only returned by the API; a transform
+// cannot be enabled while a conflicting one is.
+type ManagedHeader struct {
+	ID            string   `json:"id"`
+	Enabled       bool     `json:"enabled"`
+	HasConflict   bool     `json:"has_conflict,omitempty"`
+	ConflictsWith []string `json:"conflicts_with,omitempty"`
+}
+
+// ManagedHeadersResponse is the API response for the managed transforms of a
+// zone.
+type ManagedHeadersResponse struct {
+	Response
+	Result ManagedHeaders `json:"result"`
+}
+
+// ListZoneManagedHeaders returns all managed request and response header
+// transforms of a zone, along with whether they are enabled.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-list-managed-transforms
+func (api *API) ListZoneManagedHeaders(ctx context.Context, zoneID string) (ManagedHeaders, error) {
+	return api.managedHeadersRequest(ctx, http.MethodGet, zoneID, nil)
+}
+
+// UpdateZoneManagedHeaders enables or disables the given managed transforms
+// of a zone. Transforms not included are left unchanged.
+//
+// API reference: https://api.cloudflare.com/#managed-transforms-update-status-of-managed-transforms
+func (api *API) UpdateZoneManagedHeaders(ctx context.Context, zoneID string, headers ManagedHeaders) (ManagedHeaders, error) {
+	params := ManagedHeaders{
+		ManagedRequestHeaders:  managedHeaderToggles(headers.ManagedRequestHeaders),
+		ManagedResponseHeaders: managedHeaderToggles(headers.ManagedResponseHeaders),
+	}
+	return api.managedHeadersRequest(ctx, http.MethodPatch, zoneID, params)
+}
+
+// managedHeaderToggles strips the read-only fields of managed transforms
+// and ensures an empty list is sent rather than null.
+func managedHeaderToggles(headers []ManagedHeader) []ManagedHeader {
+	toggles := make([]ManagedHeader, 0, len(headers))
+	for _, h := range headers {
+		toggles = append(toggles, ManagedHeader{ID: h.ID, Enabled: h.Enabled})
+	}
+	return toggles
+}
+
+func (api *API) managedHeadersRequest(ctx context.Context, method, zoneID string, params interface{}) (ManagedHeaders, error) {
+	uri := fmt.Sprintf("/zones/%s/managed_headers", zoneID)
+
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return ManagedHeaders{}, err
+	}
+
+	var r ManagedHeadersResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ManagedHeaders{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,94 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListZoneManagedHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"managed_request_headers": [
+					{"id": "add_true_client_ip_headers", "enabled": true, "has_conflict": false},
+					{"id": "add_visitor_location_headers", "enabled": false, "has_conflict": false}
+				],
+				"managed_response_headers": [
+					{"id": "remove_x-powered-by_header", "enabled": false, "has_conflict": true, "conflicts_with": ["add_security_headers"]}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/managed_headers", handler)
+
+	want := ManagedHeaders{
+		ManagedRequestHeaders: []ManagedHeader{
+			{ID: "add_true_client_ip_headers", Enabled: true},
+			{ID: "add_visitor_location_headers"},
+		},
+		ManagedResponseHeaders: []ManagedHeader{
+			{ID: "remove_x-powered-by_header", HasConflict: true, ConflictsWith: []string{"add_security_headers"}},
+		},
+	}
+
+	actual, err := client.ListZoneManagedHeaders(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateZoneManagedHeaders(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"managed_request_headers": [],
+				"managed_response_headers": [{"id": "add_security_headers", "enabled": true}]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"managed_request_headers": [
+					{"id": "add_true_client_ip_headers", "enabled": false}
+				],
+				"managed_response_headers": [
+					{"id": "add_security_headers", "enabled": true}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/managed_headers", handler)
+
+	actual, err := client.UpdateZoneManagedHeaders(context.Background(), testZoneID, ManagedHeaders{
+		ManagedResponseHeaders: []ManagedHeader{{ID: "add_security_headers", Enabled: true, HasConflict: true}},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ManagedHeaders{
+			ManagedRequestHeaders:  []ManagedHeader{{ID: "add_true_client_ip_headers"}},
+			ManagedResponseHeaders: []ManagedHeader{{ID: "add_security_headers", Enabled: true}},
+		}, actual)
+	}
+}
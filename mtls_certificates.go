@@ -0,0 +1,152 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MTLSCertificate represents a certificate held in an account's mTLS
+// certificate store.
+type MTLSCertificate struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name"`
+	Issuer       string     `json:"issuer"`
+	Signature    string     `json:"signature"`
+	SerialNumber string     `json:"serial_number"`
+	Certificates string     `json:"certificates"`
+	CA           bool       `json:"ca"`
+	UploadedOn   *time.Time `json:"uploaded_on,omitempty"`
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	ExpiresOn    *time.Time `json:"expires_on,omitempty"`
+}
+
+// MTLSCertificateParams holds the certificate, and for leaf certificates the
+// private key, to upload to the mTLS certificate store.
+type MTLSCertificateParams struct {
+	Name         string `json:"name,omitempty"`
+	Certificates string `json:"certificates"`
+	PrivateKey   string `json:"private_key,omitempty"`
+	CA           bool   `json:"ca"`
+}
+
+// MTLSAssociation represents a service that makes use of an mTLS
+// certificate.
+type MTLSAssociation struct {
+	Service string `json:"service"`
+	Status  string `json:"status"`
+}
+
+// MTLSCertificatesResponse is the API response, containing a list of mTLS
+// certificates.
+type MTLSCertificatesResponse struct {
+	Response
+	Result     []MTLSCertificate `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// MTLSCertificateResponse is the API response, containing a single mTLS
+// certificate.
+type MTLSCertificateResponse struct {
+	Response
+	Result MTLSCertificate `json:"result"`
+}
+
+// MTLSAssociationResponse is the API response, containing the services
+// associated with an mTLS certificate.
+type MTLSAssociationResponse struct {
+	Response
+	Result []MTLSAssociation `json:"result"`
+}
+
+// ListMTLSCertificates returns all certificates in an account's mTLS
+// certificate store.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-list-mtls-certificates
+func (api *API) ListMTLSCertificates(ctx context.Context, accountID string) ([]MTLSCertificate, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/mtls_certificates", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MTLSCertificate{}, ResultInfo{}, err
+	}
+
+	var certificatesResponse MTLSCertificatesResponse
+	err = json.Unmarshal(res, &certificatesResponse)
+	if err != nil {
+		return []MTLSCertificate{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return certificatesResponse.Result, certificatesResponse.ResultInfo, nil
+}
+
+// MTLSCertificate returns a single certificate from an account's mTLS
+// certificate store.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-get-mtls-certificate
+func (api *API) MTLSCertificate(ctx context.Context, accountID, certificateID string) (MTLSCertificate, error) {
+	uri := fmt.Sprintf("/%s/%s/mtls_certificates/%s", AccountRouteRoot, accountID, certificateID)
+
+	return api.mtlsCertificateRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UploadMTLSCertificate uploads a CA or leaf certificate to an account's
+// mTLS certificate store.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-upload-mtls-certificate
+func (api *API) UploadMTLSCertificate(ctx context.Context, accountID string, params MTLSCertificateParams) (MTLSCertificate, error) {
+	uri := fmt.Sprintf("/%s/%s/mtls_certificates", AccountRouteRoot, accountID)
+
+	return api.mtlsCertificateRequest(ctx, http.MethodPost, uri, params)
+}
+
+// DeleteMTLSCertificate removes a certificate from an account's mTLS
+// certificate store. A certificate cannot be deleted while it is still
+// associated with a service.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-delete-mtls-certificate
+func (api *API) DeleteMTLSCertificate(ctx context.Context, accountID, certificateID string) (MTLSCertificate, error) {
+	uri := fmt.Sprintf("/%s/%s/mtls_certificates/%s", AccountRouteRoot, accountID, certificateID)
+
+	return api.mtlsCertificateRequest(ctx, http.MethodDelete, uri, nil)
+}
+
+// ListMTLSCertificateAssociations returns the services that make use of an
+// mTLS certificate.
+//
+// API reference: https://api.cloudflare.com/#mtls-certificate-management-list-mtls-certificate-associations
+func (api *API) ListMTLSCertificateAssociations(ctx context.Context, accountID, certificateID string) ([]MTLSAssociation, error) {
+	uri := fmt.Sprintf("/%s/%s/mtls_certificates/%s/associations", AccountRouteRoot, accountID, certificateID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []MTLSAssociation{}, err
+	}
+
+	var associationResponse MTLSAssociationResponse
+	err = json.Unmarshal(res, &associationResponse)
+	if err != nil {
+		return []MTLSAssociation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return associationResponse.Result, nil
+}
+
+func (api *API) mtlsCertificateRequest(ctx context.Context, method, uri string, params interface{}) (MTLSCertificate, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return MTLSCertificate{}, err
+	}
+
+	var certificateResponse MTLSCertificateResponse
+	err = json.Unmarshal(res, &certificateResponse)
+	if err != nil {
+		return MTLSCertificate{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return certificateResponse.Result, nil
+}
@@ -0,0 +1,187 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testMTLSCertificateID = "2458ce5a-0c35-4c7f-82c7-8e9487d3ff60"
+
+func TestListMTLSCertificates(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "%s",
+					"name": "example_ca_cert",
+					"issuer": "O=Example Inc.,L=California,ST=San Francisco,C=US",
+					"signature": "SHA256WithRSA",
+					"serial_number": "235217144297995885180570755458463043449861756659",
+					"certificates": "-----BEGIN CERTIFICATE-----\nMIIDmDCCAoCgAwIBAgIUKTOAZNj...\n-----END CERTIFICATE-----",
+					"ca": true,
+					"uploaded_on": "2022-11-22T17:32:30.467938Z",
+					"expires_on": "2122-10-29T16:59:47Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 50,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testMTLSCertificateID)
+	}
+
+	uploadedOn, _ := time.Parse(time.RFC3339, "2022-11-22T17:32:30.467938Z")
+	expiresOn, _ := time.Parse(time.RFC3339, "2122-10-29T16:59:47Z")
+
+	want := []MTLSCertificate{{
+		ID:           testMTLSCertificateID,
+		Name:         "example_ca_cert",
+		Issuer:       "O=Example Inc.,L=California,ST=San Francisco,C=US",
+		Signature:    "SHA256WithRSA",
+		SerialNumber: "235217144297995885180570755458463043449861756659",
+		Certificates: "-----BEGIN CERTIFICATE-----\nMIIDmDCCAoCgAwIBAgIUKTOAZNj...\n-----END CERTIFICATE-----",
+		CA:           true,
+		UploadedOn:   &uploadedOn,
+		ExpiresOn:    &expiresOn,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/mtls_certificates", handler)
+
+	actual, _, err := client.ListMTLSCertificates(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUploadMTLSCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name":"example_ca_cert","certificates":"-----BEGIN CERTIFICATE-----","ca":true}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"name": "example_ca_cert",
+				"certificates": "-----BEGIN CERTIFICATE-----",
+				"ca": true
+			}
+		}
+		`, testMTLSCertificateID)
+	}
+
+	want := MTLSCertificate{
+		ID:           testMTLSCertificateID,
+		Name:         "example_ca_cert",
+		Certificates: "-----BEGIN CERTIFICATE-----",
+		CA:           true,
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/mtls_certificates", handler)
+
+	actual, err := client.UploadMTLSCertificate(context.Background(), testAccountID, MTLSCertificateParams{
+		Name:         "example_ca_cert",
+		Certificates: "-----BEGIN CERTIFICATE-----",
+		CA:           true,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteMTLSCertificate(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"name": "example_ca_cert",
+				"ca": true
+			}
+		}
+		`, testMTLSCertificateID)
+	}
+
+	want := MTLSCertificate{
+		ID:   testMTLSCertificateID,
+		Name: "example_ca_cert",
+		CA:   true,
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/mtls_certificates/"+testMTLSCertificateID, handler)
+
+	actual, err := client.DeleteMTLSCertificate(context.Background(), testAccountID, testMTLSCertificateID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListMTLSCertificateAssociations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"service": "gateway",
+					"status": "pending_deployment"
+				}
+			]
+		}
+		`)
+	}
+
+	want := []MTLSAssociation{{
+		Service: "gateway",
+		Status:  "pending_deployment",
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/mtls_certificates/"+testMTLSCertificateID+"/associations", handler)
+
+	actual, err := client.ListMTLSCertificateAssociations(context.Background(), testAccountID, testMTLSCertificateID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,99 @@
+package cloudflare
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// NetworkAnalyticsMitigationsParams selects the L3/4 traffic summarised by
+// NetworkAnalyticsMitigations.
+type NetworkAnalyticsMitigationsParams struct {
+	// Dataset is either AnalyticsDatasetMagicTransitNetworkAnalytics or
+	// AnalyticsDatasetSpectrumNetworkAnalytics. Defaults to Magic Transit.
+	Dataset AnalyticsDataset
+	Since   time.Time
+	Until   time.Time
+	// Outcome optionally restricts the results to "pass" or "drop".
+	Outcome string
+}
+
+// NetworkAnalyticsMitigation is the traffic handled by a single mitigation
+// system with a given outcome.
+type NetworkAnalyticsMitigation struct {
+	MitigationSystem string
+	Outcome          string
+	Bits             int64
+	Packets          int64
+}
+
+// Bytes returns the traffic volume in bytes.
+func (m NetworkAnalyticsMitigation) Bytes() int64 {
+	return m.Bits / 8
+}
+
+type networkAnalyticsMitigationRow struct {
+	Dimensions struct {
+		MitigationSystem string `json:"mitigationSystem"`
+		Outcome          string `json:"outcome"`
+	} `json:"dimensions"`
+	Sum struct {
+		Bits    int64 `json:"bits"`
+		Packets int64 `json:"packets"`
+	} `json:"sum"`
+}
+
+// NetworkAnalyticsMitigations returns the bits and packets of an account's
+// L3/4 traffic between params.Since and params.Until, grouped by mitigation
+// system and outcome, largest first.
+//
+// API reference: https://developers.cloudflare.com/analytics/graphql-api/tutorials/querying-magic-transit-network-analytics/
+func (api *API) NetworkAnalyticsMitigations(ctx context.Context, accountID string, params NetworkAnalyticsMitigationsParams) ([]NetworkAnalyticsMitigation, error) {
+	if !params.Until.After(params.Since) {
+		return []NetworkAnalyticsMitigation{}, errors.Errorf("until must be after since")
+	}
+
+	dataset := params.Dataset
+	if dataset == "" {
+		dataset = AnalyticsDatasetMagicTransitNetworkAnalytics
+	}
+	if dataset != AnalyticsDatasetMagicTransitNetworkAnalytics && dataset != AnalyticsDatasetSpectrumNetworkAnalytics {
+		return []NetworkAnalyticsMitigation{}, errors.Errorf("unsupported network analytics dataset %q", dataset)
+	}
+
+	filter := map[string]interface{}{
+		"datetime_geq": params.Since.UTC().Format(time.RFC3339),
+		"datetime_lt":  params.Until.UTC().Format(time.RFC3339),
+	}
+	if params.Outcome != "" {
+		filter["outcome"] = params.Outcome
+	}
+
+	var rows []networkAnalyticsMitigationRow
+	err := api.GraphQLAnalytics(ctx, GraphQLAnalyticsQuery{
+		Dataset: dataset,
+		Tag:     accountID,
+		Filter:  filter,
+		Limit:   graphQLAnalyticsMaxLimit,
+		OrderBy: []string{"sum_bits_DESC"},
+		Fields: []string{
+			"dimensions { mitigationSystem outcome }",
+			"sum { bits packets }",
+		},
+	}, &rows)
+	if err != nil {
+		return []NetworkAnalyticsMitigation{}, err
+	}
+
+	mitigations := make([]NetworkAnalyticsMitigation, 0, len(rows))
+	for _, row := range rows {
+		mitigations = append(mitigations, NetworkAnalyticsMitigation{
+			MitigationSystem: row.Dimensions.MitigationSystem,
+			Outcome:          row.Dimensions.Outcome,
+			Bits:             row.Sum.Bits,
+			Packets:          row.Sum.Packets,
+		})
+	}
+	return mitigations, nil
+}
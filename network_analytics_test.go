@@ -0,0 +1,86 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNetworkAnalyticsMitigations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			var query GraphQLQuery
+			if assert.NoError(t, json.Unmarshal(body, &query)) {
+				assert.Contains(t, query.Query, "accounts(filter: {accountTag: $tag})")
+				assert.Contains(t, query.Query, "rows: spectrumNetworkAnalyticsAdaptiveGroups(filter: $filter, limit: $limit, orderBy: [sum_bits_DESC])")
+				assert.Equal(t, map[string]interface{}{
+					"datetime_geq": "2023-06-01T00:00:00Z",
+					"datetime_lt":  "2023-06-02T00:00:00Z",
+					"outcome":      "drop",
+				}, query.Variables["filter"])
+			}
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"data": {
+				"viewer": {
+					"accounts": [
+						{
+							"rows": [
+								{
+									"dimensions": {"mitigationSystem": "dosd", "outcome": "drop"},
+									"sum": {"bits": 8000, "packets": 20}
+								},
+								{
+									"dimensions": {"mitigationSystem": "flowtrackd", "outcome": "drop"},
+									"sum": {"bits": 800, "packets": 2}
+								}
+							]
+						}
+					]
+				}
+			},
+			"errors": null
+		}`)
+	}
+
+	mux.HandleFunc("/graphql", handler)
+
+	since, _ := time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+	until, _ := time.Parse(time.RFC3339, "2023-06-02T00:00:00Z")
+
+	want := []NetworkAnalyticsMitigation{
+		{MitigationSystem: "dosd", Outcome: "drop", Bits: 8000, Packets: 20},
+		{MitigationSystem: "flowtrackd", Outcome: "drop", Bits: 800, Packets: 2},
+	}
+
+	actual, err := client.NetworkAnalyticsMitigations(context.Background(), testAccountID, NetworkAnalyticsMitigationsParams{
+		Dataset: AnalyticsDatasetSpectrumNetworkAnalytics,
+		Since:   since,
+		Until:   until,
+		Outcome: "drop",
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, int64(1000), actual[0].Bytes())
+	}
+
+	_, err = client.NetworkAnalyticsMitigations(context.Background(), testAccountID, NetworkAnalyticsMitigationsParams{
+		Dataset: AnalyticsDatasetHTTPRequestsAdaptiveGroups,
+		Since:   since,
+		Until:   until,
+	})
+	assert.EqualError(t, err, `unsupported network analytics dataset "httpRequestsAdaptiveGroups"`)
+}
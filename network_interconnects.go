@@ -0,0 +1,241 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Network Interconnect types
+const (
+	// NetworkInterconnectTypeDirect is a physical interconnect in a Cloudflare data center
+	NetworkInterconnectTypeDirect = "direct"
+
+	// NetworkInterconnectTypeGCP is a Google Cloud Partner Interconnect
+	NetworkInterconnectTypeGCP = "gcp"
+
+	// NetworkInterconnectTypeAWS is an AWS Direct Connect partner interconnect
+	NetworkInterconnectTypeAWS = "aws"
+)
+
+// NetworkInterconnectFacility is the data center hosting a direct interconnect
+type NetworkInterconnectFacility struct {
+	Name    string   `json:"name"`
+	Address []string `json:"address"`
+}
+
+// NetworkInterconnect contains information about a Cloudflare Network Interconnect
+type NetworkInterconnect struct {
+	Name     string                       `json:"name"`
+	Account  string                       `json:"account"`
+	Type     string                       `json:"type"`
+	Facility *NetworkInterconnectFacility `json:"facility,omitempty"`
+	Site     string                       `json:"site,omitempty"`
+	SlotID   string                       `json:"slot_id,omitempty"`
+	Speed    string                       `json:"speed,omitempty"`
+	Region   string                       `json:"region,omitempty"`
+	Owner    string                       `json:"owner,omitempty"`
+}
+
+// NetworkInterconnectStatus is the provisioning and health state of an interconnect
+type NetworkInterconnectStatus struct {
+	// State is one of "Pending", "Down", "Unhealthy" or "Healthy".
+	State  string `json:"state"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// NetworkInterconnectsParams holds the filters used when listing interconnects
+type NetworkInterconnectsParams struct {
+	Site string
+	Type string
+}
+
+// CreateNetworkInterconnectParams describes a new interconnect. Direct
+// interconnects need SlotID and Speed; partner interconnects need Region
+// and Bandwidth, plus PairingKey for GCP.
+type CreateNetworkInterconnectParams struct {
+	Account    string `json:"account"`
+	Type       string `json:"type"`
+	SlotID     string `json:"slot_id,omitempty"`
+	Speed      string `json:"speed,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Bandwidth  string `json:"bandwidth,omitempty"`
+	PairingKey string `json:"pairing_key,omitempty"`
+}
+
+// NetworkInterconnectResponse contains a response including exactly one interconnect
+type NetworkInterconnectResponse struct {
+	Response
+	Result NetworkInterconnect `json:"result"`
+}
+
+// NetworkInterconnectsResponse contains a response including a page of interconnects
+type NetworkInterconnectsResponse struct {
+	Response
+	Result struct {
+		Items []NetworkInterconnect `json:"items"`
+		Next  string                `json:"next,omitempty"`
+	} `json:"result"`
+}
+
+// NetworkInterconnectStatusResponse contains a response including the status of an interconnect
+type NetworkInterconnectStatusResponse struct {
+	Response
+	Result NetworkInterconnectStatus `json:"result"`
+}
+
+// ListNetworkInterconnects lists all interconnects of an account matching
+// params, following the cursor until every page has been fetched.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) ListNetworkInterconnects(ctx context.Context, accountID string, params NetworkInterconnectsParams) ([]NetworkInterconnect, error) {
+	v := url.Values{}
+	if params.Site != "" {
+		v.Set("site", params.Site)
+	}
+	if params.Type != "" {
+		v.Set("type", params.Type)
+	}
+
+	var interconnects []NetworkInterconnect
+	for {
+		uri := fmt.Sprintf("/%s/%s/cni/interconnects", AccountRouteRoot, accountID)
+		if len(v) > 0 {
+			uri += "?" + v.Encode()
+		}
+		res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+		if err != nil {
+			return []NetworkInterconnect{}, err
+		}
+
+		var r NetworkInterconnectsResponse
+		err = json.Unmarshal(res, &r)
+		if err != nil {
+			return []NetworkInterconnect{}, errors.Wrap(err, errUnmarshalError)
+		}
+		interconnects = append(interconnects, r.Result.Items...)
+
+		if r.Result.Next == "" || len(r.Result.Items) == 0 {
+			return interconnects, nil
+		}
+		v.Set("cursor", r.Result.Next)
+	}
+}
+
+// NetworkInterconnect returns a single interconnect.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) NetworkInterconnect(ctx context.Context, accountID, name string) (NetworkInterconnect, error) {
+	if name == "" {
+		return NetworkInterconnect{}, errors.Errorf("interconnect name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/cni/interconnects/%s", AccountRouteRoot, accountID, name)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return NetworkInterconnect{}, err
+	}
+
+	var r NetworkInterconnectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return NetworkInterconnect{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateNetworkInterconnect provisions a new interconnect.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) CreateNetworkInterconnect(ctx context.Context, accountID string, params CreateNetworkInterconnectParams) (NetworkInterconnect, error) {
+	switch params.Type {
+	case NetworkInterconnectTypeDirect:
+		if params.SlotID == "" || params.Speed == "" {
+			return NetworkInterconnect{}, errors.Errorf("direct interconnects require a slot ID and speed")
+		}
+	case NetworkInterconnectTypeGCP:
+		if params.PairingKey == "" {
+			return NetworkInterconnect{}, errors.Errorf("GCP interconnects require a pairing key")
+		}
+	case NetworkInterconnectTypeAWS:
+	default:
+		return NetworkInterconnect{}, errors.Errorf("unsupported interconnect type %q", params.Type)
+	}
+	if params.Account == "" {
+		params.Account = accountID
+	}
+
+	uri := fmt.Sprintf("/%s/%s/cni/interconnects", AccountRouteRoot, accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return NetworkInterconnect{}, err
+	}
+
+	var r NetworkInterconnectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return NetworkInterconnect{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteNetworkInterconnect deletes an interconnect.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) DeleteNetworkInterconnect(ctx context.Context, accountID, name string) error {
+	if name == "" {
+		return errors.Errorf("interconnect name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/cni/interconnects/%s", AccountRouteRoot, accountID, name)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// NetworkInterconnectStatus returns the provisioning and health state of an
+// interconnect.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) NetworkInterconnectStatus(ctx context.Context, accountID, name string) (NetworkInterconnectStatus, error) {
+	if name == "" {
+		return NetworkInterconnectStatus{}, errors.Errorf("interconnect name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/cni/interconnects/%s/status", AccountRouteRoot, accountID, name)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return NetworkInterconnectStatus{}, err
+	}
+
+	var r NetworkInterconnectStatusResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return NetworkInterconnectStatus{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// NetworkInterconnectLOA returns the Letter of Authorization PDF of a direct
+// interconnect, which is handed to the data center to provision the cross
+// connect.
+//
+// API reference: https://developers.cloudflare.com/network-interconnect/
+func (api *API) NetworkInterconnectLOA(ctx context.Context, accountID, name string) ([]byte, error) {
+	if name == "" {
+		return nil, errors.Errorf("interconnect name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/cni/interconnects/%s/loa", AccountRouteRoot, accountID, name)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,174 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListNetworkInterconnects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "direct", r.URL.Query().Get("type"))
+		w.Header().Set("content-type", "application/json")
+		switch r.URL.Query().Get("cursor") {
+		case "":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"items": [
+						{
+							"name": "cni-ord-1",
+							"account": "`+testAccountID+`",
+							"type": "direct",
+							"facility": {"name": "Equinix CH1", "address": ["350 E Cermak Rd", "Chicago, IL"]},
+							"site": "ord01",
+							"slot_id": "dc3ab1b0-0e2c-4d5c-8a05-6fb6fd0e2a6b",
+							"speed": "10G"
+						}
+					],
+					"next": "2"
+				}
+			}`)
+		case "2":
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"items": [
+						{"name": "cni-ams-1", "account": "`+testAccountID+`", "type": "direct", "site": "ams01", "speed": "100G"}
+					]
+				}
+			}`)
+		default:
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("cursor"))
+		}
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cni/interconnects", handler)
+
+	want := []NetworkInterconnect{
+		{
+			Name:    "cni-ord-1",
+			Account: testAccountID,
+			Type:    NetworkInterconnectTypeDirect,
+			Facility: &NetworkInterconnectFacility{
+				Name:    "Equinix CH1",
+				Address: []string{"350 E Cermak Rd", "Chicago, IL"},
+			},
+			Site:   "ord01",
+			SlotID: "dc3ab1b0-0e2c-4d5c-8a05-6fb6fd0e2a6b",
+			Speed:  "10G",
+		},
+		{
+			Name:    "cni-ams-1",
+			Account: testAccountID,
+			Type:    NetworkInterconnectTypeDirect,
+			Site:    "ams01",
+			Speed:   "100G",
+		},
+	}
+
+	actual, err := client.ListNetworkInterconnects(context.Background(), testAccountID, NetworkInterconnectsParams{Type: NetworkInterconnectTypeDirect})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateNetworkInterconnect(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"account":"`+testAccountID+`","type":"gcp","region":"us-east4","bandwidth":"1G","pairing_key":"7f4ca3a6-e3e5-4b6e-98e2-4cbb4b5b9d8e/us-east4/1"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"name": "cni-gcp-1", "account": "`+testAccountID+`", "type": "gcp", "region": "us-east4", "owner": "Google"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cni/interconnects", handler)
+
+	actual, err := client.CreateNetworkInterconnect(context.Background(), testAccountID, CreateNetworkInterconnectParams{
+		Type:       NetworkInterconnectTypeGCP,
+		Region:     "us-east4",
+		Bandwidth:  "1G",
+		PairingKey: "7f4ca3a6-e3e5-4b6e-98e2-4cbb4b5b9d8e/us-east4/1",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, NetworkInterconnect{
+			Name:    "cni-gcp-1",
+			Account: testAccountID,
+			Type:    NetworkInterconnectTypeGCP,
+			Region:  "us-east4",
+			Owner:   "Google",
+		}, actual)
+	}
+
+	_, err = client.CreateNetworkInterconnect(context.Background(), testAccountID, CreateNetworkInterconnectParams{Type: NetworkInterconnectTypeDirect})
+	assert.EqualError(t, err, "direct interconnects require a slot ID and speed")
+
+	_, err = client.CreateNetworkInterconnect(context.Background(), testAccountID, CreateNetworkInterconnectParams{Type: "azure"})
+	assert.EqualError(t, err, `unsupported interconnect type "azure"`)
+}
+
+func TestNetworkInterconnectStatus(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"state": "Down", "reason": "No light detected"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cni/interconnects/cni-ord-1/status", handler)
+
+	actual, err := client.NetworkInterconnectStatus(context.Background(), testAccountID, "cni-ord-1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, NetworkInterconnectStatus{State: "Down", Reason: "No light detected"}, actual)
+	}
+}
+
+func TestNetworkInterconnectLOA(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/pdf")
+		fmt.Fprint(w, "%PDF-1.7")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cni/interconnects/cni-ord-1/loa", handler)
+
+	actual, err := client.NetworkInterconnectLOA(context.Background(), testAccountID, "cni-ord-1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("%PDF-1.7"), actual)
+	}
+
+	_, err = client.NetworkInterconnectLOA(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "interconnect name cannot be empty")
+}
@@ -0,0 +1,342 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Observatory test schedule frequencies
+const (
+	// ObservatoryScheduleDaily runs a scheduled test every day
+	ObservatoryScheduleDaily = "DAILY"
+
+	// ObservatoryScheduleWeekly runs a scheduled test every week
+	ObservatoryScheduleWeekly = "WEEKLY"
+)
+
+// ObservatoryRegion is a region Observatory tests can be run from.
+type ObservatoryRegion struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
+
+// ObservatoryLighthouseError describes why a Lighthouse report could not be
+// generated.
+type ObservatoryLighthouseError struct {
+	Code              string `json:"code"`
+	Detail            string `json:"detail"`
+	FinalDisplayedURL string `json:"finalDisplayedUrl"`
+}
+
+// ObservatoryLighthouseReport contains the Lighthouse performance score and
+// metrics of a test run on a single device type.
+type ObservatoryLighthouseReport struct {
+	// State is one of "RUNNING", "COMPLETE" or "FAILED".
+	State            string                      `json:"state"`
+	DeviceType       string                      `json:"deviceType"`
+	PerformanceScore int                         `json:"performanceScore"`
+	CLS              float64                     `json:"cls"`
+	FCP              float64                     `json:"fcp"`
+	LCP              float64                     `json:"lcp"`
+	SI               float64                     `json:"si"`
+	TBT              float64                     `json:"tbt"`
+	TTI              float64                     `json:"tti"`
+	Error            *ObservatoryLighthouseError `json:"error,omitempty"`
+}
+
+// ObservatoryPageTest is a single Observatory test of a page.
+type ObservatoryPageTest struct {
+	ID                string                      `json:"id"`
+	Date              *time.Time                  `json:"date"`
+	URL               string                      `json:"url"`
+	Region            ObservatoryRegion           `json:"region"`
+	ScheduleFrequency string                      `json:"scheduleFrequency"`
+	MobileReport      ObservatoryLighthouseReport `json:"mobileReport"`
+	DesktopReport     ObservatoryLighthouseReport `json:"desktopReport"`
+}
+
+// ObservatoryPage is a page tested by Observatory, along with its latest
+// test.
+type ObservatoryPage struct {
+	URL               string                `json:"url"`
+	Region            ObservatoryRegion     `json:"region"`
+	ScheduleFrequency string                `json:"scheduleFrequency"`
+	Tests             []ObservatoryPageTest `json:"tests"`
+}
+
+// ObservatorySchedule is the recurring test schedule of a page.
+type ObservatorySchedule struct {
+	URL       string `json:"url"`
+	Region    string `json:"region"`
+	Frequency string `json:"frequency"`
+}
+
+// ObservatoryQuota contains the remaining test and schedule quota of a zone.
+type ObservatoryQuota struct {
+	Plan               string `json:"plan"`
+	RemainingSchedules int    `json:"remainingSchedules"`
+	RemainingTests     int    `json:"remainingTests"`
+}
+
+// ObservatoryAvailabilities contains the regions tests can be run from and
+// the remaining quota of a zone.
+type ObservatoryAvailabilities struct {
+	Quota          ObservatoryQuota               `json:"quota"`
+	Regions        []ObservatoryRegion            `json:"regions"`
+	RegionsPerPlan map[string][]ObservatoryRegion `json:"regionsPerPlan"`
+}
+
+// ObservatoryPageTestsParams holds the filters used when listing the tests
+// of a page.
+type ObservatoryPageTestsParams struct {
+	Region string
+	PaginationOptions
+}
+
+// Encode encodes the Observatory page test parameters into a query string.
+func (p ObservatoryPageTestsParams) Encode() string {
+	v := url.Values{}
+
+	if p.Region != "" {
+		v.Set("region", p.Region)
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// ObservatoryPagesResponse is the API response containing the tested pages
+// of a zone.
+type ObservatoryPagesResponse struct {
+	Response
+	Result []ObservatoryPage `json:"result"`
+}
+
+// ObservatoryPageTestsResponse is the API response containing a page of
+// Observatory tests.
+type ObservatoryPageTestsResponse struct {
+	Response
+	Result     []ObservatoryPageTest `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// ObservatoryPageTestResponse is the API response containing a single
+// Observatory test.
+type ObservatoryPageTestResponse struct {
+	Response
+	Result ObservatoryPageTest `json:"result"`
+}
+
+// ObservatoryScheduleResponse is the API response containing a test
+// schedule.
+type ObservatoryScheduleResponse struct {
+	Response
+	Result ObservatorySchedule `json:"result"`
+}
+
+// ObservatoryAvailabilitiesResponse is the API response containing the
+// Observatory availabilities of a zone.
+type ObservatoryAvailabilitiesResponse struct {
+	Response
+	Result ObservatoryAvailabilities `json:"result"`
+}
+
+// ObservatoryPages returns the pages of a zone that have been tested, with
+// their latest test.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) ObservatoryPages(ctx context.Context, zoneID string) ([]ObservatoryPage, error) {
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []ObservatoryPage{}, err
+	}
+
+	var r ObservatoryPagesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ObservatoryPage{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ObservatoryPageTests returns the tests of a page, most recent first.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) ObservatoryPageTests(ctx context.Context, zoneID, pageURL string, params ObservatoryPageTestsParams) ([]ObservatoryPageTest, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests?%s", zoneID, url.PathEscape(pageURL), params.Encode())
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []ObservatoryPageTest{}, ResultInfo{}, err
+	}
+
+	var r ObservatoryPageTestsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []ObservatoryPageTest{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// ObservatoryPageTest returns a single test of a page.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) ObservatoryPageTest(ctx context.Context, zoneID, pageURL, testID string) (ObservatoryPageTest, error) {
+	if testID == "" {
+		return ObservatoryPageTest{}, errors.Errorf("test ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests/%s", zoneID, url.PathEscape(pageURL), testID)
+	return api.observatoryPageTestRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateObservatoryPageTest starts an on-demand test of a page from region.
+// The returned test is "RUNNING" until its reports are complete.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) CreateObservatoryPageTest(ctx context.Context, zoneID, pageURL, region string) (ObservatoryPageTest, error) {
+	if pageURL == "" {
+		return ObservatoryPageTest{}, errors.Errorf("page URL cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests", zoneID, url.PathEscape(pageURL))
+	params := struct {
+		Region string `json:"region,omitempty"`
+	}{region}
+	return api.observatoryPageTestRequest(ctx, http.MethodPost, uri, params)
+}
+
+// DeleteObservatoryPageTests deletes every test of a page run from region.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) DeleteObservatoryPageTests(ctx context.Context, zoneID, pageURL, region string) error {
+	uri := fmt.Sprintf("/zones/%s/speed_api/pages/%s/tests", zoneID, url.PathEscape(pageURL))
+	if region != "" {
+		uri += "?" + url.Values{"region": {region}}.Encode()
+	}
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// ObservatorySchedule returns the recurring test schedule of a page.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) ObservatorySchedule(ctx context.Context, zoneID, pageURL, region string) (ObservatorySchedule, error) {
+	return api.observatoryScheduleRequest(ctx, http.MethodGet, zoneID, pageURL, region)
+}
+
+// CreateObservatorySchedule schedules recurring tests of a page.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) CreateObservatorySchedule(ctx context.Context, zoneID string, schedule ObservatorySchedule) (ObservatorySchedule, error) {
+	if schedule.URL == "" {
+		return ObservatorySchedule{}, errors.Errorf("page URL cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/speed_api/schedule/%s", zoneID, url.PathEscape(schedule.URL))
+	v := url.Values{}
+	if schedule.Region != "" {
+		v.Set("region", schedule.Region)
+	}
+	if schedule.Frequency != "" {
+		v.Set("frequency", schedule.Frequency)
+	}
+	if len(v) > 0 {
+		uri += "?" + v.Encode()
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return ObservatorySchedule{}, err
+	}
+
+	// The schedule is returned alongside the test run it triggered.
+	var r struct {
+		Response
+		Result struct {
+			Schedule ObservatorySchedule `json:"schedule"`
+		} `json:"result"`
+	}
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ObservatorySchedule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Schedule, nil
+}
+
+// DeleteObservatorySchedule stops the recurring tests of a page.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) DeleteObservatorySchedule(ctx context.Context, zoneID, pageURL, region string) error {
+	_, err := api.observatoryScheduleRequest(ctx, http.MethodDelete, zoneID, pageURL, region)
+	return err
+}
+
+// ObservatoryAvailabilities returns the regions Observatory tests can be run
+// from and the remaining test and schedule quota of a zone.
+//
+// API reference: https://developers.cloudflare.com/speed/observatory/
+func (api *API) ObservatoryAvailabilities(ctx context.Context, zoneID string) (ObservatoryAvailabilities, error) {
+	uri := fmt.Sprintf("/zones/%s/speed_api/availabilities", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return ObservatoryAvailabilities{}, err
+	}
+
+	var r ObservatoryAvailabilitiesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ObservatoryAvailabilities{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func (api *API) observatoryPageTestRequest(ctx context.Context, method, uri string, params interface{}) (ObservatoryPageTest, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return ObservatoryPageTest{}, err
+	}
+
+	var r ObservatoryPageTestResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ObservatoryPageTest{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func (api *API) observatoryScheduleRequest(ctx context.Context, method, zoneID, pageURL, region string) (ObservatorySchedule, error) {
+	if pageURL == "" {
+		return ObservatorySchedule{}, errors.Errorf("page URL cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/speed_api/schedule/%s", zoneID, url.PathEscape(pageURL))
+	if region != "" {
+		uri += "?" + url.Values{"region": {region}}.Encode()
+	}
+
+	res, err := api.makeRequestContext(ctx, method, uri, nil)
+	if err != nil {
+		return ObservatorySchedule{}, err
+	}
+
+	var r ObservatoryScheduleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ObservatorySchedule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,226 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const observatoryTestJSON = `{
+	"id": "ae0a9a7e-4e1a-4dc4-a0c6-2b6a4d2e0a6b",
+	"date": "2023-05-01T10:00:00Z",
+	"url": "example.com",
+	"region": {"label": "Iowa, USA", "value": "us-central1"},
+	"scheduleFrequency": "DAILY",
+	"mobileReport": {"state": "COMPLETE", "deviceType": "MOBILE", "performanceScore": 82, "cls": 0.1, "fcp": 1200, "lcp": 2100, "si": 1500, "tbt": 300, "tti": 3200},
+	"desktopReport": {"state": "FAILED", "deviceType": "DESKTOP", "error": {"code": "NOT_REACHABLE", "detail": "The page could not be reached", "finalDisplayedUrl": "https://example.com/"}}
+}`
+
+func observatoryTestWant() ObservatoryPageTest {
+	date, _ := time.Parse(time.RFC3339, "2023-05-01T10:00:00Z")
+	return ObservatoryPageTest{
+		ID:                "ae0a9a7e-4e1a-4dc4-a0c6-2b6a4d2e0a6b",
+		Date:              &date,
+		URL:               "example.com",
+		Region:            ObservatoryRegion{Label: "Iowa, USA", Value: "us-central1"},
+		ScheduleFrequency: ObservatoryScheduleDaily,
+		MobileReport: ObservatoryLighthouseReport{
+			State:            "COMPLETE",
+			DeviceType:       "MOBILE",
+			PerformanceScore: 82,
+			CLS:              0.1,
+			FCP:              1200,
+			LCP:              2100,
+			SI:               1500,
+			TBT:              300,
+			TTI:              3200,
+		},
+		DesktopReport: ObservatoryLighthouseReport{
+			State:      "FAILED",
+			DeviceType: "DESKTOP",
+			Error: &ObservatoryLighthouseError{
+				Code:              "NOT_REACHABLE",
+				Detail:            "The page could not be reached",
+				FinalDisplayedURL: "https://example.com/",
+			},
+		},
+	}
+}
+
+func TestObservatoryPages(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"url": "example.com",
+					"region": {"label": "Iowa, USA", "value": "us-central1"},
+					"scheduleFrequency": "DAILY",
+					"tests": [%s]
+				}
+			]
+		}`, observatoryTestJSON)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages", handler)
+
+	want := []ObservatoryPage{{
+		URL:               "example.com",
+		Region:            ObservatoryRegion{Label: "Iowa, USA", Value: "us-central1"},
+		ScheduleFrequency: ObservatoryScheduleDaily,
+		Tests:             []ObservatoryPageTest{observatoryTestWant()},
+	}}
+
+	actual, err := client.ObservatoryPages(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestObservatoryPageTests(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "page=2&per_page=1&region=us-central1", r.URL.RawQuery)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 3}
+		}`, observatoryTestJSON)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages/example.com/tests", handler)
+
+	actual, resultInfo, err := client.ObservatoryPageTests(context.Background(), testZoneID, "example.com", ObservatoryPageTestsParams{
+		Region:            "us-central1",
+		PaginationOptions: PaginationOptions{Page: 2, PerPage: 1},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []ObservatoryPageTest{observatoryTestWant()}, actual)
+		assert.Equal(t, ResultInfo{Page: 2, PerPage: 1, Count: 1, Total: 3}, resultInfo)
+	}
+}
+
+func TestCreateObservatoryPageTest(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"region": "us-central1"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, observatoryTestJSON)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/pages/example.com/tests", handler)
+
+	actual, err := client.CreateObservatoryPageTest(context.Background(), testZoneID, "example.com", "us-central1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, observatoryTestWant(), actual)
+	}
+
+	_, err = client.CreateObservatoryPageTest(context.Background(), testZoneID, "", "us-central1")
+	assert.EqualError(t, err, "page URL cannot be empty")
+}
+
+func TestObservatorySchedule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "us-central1", r.URL.Query().Get("region"))
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodPost:
+			assert.Equal(t, "WEEKLY", r.URL.Query().Get("frequency"))
+			fmt.Fprintf(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"schedule": {"url": "example.com", "region": "us-central1", "frequency": "WEEKLY"},
+					"test": %s
+				}
+			}`, observatoryTestJSON)
+		case http.MethodGet, http.MethodDelete:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {"url": "example.com", "region": "us-central1", "frequency": "WEEKLY"}
+			}`)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/schedule/example.com", handler)
+
+	want := ObservatorySchedule{URL: "example.com", Region: "us-central1", Frequency: ObservatoryScheduleWeekly}
+
+	actual, err := client.CreateObservatorySchedule(context.Background(), testZoneID, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	actual, err = client.ObservatorySchedule(context.Background(), testZoneID, "example.com", "us-central1")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	assert.NoError(t, client.DeleteObservatorySchedule(context.Background(), testZoneID, "example.com", "us-central1"))
+}
+
+func TestObservatoryAvailabilities(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"quota": {"plan": "business", "remainingSchedules": 8, "remainingTests": 42},
+				"regions": [{"label": "Iowa, USA", "value": "us-central1"}],
+				"regionsPerPlan": {"business": [{"label": "Iowa, USA", "value": "us-central1"}]}
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/speed_api/availabilities", handler)
+
+	region := ObservatoryRegion{Label: "Iowa, USA", Value: "us-central1"}
+	want := ObservatoryAvailabilities{
+		Quota:          ObservatoryQuota{Plan: "business", RemainingSchedules: 8, RemainingTests: 42},
+		Regions:        []ObservatoryRegion{region},
+		RegionsPerPlan: map[string][]ObservatoryRegion{"business": {region}},
+	}
+
+	actual, err := client.ObservatoryAvailabilities(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -6,11 +6,20 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+// These constants represent the signature types an Origin CA certificate can
+// be requested with.
+const (
+	OriginCARequestTypeRSA     = "origin-rsa"
+	OriginCARequestTypeECC     = "origin-ecc"
+	OriginCARequestTypeKeyless = "keyless-certificate"
+)
+
 // OriginCACertificate represents a Cloudflare-issued certificate.
 //
 // API reference: https://api.cloudflare.com/#cloudflare-ca
@@ -60,6 +69,7 @@ func (c *OriginCACertificate) UnmarshalJSON(data []byte) error {
 // OriginCACertificateListOptions represents the parameters used to list Cloudflare-issued certificates.
 type OriginCACertificateListOptions struct {
 	ZoneID string
+	PaginationOptions
 }
 
 // OriginCACertificateID represents the ID of the revoked certificate from the Revoke Certificate endpoint.
@@ -124,6 +134,12 @@ func (api *API) OriginCertificates(ctx context.Context, options OriginCACertific
 	if options.ZoneID != "" {
 		v.Set("zone_id", options.ZoneID)
 	}
+	if options.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(options.PerPage))
+	}
+	if options.Page > 0 {
+		v.Set("page", strconv.Itoa(options.Page))
+	}
 	uri := fmt.Sprintf("/certificates?%s", v.Encode())
 	res, err := api.makeRequestWithAuthType(ctx, http.MethodGet, uri, nil, AuthUserService)
 
@@ -145,6 +145,58 @@ func TestOriginCA_OriginCertificates(t *testing.T) {
 	}
 }
 
+func TestOriginCA_OriginCertificatesPagination(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/certificates", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+  "success": true,
+  "errors": [],
+  "messages": [],
+  "result": [
+    {
+      "id": "0x47530d8f561faa09",
+      "hostnames": [
+        "example.com"
+      ],
+      "expires_on": "2014-01-01T05:20:00.12345Z",
+      "request_type": "origin-ecc",
+      "requested_validity": 365
+    }
+  ],
+  "result_info": {
+    "page": 2,
+    "per_page": 50,
+    "count": 1,
+    "total_count": 51
+  }
+}`)
+	})
+
+	expiresOn, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+
+	want := []OriginCACertificate{{
+		ID:              "0x47530d8f561faa09",
+		Hostnames:       []string{"example.com"},
+		ExpiresOn:       expiresOn,
+		RequestType:     OriginCARequestTypeECC,
+		RequestValidity: 365,
+	}}
+
+	certs, err := client.OriginCertificates(context.Background(), OriginCACertificateListOptions{
+		PaginationOptions: PaginationOptions{Page: 2, PerPage: 50},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, certs)
+	}
+}
+
 func TestOriginCA_OriginCertificate(t *testing.T) {
 	setup()
 	defer teardown()
@@ -238,3 +238,135 @@ func (api *API) DeletePageRule(ctx context.Context, zoneID, ruleID string) error
 	}
 	return nil
 }
+
+// Page Rule cache levels, used with PageRuleCacheLevel
+const (
+	PageRuleCacheLevelBypass          = "bypass"
+	PageRuleCacheLevelNoQueryString   = "basic"
+	PageRuleCacheLevelIgnoreQuery     = "simplified"
+	PageRuleCacheLevelStandard        = "aggressive"
+	PageRuleCacheLevelCacheEverything = "cache_everything"
+)
+
+// PageRuleForwardingURLValue is the value of a forwarding_url action.
+type PageRuleForwardingURLValue struct {
+	URL string `json:"url"`
+	// StatusCode is 301 or 302.
+	StatusCode int `json:"status_code"`
+}
+
+// PageRulePriority sets the priority of a Page Rule. Rules with a higher
+// priority take precedence over rules with a lower one.
+type PageRulePriority struct {
+	ID       string `json:"id"`
+	Priority int    `json:"priority"`
+}
+
+// PageRuleForwardingURL returns a forwarding_url action redirecting matched
+// requests to url with a 301 or 302 status code.
+func PageRuleForwardingURL(url string, statusCode int) (PageRuleAction, error) {
+	if statusCode != http.StatusMovedPermanently && statusCode != http.StatusFound {
+		return PageRuleAction{}, errors.Errorf("invalid forwarding status code %d. must be 301 or 302", statusCode)
+	}
+	return PageRuleAction{ID: "forwarding_url", Value: PageRuleForwardingURLValue{URL: url, StatusCode: statusCode}}, nil
+}
+
+// PageRuleCacheLevel returns a cache_level action. level is one of the
+// PageRuleCacheLevel constants.
+func PageRuleCacheLevel(level string) PageRuleAction {
+	return PageRuleAction{ID: "cache_level", Value: level}
+}
+
+// PageRuleEdgeCacheTTL returns an edge_cache_ttl action caching matched
+// resources at the edge for ttl seconds.
+func PageRuleEdgeCacheTTL(ttl int) PageRuleAction {
+	return PageRuleAction{ID: "edge_cache_ttl", Value: ttl}
+}
+
+// PageRuleBrowserCacheTTL returns a browser_cache_ttl action telling browsers
+// to cache matched resources for ttl seconds.
+func PageRuleBrowserCacheTTL(ttl int) PageRuleAction {
+	return PageRuleAction{ID: "browser_cache_ttl", Value: ttl}
+}
+
+// PageRuleDisableApps returns a disable_apps action.
+func PageRuleDisableApps() PageRuleAction {
+	return PageRuleAction{ID: "disable_apps"}
+}
+
+// PageRuleDisableSecurity returns a disable_security action.
+func PageRuleDisableSecurity() PageRuleAction {
+	return PageRuleAction{ID: "disable_security"}
+}
+
+// PageRuleDisablePerformance returns a disable_performance action.
+func PageRuleDisablePerformance() PageRuleAction {
+	return PageRuleAction{ID: "disable_performance"}
+}
+
+// ForwardingURL returns the value of a forwarding_url action.
+func (a PageRuleAction) ForwardingURL() (PageRuleForwardingURLValue, bool) {
+	if a.ID != "forwarding_url" {
+		return PageRuleForwardingURLValue{}, false
+	}
+
+	switch v := a.Value.(type) {
+	case PageRuleForwardingURLValue:
+		return v, true
+	case map[string]interface{}:
+		url, _ := v["url"].(string)
+		statusCode, _ := v["status_code"].(float64)
+		return PageRuleForwardingURLValue{URL: url, StatusCode: int(statusCode)}, true
+	default:
+		return PageRuleForwardingURLValue{}, false
+	}
+}
+
+// TTL returns the value in seconds of an edge_cache_ttl or browser_cache_ttl
+// action.
+func (a PageRuleAction) TTL() (int, bool) {
+	if a.ID != "edge_cache_ttl" && a.ID != "browser_cache_ttl" {
+		return 0, false
+	}
+
+	switch v := a.Value.(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	default:
+		return 0, false
+	}
+}
+
+// UpdatePageRulePriorities changes the priorities of the Page Rules of a
+// zone, returning all of its rules.
+//
+// API reference: https://api.cloudflare.com/#page-rules-for-a-zone-update-page-rule-priorities
+func (api *API) UpdatePageRulePriorities(ctx context.Context, zoneID string, priorities []PageRulePriority) ([]PageRule, error) {
+	uri := fmt.Sprintf("/zones/%s/pagerules/priorities", zoneID)
+	params := struct {
+		Priorities []PageRulePriority `json:"priorities"`
+	}{priorities}
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, params)
+	if err != nil {
+		return []PageRule{}, err
+	}
+	var r PageRulesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PageRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ReorderPageRules sets the priorities of the given Page Rules so that they
+// take precedence in the order given, the first one taking precedence over
+// all others.
+func (api *API) ReorderPageRules(ctx context.Context, zoneID string, ruleIDs []string) ([]PageRule, error) {
+	priorities := make([]PageRulePriority, 0, len(ruleIDs))
+	for i, id := range ruleIDs {
+		priorities = append(priorities, PageRulePriority{ID: id, Priority: len(ruleIDs) - i})
+	}
+	return api.UpdatePageRulePriorities(ctx, zoneID, priorities)
+}
@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 
@@ -197,3 +198,119 @@ func TestDeletePageRule(t *testing.T) {
 	err := client.DeletePageRule(context.Background(), testZoneID, pageRuleID)
 	assert.NoError(t, err)
 }
+
+func TestPageRuleActions(t *testing.T) {
+	forward, err := PageRuleForwardingURL("https://www.example.com/$1", http.StatusMovedPermanently)
+	if assert.NoError(t, err) {
+		value, ok := forward.ForwardingURL()
+		assert.True(t, ok)
+		assert.Equal(t, PageRuleForwardingURLValue{URL: "https://www.example.com/$1", StatusCode: 301}, value)
+	}
+
+	_, err = PageRuleForwardingURL("https://www.example.com/$1", http.StatusOK)
+	assert.EqualError(t, err, "invalid forwarding status code 200. must be 301 or 302")
+
+	ttl, ok := PageRuleEdgeCacheTTL(7200).TTL()
+	assert.True(t, ok)
+	assert.Equal(t, 7200, ttl)
+
+	_, ok = PageRuleCacheLevel(PageRuleCacheLevelCacheEverything).TTL()
+	assert.False(t, ok)
+}
+
+func TestCreatePageRuleWithTypedActions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/old/*"}}],
+				"actions": [
+					{"id": "forwarding_url", "value": {"url": "https://example.com/new/$1", "status_code": 302}},
+					{"id": "browser_cache_ttl", "value": 3600},
+					{"id": "disable_apps", "value": null}
+				],
+				"priority": 1,
+				"status": "active",
+				"modified_on": "0001-01-01T00:00:00Z",
+				"created_on": "0001-01-01T00:00:00Z"
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"targets": [{"target": "url", "constraint": {"operator": "matches", "value": "example.com/old/*"}}],
+				"actions": [
+					{"id": "forwarding_url", "value": {"url": "https://example.com/new/$1", "status_code": 302}},
+					{"id": "browser_cache_ttl", "value": 3600},
+					{"id": "disable_apps"}
+				],
+				"priority": 1,
+				"status": "active"
+			}
+		}`, pageRuleID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/pagerules", handler)
+
+	forward, _ := PageRuleForwardingURL("https://example.com/new/$1", http.StatusFound)
+	target := PageRuleTarget{Target: "url"}
+	target.Constraint.Operator = "matches"
+	target.Constraint.Value = "example.com/old/*"
+
+	actual, err := client.CreatePageRule(context.Background(), testZoneID, PageRule{
+		Targets:  []PageRuleTarget{target},
+		Actions:  []PageRuleAction{forward, PageRuleBrowserCacheTTL(3600), PageRuleDisableApps()},
+		Priority: 1,
+		Status:   "active",
+	})
+	if assert.NoError(t, err) {
+		value, ok := actual.Actions[0].ForwardingURL()
+		assert.True(t, ok)
+		assert.Equal(t, PageRuleForwardingURLValue{URL: "https://example.com/new/$1", StatusCode: 302}, value)
+
+		ttl, ok := actual.Actions[1].TTL()
+		assert.True(t, ok)
+		assert.Equal(t, 3600, ttl)
+	}
+}
+
+func TestReorderPageRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"priorities": [{"id": "b", "priority": 2}, {"id": "a", "priority": 1}]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "b", "targets": [], "actions": [], "priority": 2, "status": "active"},
+				{"id": "a", "targets": [], "actions": [], "priority": 1, "status": "active"}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/pagerules/priorities", handler)
+
+	actual, err := client.ReorderPageRules(context.Background(), testZoneID, []string{"b", "a"})
+	if assert.NoError(t, err) {
+		if assert.Len(t, actual, 2) {
+			assert.Equal(t, "b", actual[0].ID)
+			assert.Equal(t, 2, actual[0].Priority)
+		}
+	}
+}
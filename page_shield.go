@@ -0,0 +1,391 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PageShieldSettings are the Page Shield settings of a zone.
+type PageShieldSettings struct {
+	Enabled                        *bool      `json:"enabled,omitempty"`
+	UseCloudflareReportingEndpoint *bool      `json:"use_cloudflare_reporting_endpoint,omitempty"`
+	UseConnectionURLPath           *bool      `json:"use_connection_url_path,omitempty"`
+	UpdatedAt                      *time.Time `json:"updated_at,omitempty"`
+}
+
+// PageShieldSettingsResponse is the API response, containing the Page Shield
+// settings of a zone.
+type PageShieldSettingsResponse struct {
+	Response
+	Result PageShieldSettings `json:"result"`
+}
+
+// PageShieldScript is a script loaded by the pages of a zone.
+type PageShieldScript struct {
+	ID                        string                    `json:"id"`
+	URL                       string                    `json:"url"`
+	Host                      string                    `json:"host"`
+	AddedAt                   *time.Time                `json:"added_at"`
+	FirstSeenAt               *time.Time                `json:"first_seen_at"`
+	LastSeenAt                *time.Time                `json:"last_seen_at"`
+	PageURLs                  []string                  `json:"page_urls"`
+	URLContainsCdnCgiPath     bool                      `json:"url_contains_cdn_cgi_path"`
+	Hash                      string                    `json:"hash,omitempty"`
+	JSIntegrityScore          int                       `json:"js_integrity_score,omitempty"`
+	MalwareScore              int                       `json:"malware_score,omitempty"`
+	MageCartScore             int                       `json:"magecart_score,omitempty"`
+	ObfuscationScore          int                       `json:"obfuscation_score,omitempty"`
+	FetchedAt                 *time.Time                `json:"fetched_at,omitempty"`
+	DomainReportedMalicious   *bool                     `json:"domain_reported_malicious,omitempty"`
+	URLReportedMalicious      *bool                     `json:"url_reported_malicious,omitempty"`
+	MaliciousDomainCategories []string                  `json:"malicious_domain_categories,omitempty"`
+	MaliciousURLCategories    []string                  `json:"malicious_url_categories,omitempty"`
+	Versions                  []PageShieldScriptVersion `json:"versions,omitempty"`
+}
+
+// PageShieldScriptVersion is a version of a script seen by Page Shield.
+type PageShieldScriptVersion struct {
+	Hash             string     `json:"hash"`
+	JSIntegrityScore int        `json:"js_integrity_score"`
+	FetchedAt        *time.Time `json:"fetched_at"`
+}
+
+// PageShieldConnection is a connection made by scripts on the pages of a
+// zone.
+type PageShieldConnection struct {
+	ID                        string     `json:"id"`
+	URL                       string     `json:"url"`
+	Host                      string     `json:"host"`
+	AddedAt                   *time.Time `json:"added_at"`
+	FirstSeenAt               *time.Time `json:"first_seen_at"`
+	LastSeenAt                *time.Time `json:"last_seen_at"`
+	PageURLs                  []string   `json:"page_urls"`
+	URLContainsCdnCgiPath     bool       `json:"url_contains_cdn_cgi_path"`
+	DomainReportedMalicious   *bool      `json:"domain_reported_malicious,omitempty"`
+	URLReportedMalicious      *bool      `json:"url_reported_malicious,omitempty"`
+	MaliciousDomainCategories []string   `json:"malicious_domain_categories,omitempty"`
+	MaliciousURLCategories    []string   `json:"malicious_url_categories,omitempty"`
+}
+
+// PageShieldListParams holds the filters used when listing Page Shield
+// scripts and connections.
+type PageShieldListParams struct {
+	// URLs and Hosts restrict the results to those matching any of the
+	// given values, which may contain "*" wildcards.
+	URLs        []string
+	Hosts       []string
+	ExcludeURLs []string
+	// Status is a comma separated list of "active", "infrequent" and
+	// "inactive". Defaults to "active".
+	Status string
+	// OrderBy is "first_seen_at" or "last_seen_at" and Direction is "asc"
+	// or "desc".
+	OrderBy             string
+	Direction           string
+	PrioritizeMalicious *bool
+	ExcludeCdnCgi       *bool
+	PaginationOptions
+}
+
+// Encode encodes the Page Shield list parameters into a query string.
+func (p PageShieldListParams) Encode() string {
+	v := url.Values{}
+
+	if len(p.URLs) > 0 {
+		v.Set("urls", strings.Join(p.URLs, ","))
+	}
+	if len(p.Hosts) > 0 {
+		v.Set("hosts", strings.Join(p.Hosts, ","))
+	}
+	if len(p.ExcludeURLs) > 0 {
+		v.Set("exclude_urls", strings.Join(p.ExcludeURLs, ","))
+	}
+	if p.Status != "" {
+		v.Set("status", p.Status)
+	}
+	if p.OrderBy != "" {
+		v.Set("order_by", p.OrderBy)
+	}
+	if p.Direction != "" {
+		v.Set("direction", p.Direction)
+	}
+	if p.PrioritizeMalicious != nil {
+		v.Set("prioritize_malicious", strconv.FormatBool(*p.PrioritizeMalicious))
+	}
+	if p.ExcludeCdnCgi != nil {
+		v.Set("exclude_cdn_cgi", strconv.FormatBool(*p.ExcludeCdnCgi))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// PageShieldScriptsResponse is the API response, containing a page of
+// Page Shield scripts.
+type PageShieldScriptsResponse struct {
+	Response
+	Result     []PageShieldScript `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// PageShieldScriptResponse is the API response, containing a single Page
+// Shield script and its versions.
+type PageShieldScriptResponse struct {
+	Response
+	Result PageShieldScript `json:"result"`
+}
+
+// PageShieldConnectionsResponse is the API response, containing a page of
+// Page Shield connections.
+type PageShieldConnectionsResponse struct {
+	Response
+	Result     []PageShieldConnection `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// PageShieldConnectionResponse is the API response, containing a single
+// Page Shield connection.
+type PageShieldConnectionResponse struct {
+	Response
+	Result PageShieldConnection `json:"result"`
+}
+
+// PageShieldPolicy is a Content Security Policy deployed by Page Shield.
+type PageShieldPolicy struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description"`
+	// Action is "allow" to enforce the policy or "log" to only report
+	// violations.
+	Action     string `json:"action"`
+	Enabled    *bool  `json:"enabled,omitempty"`
+	Expression string `json:"expression"`
+	// Value is the policy directives, e.g. "script-src 'self'".
+	Value string `json:"value"`
+}
+
+// PageShieldPolicyResponse is the API response, containing a single Page
+// Shield policy.
+type PageShieldPolicyResponse struct {
+	Response
+	Result PageShieldPolicy `json:"result"`
+}
+
+// PageShieldPoliciesResponse is the API response, containing the Page
+// Shield policies of a zone.
+type PageShieldPoliciesResponse struct {
+	Response
+	Result []PageShieldPolicy `json:"result"`
+}
+
+// PageShieldSettings returns the Page Shield settings of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-get-page-shield-settings
+func (api *API) PageShieldSettings(ctx context.Context, zoneID string) (PageShieldSettings, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return PageShieldSettings{}, err
+	}
+
+	var r PageShieldSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdatePageShieldSettings updates the Page Shield settings of a zone. Nil
+// settings are left unchanged.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-update-page-shield-settings
+func (api *API) UpdatePageShieldSettings(ctx context.Context, zoneID string, settings PageShieldSettings) (PageShieldSettings, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield", zoneID)
+	settings.UpdatedAt = nil
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, settings)
+	if err != nil {
+		return PageShieldSettings{}, err
+	}
+
+	var r PageShieldSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PageShieldScripts returns a page of the scripts seen on a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-list-page-shield-scripts
+func (api *API) PageShieldScripts(ctx context.Context, zoneID string, params PageShieldListParams) ([]PageShieldScript, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield/scripts?%s", zoneID, params.Encode())
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []PageShieldScript{}, ResultInfo{}, err
+	}
+
+	var r PageShieldScriptsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PageShieldScript{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// PageShieldScript returns a single script seen on a zone, along with its
+// versions.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-get-a-page-shield-script
+func (api *API) PageShieldScript(ctx context.Context, zoneID, scriptID string) (PageShieldScript, error) {
+	if scriptID == "" {
+		return PageShieldScript{}, errors.Errorf("script ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/page_shield/scripts/%s", zoneID, scriptID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return PageShieldScript{}, err
+	}
+
+	var r PageShieldScriptResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldScript{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PageShieldConnections returns a page of the connections seen on a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-list-page-shield-connections
+func (api *API) PageShieldConnections(ctx context.Context, zoneID string, params PageShieldListParams) ([]PageShieldConnection, ResultInfo, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield/connections?%s", zoneID, params.Encode())
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []PageShieldConnection{}, ResultInfo{}, err
+	}
+
+	var r PageShieldConnectionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PageShieldConnection{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// PageShieldConnection returns a single connection seen on a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-get-a-page-shield-connection
+func (api *API) PageShieldConnection(ctx context.Context, zoneID, connectionID string) (PageShieldConnection, error) {
+	if connectionID == "" {
+		return PageShieldConnection{}, errors.Errorf("connection ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/page_shield/connections/%s", zoneID, connectionID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return PageShieldConnection{}, err
+	}
+
+	var r PageShieldConnectionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldConnection{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PageShieldPolicies returns the Page Shield policies of a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-list-page-shield-policies
+func (api *API) PageShieldPolicies(ctx context.Context, zoneID string) ([]PageShieldPolicy, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield/policies", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPoliciesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PageShieldPolicy{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PageShieldPolicy returns a single Page Shield policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-get-a-page-shield-policy
+func (api *API) PageShieldPolicy(ctx context.Context, zoneID, policyID string) (PageShieldPolicy, error) {
+	if policyID == "" {
+		return PageShieldPolicy{}, errors.Errorf("policy ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/page_shield/policies/%s", zoneID, policyID)
+	return api.pageShieldPolicyRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreatePageShieldPolicy creates a Page Shield policy for a zone.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-create-a-page-shield-policy
+func (api *API) CreatePageShieldPolicy(ctx context.Context, zoneID string, policy PageShieldPolicy) (PageShieldPolicy, error) {
+	uri := fmt.Sprintf("/zones/%s/page_shield/policies", zoneID)
+	return api.pageShieldPolicyRequest(ctx, http.MethodPost, uri, policy)
+}
+
+// UpdatePageShieldPolicy replaces a Page Shield policy. policy.ID must be
+// set.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-update-a-page-shield-policy
+func (api *API) UpdatePageShieldPolicy(ctx context.Context, zoneID string, policy PageShieldPolicy) (PageShieldPolicy, error) {
+	if policy.ID == "" {
+		return PageShieldPolicy{}, errors.Errorf("policy ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/page_shield/policies/%s", zoneID, policy.ID)
+	return api.pageShieldPolicyRequest(ctx, http.MethodPut, uri, policy)
+}
+
+// DeletePageShieldPolicy deletes a Page Shield policy.
+//
+// API reference: https://developers.cloudflare.com/api/operations/page-shield-delete-a-page-shield-policy
+func (api *API) DeletePageShieldPolicy(ctx context.Context, zoneID, policyID string) error {
+	if policyID == "" {
+		return errors.Errorf("policy ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/zones/%s/page_shield/policies/%s", zoneID, policyID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (api *API) pageShieldPolicyRequest(ctx context.Context, method, uri string, params interface{}) (PageShieldPolicy, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return PageShieldPolicy{}, err
+	}
+
+	var r PageShieldPolicyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PageShieldPolicy{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
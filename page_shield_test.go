@@ -0,0 +1,297 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testPageShieldTimestamp, _ = time.Parse(time.RFC3339, "2023-06-01T00:00:00Z")
+
+func TestPageShieldSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"enabled": true,
+				"use_cloudflare_reporting_endpoint": true,
+				"use_connection_url_path": false,
+				"updated_at": "2023-06-01T00:00:00Z"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield", handler)
+
+	enabled, disabled := true, false
+	want := PageShieldSettings{
+		Enabled:                        &enabled,
+		UseCloudflareReportingEndpoint: &enabled,
+		UseConnectionURLPath:           &disabled,
+		UpdatedAt:                      &testPageShieldTimestamp,
+	}
+
+	actual, err := client.PageShieldSettings(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdatePageShieldSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"enabled":false}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"enabled": false,
+				"use_cloudflare_reporting_endpoint": true,
+				"use_connection_url_path": false,
+				"updated_at": "2023-06-01T00:00:00Z"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield", handler)
+
+	disabled := false
+	actual, err := client.UpdatePageShieldSettings(context.Background(), testZoneID, PageShieldSettings{
+		Enabled:   &disabled,
+		UpdatedAt: &testPageShieldTimestamp,
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, &disabled, actual.Enabled)
+	}
+}
+
+func TestPageShieldScripts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "cdn.example.com,*.example.net", r.URL.Query().Get("hosts"))
+		assert.Equal(t, "active,infrequent", r.URL.Query().Get("status"))
+		assert.Equal(t, "true", r.URL.Query().Get("prioritize_malicious"))
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": [
+				{
+					"id": "c9ef84a6bf5e47138c75d95e2f933e8f",
+					"url": "https://cdn.example.com/app.js",
+					"host": "cdn.example.com",
+					"added_at": "2023-06-01T00:00:00Z",
+					"first_seen_at": "2023-06-01T00:00:00Z",
+					"last_seen_at": "2023-06-01T00:00:00Z",
+					"page_urls": ["example.com/"],
+					"url_contains_cdn_cgi_path": false,
+					"hash": "9245aad577e846dd9b990b1b32425a3fae4aad8b8a28441a8b80084b6bb75a45",
+					"js_integrity_score": 93
+				}
+			],
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result_info": {"page": 2, "per_page": 15, "count": 1, "total_count": 16, "total_pages": 2}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/scripts", handler)
+
+	want := []PageShieldScript{{
+		ID:               "c9ef84a6bf5e47138c75d95e2f933e8f",
+		URL:              "https://cdn.example.com/app.js",
+		Host:             "cdn.example.com",
+		AddedAt:          &testPageShieldTimestamp,
+		FirstSeenAt:      &testPageShieldTimestamp,
+		LastSeenAt:       &testPageShieldTimestamp,
+		PageURLs:         []string{"example.com/"},
+		Hash:             "9245aad577e846dd9b990b1b32425a3fae4aad8b8a28441a8b80084b6bb75a45",
+		JSIntegrityScore: 93,
+	}}
+
+	prioritizeMalicious := true
+	actual, resultInfo, err := client.PageShieldScripts(context.Background(), testZoneID, PageShieldListParams{
+		Hosts:               []string{"cdn.example.com", "*.example.net"},
+		Status:              "active,infrequent",
+		PrioritizeMalicious: &prioritizeMalicious,
+		PaginationOptions:   PaginationOptions{Page: 2},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+		assert.Equal(t, 2, resultInfo.TotalPages)
+	}
+}
+
+func TestPageShieldConnection(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"id": "c9ef84a6bf5e47138c75d95e2f933e8f",
+				"url": "https://analytics.example.net/collect",
+				"host": "analytics.example.net",
+				"added_at": "2023-06-01T00:00:00Z",
+				"first_seen_at": "2023-06-01T00:00:00Z",
+				"last_seen_at": "2023-06-01T00:00:00Z",
+				"page_urls": ["example.com/checkout"],
+				"url_contains_cdn_cgi_path": false,
+				"domain_reported_malicious": false
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/connections/c9ef84a6bf5e47138c75d95e2f933e8f", handler)
+
+	reportedMalicious := false
+	want := PageShieldConnection{
+		ID:                      "c9ef84a6bf5e47138c75d95e2f933e8f",
+		URL:                     "https://analytics.example.net/collect",
+		Host:                    "analytics.example.net",
+		AddedAt:                 &testPageShieldTimestamp,
+		FirstSeenAt:             &testPageShieldTimestamp,
+		LastSeenAt:              &testPageShieldTimestamp,
+		PageURLs:                []string{"example.com/checkout"},
+		DomainReportedMalicious: &reportedMalicious,
+	}
+
+	actual, err := client.PageShieldConnection(context.Background(), testZoneID, "c9ef84a6bf5e47138c75d95e2f933e8f")
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.PageShieldConnection(context.Background(), testZoneID, "")
+	assert.EqualError(t, err, "connection ID cannot be empty")
+}
+
+func TestCreatePageShieldPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "Checkout page",
+				"action": "allow",
+				"enabled": true,
+				"expression": "ends_with(http.request.uri.path, \"/checkout\")",
+				"value": "script-src 'self'"
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"id": "c9ef84a6bf5e47138c75d95e2f933e8f",
+				"description": "Checkout page",
+				"action": "allow",
+				"enabled": true,
+				"expression": "ends_with(http.request.uri.path, \"/checkout\")",
+				"value": "script-src 'self'"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/policies", handler)
+
+	enabled := true
+	policy := PageShieldPolicy{
+		Description: "Checkout page",
+		Action:      "allow",
+		Enabled:     &enabled,
+		Expression:  `ends_with(http.request.uri.path, "/checkout")`,
+		Value:       "script-src 'self'",
+	}
+
+	actual, err := client.CreatePageShieldPolicy(context.Background(), testZoneID, policy)
+	if assert.NoError(t, err) {
+		policy.ID = "c9ef84a6bf5e47138c75d95e2f933e8f"
+		assert.Equal(t, policy, actual)
+	}
+}
+
+func TestUpdatePageShieldPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"result": {
+				"id": "c9ef84a6bf5e47138c75d95e2f933e8f",
+				"description": "Checkout page",
+				"action": "log",
+				"enabled": true,
+				"expression": "true",
+				"value": "script-src 'self'"
+			},
+			"success": true,
+			"errors": [],
+			"messages": []
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/policies/c9ef84a6bf5e47138c75d95e2f933e8f", handler)
+
+	actual, err := client.UpdatePageShieldPolicy(context.Background(), testZoneID, PageShieldPolicy{
+		ID:         "c9ef84a6bf5e47138c75d95e2f933e8f",
+		Action:     "log",
+		Expression: "true",
+		Value:      "script-src 'self'",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "log", actual.Action)
+	}
+
+	_, err = client.UpdatePageShieldPolicy(context.Background(), testZoneID, PageShieldPolicy{})
+	assert.EqualError(t, err, "policy ID cannot be empty")
+}
+
+func TestDeletePageShieldPolicy(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.WriteHeader(http.StatusNoContent)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/page_shield/policies/c9ef84a6bf5e47138c75d95e2f933e8f", handler)
+
+	err := client.DeletePageShieldPolicy(context.Background(), testZoneID, "c9ef84a6bf5e47138c75d95e2f933e8f")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,283 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Pages deployment environments.
+const (
+	PagesEnvironmentPreview    = "preview"
+	PagesEnvironmentProduction = "production"
+)
+
+// PagesProjectDeployment is a deployment of a Pages project.
+type PagesProjectDeployment struct {
+	ID                string                                `json:"id"`
+	ShortID           string                                `json:"short_id"`
+	ProjectID         string                                `json:"project_id"`
+	ProjectName       string                                `json:"project_name"`
+	Environment       string                                `json:"environment"`
+	URL               string                                `json:"url"`
+	CreatedOn         *time.Time                            `json:"created_on"`
+	ModifiedOn        *time.Time                            `json:"modified_on"`
+	Aliases           []string                              `json:"aliases,omitempty"`
+	LatestStage       PagesProjectDeploymentStage           `json:"latest_stage"`
+	EnvVars           map[string]*PagesProjectDeploymentVar `json:"env_vars"`
+	DeploymentTrigger PagesProjectDeploymentTrigger         `json:"deployment_trigger"`
+	Stages            []PagesProjectDeploymentStage         `json:"stages"`
+	BuildConfig       PagesProjectBuildConfig               `json:"build_config"`
+	Source            *PagesProjectSource                   `json:"source,omitempty"`
+	IsSkipped         bool                                  `json:"is_skipped"`
+	ProductionBranch  string                                `json:"production_branch,omitempty"`
+}
+
+// PagesProjectDeploymentStage is a stage of a deployment, such as its
+// build or deploy step.
+type PagesProjectDeploymentStage struct {
+	Name      string     `json:"name"`
+	StartedOn *time.Time `json:"started_on,omitempty"`
+	EndedOn   *time.Time `json:"ended_on,omitempty"`
+	Status    string     `json:"status"`
+}
+
+// PagesProjectDeploymentTrigger is what caused a deployment.
+type PagesProjectDeploymentTrigger struct {
+	Type     string                                 `json:"type"`
+	Metadata *PagesProjectDeploymentTriggerMetadata `json:"metadata"`
+}
+
+// PagesProjectDeploymentTriggerMetadata is the commit a deployment was
+// triggered by.
+type PagesProjectDeploymentTriggerMetadata struct {
+	Branch        string `json:"branch"`
+	CommitHash    string `json:"commit_hash"`
+	CommitMessage string `json:"commit_message"`
+}
+
+// PagesDeploymentListParams filters the deployments of a project.
+type PagesDeploymentListParams struct {
+	Environment string
+	PaginationOptions
+}
+
+// Encode encodes the params as a URL query string.
+func (p PagesDeploymentListParams) Encode() string {
+	v := url.Values{}
+	if p.Environment != "" {
+		v.Set("env", p.Environment)
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+	return v.Encode()
+}
+
+// PagesDeploymentLogs are the build logs of a deployment.
+type PagesDeploymentLogs struct {
+	Total                 int                       `json:"total"`
+	IncludesContainerLogs bool                      `json:"includes_container_logs"`
+	Data                  []PagesDeploymentLogEntry `json:"data"`
+}
+
+// PagesDeploymentLogEntry is a single line of the build logs of a
+// deployment.
+type PagesDeploymentLogEntry struct {
+	Timestamp *time.Time `json:"ts"`
+	Line      string     `json:"line"`
+}
+
+// PagesDeploymentResponse is the API response containing a deployment.
+type PagesDeploymentResponse struct {
+	Response
+	Result PagesProjectDeployment `json:"result"`
+}
+
+// PagesDeploymentsResponse is the API response containing a list of
+// deployments.
+type PagesDeploymentsResponse struct {
+	Response
+	ResultInfo `json:"result_info"`
+	Result     []PagesProjectDeployment `json:"result"`
+}
+
+// PagesDeploymentLogsResponse is the API response containing the logs of a
+// deployment.
+type PagesDeploymentLogsResponse struct {
+	Response
+	Result PagesDeploymentLogs `json:"result"`
+}
+
+// ListPagesDeployments returns the deployments of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployments
+func (api *API) ListPagesDeployments(ctx context.Context, accountID, projectName string, params PagesDeploymentListParams) ([]PagesProjectDeployment, ResultInfo, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return []PagesProjectDeployment{}, ResultInfo{}, err
+	}
+
+	uri += "/deployments"
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []PagesProjectDeployment{}, ResultInfo{}, err
+	}
+
+	var r PagesDeploymentsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PagesProjectDeployment{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// PagesDeployment returns a single deployment of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployment-info
+func (api *API) PagesDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesProjectDeployment, error) {
+	uri, err := pagesDeploymentURI(accountID, projectName, deploymentID)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+	return api.pagesDeploymentRequest(ctx, http.MethodGet, uri, nil, nil)
+}
+
+// CreatePagesDeployment starts a new deployment of a Git connected Pages
+// project from the head of branch, or of the production branch if branch
+// is empty.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-create-deployment
+func (api *API) CreatePagesDeployment(ctx context.Context, accountID, projectName, branch string) (PagesProjectDeployment, error) {
+	fields := map[string]string{}
+	if branch != "" {
+		fields["branch"] = branch
+	}
+	return api.createPagesDeployment(ctx, accountID, projectName, fields)
+}
+
+// RetryPagesDeployment retries a failed deployment.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-retry-deployment
+func (api *API) RetryPagesDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesProjectDeployment, error) {
+	uri, err := pagesDeploymentURI(accountID, projectName, deploymentID)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+	return api.pagesDeploymentRequest(ctx, http.MethodPost, uri+"/retry", nil, nil)
+}
+
+// RollbackPagesDeployment makes a previous successful production
+// deployment the live one again.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-rollback-deployment
+func (api *API) RollbackPagesDeployment(ctx context.Context, accountID, projectName, deploymentID string) (PagesProjectDeployment, error) {
+	uri, err := pagesDeploymentURI(accountID, projectName, deploymentID)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+	return api.pagesDeploymentRequest(ctx, http.MethodPost, uri+"/rollback", nil, nil)
+}
+
+// DeletePagesDeployment deletes a deployment. Deployments that have
+// aliases, such as the latest deployment of a branch, can only be deleted
+// with force.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-delete-deployment
+func (api *API) DeletePagesDeployment(ctx context.Context, accountID, projectName, deploymentID string, force bool) error {
+	uri, err := pagesDeploymentURI(accountID, projectName, deploymentID)
+	if err != nil {
+		return err
+	}
+	if force {
+		uri += "?force=true"
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// PagesDeploymentLogs returns the build logs of a deployment.
+//
+// API reference: https://api.cloudflare.com/#pages-deployment-get-deployment-logs
+func (api *API) PagesDeploymentLogs(ctx context.Context, accountID, projectName, deploymentID string) (PagesDeploymentLogs, error) {
+	uri, err := pagesDeploymentURI(accountID, projectName, deploymentID)
+	if err != nil {
+		return PagesDeploymentLogs{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri+"/history/logs", nil)
+	if err != nil {
+		return PagesDeploymentLogs{}, err
+	}
+
+	var r PagesDeploymentLogsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDeploymentLogs{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// createPagesDeployment posts the multipart form creating a deployment.
+func (api *API) createPagesDeployment(ctx context.Context, accountID, projectName string, fields map[string]string) (PagesProjectDeployment, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+	for name, value := range fields {
+		if err := mpw.WriteField(name, value); err != nil {
+			return PagesProjectDeployment{}, err
+		}
+	}
+	if err := mpw.Close(); err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	headers := make(http.Header)
+	headers.Set("Content-Type", mpw.FormDataContentType())
+	return api.pagesDeploymentRequest(ctx, http.MethodPost, uri+"/deployments", buf.Bytes(), headers)
+}
+
+func (api *API) pagesDeploymentRequest(ctx context.Context, method, uri string, params interface{}, headers http.Header) (PagesProjectDeployment, error) {
+	res, err := api.makeRequestContextWithHeaders(ctx, method, uri, params, headers)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	var r PagesDeploymentResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesProjectDeployment{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func pagesDeploymentURI(accountID, projectName, deploymentID string) (string, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return "", err
+	}
+	if deploymentID == "" {
+		return "", errors.New("deployment ID cannot be empty")
+	}
+	return fmt.Sprintf("%s/deployments/%s", uri, deploymentID), nil
+}
@@ -0,0 +1,226 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPagesDeploymentJSON = `{
+	"id": "0012e50b-fa5d-44db-8cb5-1f372785dcbe",
+	"short_id": "0012e50b",
+	"project_id": "7b162ea7-7367-4d67-bcde-1160995d5",
+	"project_name": "example",
+	"environment": "preview",
+	"url": "https://0012e50b.example.pages.dev",
+	"created_on": "2022-08-15T18:00:00Z",
+	"modified_on": "2022-08-15T18:01:00Z",
+	"aliases": ["https://feature.example.pages.dev"],
+	"latest_stage": {"name": "deploy", "started_on": "2022-08-15T18:00:30Z", "ended_on": "2022-08-15T18:01:00Z", "status": "success"},
+	"env_vars": {"BUILD_VERSION": {"value": "3.3"}},
+	"deployment_trigger": {
+		"type": "ad_hoc",
+		"metadata": {"branch": "feature", "commit_hash": "c7649364c4cb32ad4f65b530b9424e8be5bec9d6", "commit_message": "Update index.html"}
+	},
+	"stages": [
+		{"name": "queued", "status": "success"},
+		{"name": "deploy", "status": "success"}
+	],
+	"build_config": {"build_command": "npm run build", "destination_dir": "build", "root_dir": "/"},
+	"is_skipped": false,
+	"production_branch": "main"
+}`
+
+func expectedPagesDeployment() PagesProjectDeployment {
+	createdOn, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+	modifiedOn, _ := time.Parse(time.RFC3339, "2022-08-15T18:01:00Z")
+	startedOn, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:30Z")
+
+	return PagesProjectDeployment{
+		ID:          "0012e50b-fa5d-44db-8cb5-1f372785dcbe",
+		ShortID:     "0012e50b",
+		ProjectID:   "7b162ea7-7367-4d67-bcde-1160995d5",
+		ProjectName: "example",
+		Environment: PagesEnvironmentPreview,
+		URL:         "https://0012e50b.example.pages.dev",
+		CreatedOn:   &createdOn,
+		ModifiedOn:  &modifiedOn,
+		Aliases:     []string{"https://feature.example.pages.dev"},
+		LatestStage: PagesProjectDeploymentStage{Name: "deploy", StartedOn: &startedOn, EndedOn: &modifiedOn, Status: "success"},
+		EnvVars:     map[string]*PagesProjectDeploymentVar{"BUILD_VERSION": {Value: "3.3"}},
+		DeploymentTrigger: PagesProjectDeploymentTrigger{
+			Type: "ad_hoc",
+			Metadata: &PagesProjectDeploymentTriggerMetadata{
+				Branch:        "feature",
+				CommitHash:    "c7649364c4cb32ad4f65b530b9424e8be5bec9d6",
+				CommitMessage: "Update index.html",
+			},
+		},
+		Stages: []PagesProjectDeploymentStage{
+			{Name: "queued", Status: "success"},
+			{Name: "deploy", Status: "success"},
+		},
+		BuildConfig:      PagesProjectBuildConfig{BuildCommand: "npm run build", DestinationDir: "build", RootDir: "/"},
+		ProductionBranch: "main",
+	}
+}
+
+func TestListPagesDeployments(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "preview", r.URL.Query().Get("env"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {"page": 1, "per_page": 25, "count": 1, "total_count": 1}
+		}`, testPagesDeploymentJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments", handler)
+
+	actual, _, err := client.ListPagesDeployments(context.Background(), testAccountID, "example", PagesDeploymentListParams{Environment: PagesEnvironmentPreview})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []PagesProjectDeployment{expectedPagesDeployment()}, actual)
+	}
+}
+
+func TestPagesDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesDeploymentJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments/0012e50b-fa5d-44db-8cb5-1f372785dcbe", handler)
+
+	actual, err := client.PagesDeployment(context.Background(), testAccountID, "example", "0012e50b-fa5d-44db-8cb5-1f372785dcbe")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedPagesDeployment(), actual)
+	}
+
+	_, err = client.PagesDeployment(context.Background(), testAccountID, "example", "")
+	assert.EqualError(t, err, "deployment ID cannot be empty")
+}
+
+func TestCreatePagesDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		if assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			assert.Equal(t, "feature", r.FormValue("branch"))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesDeploymentJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments", handler)
+
+	actual, err := client.CreatePagesDeployment(context.Background(), testAccountID, "example", "feature")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0012e50b-fa5d-44db-8cb5-1f372785dcbe", actual.ID)
+	}
+}
+
+func TestRetryPagesDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesDeploymentJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments/0012e50b-fa5d-44db-8cb5-1f372785dcbe/retry", handler)
+
+	_, err := client.RetryPagesDeployment(context.Background(), testAccountID, "example", "0012e50b-fa5d-44db-8cb5-1f372785dcbe")
+	assert.NoError(t, err)
+}
+
+func TestRollbackPagesDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesDeploymentJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments/0012e50b-fa5d-44db-8cb5-1f372785dcbe/rollback", handler)
+
+	_, err := client.RollbackPagesDeployment(context.Background(), testAccountID, "example", "0012e50b-fa5d-44db-8cb5-1f372785dcbe")
+	assert.NoError(t, err)
+}
+
+func TestDeletePagesDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("force"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments/0012e50b-fa5d-44db-8cb5-1f372785dcbe", handler)
+
+	err := client.DeletePagesDeployment(context.Background(), testAccountID, "example", "0012e50b-fa5d-44db-8cb5-1f372785dcbe", true)
+	assert.NoError(t, err)
+}
+
+func TestPagesDeploymentLogs(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"total": 2,
+				"includes_container_logs": true,
+				"data": [
+					{"ts": "2022-08-15T18:00:31Z", "line": "Cloning repository..."},
+					{"ts": "2022-08-15T18:00:45Z", "line": "Success: Finished cloning repository files"}
+				]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments/0012e50b-fa5d-44db-8cb5-1f372785dcbe/history/logs", handler)
+
+	first, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:31Z")
+	second, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:45Z")
+
+	actual, err := client.PagesDeploymentLogs(context.Background(), testAccountID, "example", "0012e50b-fa5d-44db-8cb5-1f372785dcbe")
+	if assert.NoError(t, err) {
+		assert.Equal(t, PagesDeploymentLogs{
+			Total:                 2,
+			IncludesContainerLogs: true,
+			Data: []PagesDeploymentLogEntry{
+				{Timestamp: &first, Line: "Cloning repository..."},
+				{Timestamp: &second, Line: "Success: Finished cloning repository files"},
+			},
+		}, actual)
+	}
+}
@@ -0,0 +1,247 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Limits of a single asset upload request.
+const (
+	pagesAssetMaxBucketSize  = 40 << 20
+	pagesAssetMaxBucketFiles = 2000
+	pagesAssetMaxFileSize    = 25 << 20
+)
+
+// PagesAssetFile is a static file of a direct upload deployment.
+type PagesAssetFile struct {
+	// Path is where the file is served from, relative to the root of the
+	// deployment.
+	Path    string
+	Content []byte
+	// ContentType defaults to the type matching the extension of Path.
+	ContentType string
+}
+
+// PagesDirectUploadParams are the files and branch of a direct upload
+// deployment.
+type PagesDirectUploadParams struct {
+	// Branch determines the environment of the deployment; the production
+	// branch of the project deploys to production.
+	Branch string
+	Files  []PagesAssetFile
+}
+
+type pagesAssetUpload struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Metadata struct {
+		ContentType string `json:"contentType"`
+	} `json:"metadata"`
+	Base64 bool `json:"base64"`
+}
+
+// Hash returns the content address of the file used in deployment
+// manifests. Files with the same content and extension share a hash, so
+// they are only uploaded once.
+func (f PagesAssetFile) Hash() string {
+	h := sha256.New()
+	h.Write([]byte(base64.StdEncoding.EncodeToString(f.Content)))
+	h.Write([]byte(strings.TrimPrefix(path.Ext(f.manifestPath()), ".")))
+	return hex.EncodeToString(h.Sum(nil))[:32]
+}
+
+func (f PagesAssetFile) manifestPath() string {
+	return "/" + strings.TrimPrefix(filepath.ToSlash(f.Path), "/")
+}
+
+func (f PagesAssetFile) contentType() string {
+	if f.ContentType != "" {
+		return f.ContentType
+	}
+	if t := mime.TypeByExtension(path.Ext(f.manifestPath())); t != "" {
+		return t
+	}
+	return "application/octet-stream"
+}
+
+// PagesAssetFilesFromDir reads the files below dir, with their paths
+// relative to it, for use in a direct upload deployment.
+func PagesAssetFilesFromDir(dir string) ([]PagesAssetFile, error) {
+	var files []PagesAssetFile
+	err := filepath.Walk(dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		content, err := ioutil.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		files = append(files, PagesAssetFile{Path: rel, Content: content})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// CreatePagesDirectUploadDeployment deploys static files to a Pages
+// project without Git integration.
+//
+// The files are hashed into a manifest, only the files the project does
+// not already have are uploaded, in chunks, and the deployment is then
+// created from the manifest.
+//
+// API reference: https://developers.cloudflare.com/pages/platform/direct-upload/
+func (api *API) CreatePagesDirectUploadDeployment(ctx context.Context, accountID, projectName string, params PagesDirectUploadParams) (PagesProjectDeployment, error) {
+	if len(params.Files) == 0 {
+		return PagesProjectDeployment{}, errors.New("direct upload deployment must contain at least one file")
+	}
+
+	manifest := make(map[string]string, len(params.Files))
+	files := make(map[string]PagesAssetFile, len(params.Files))
+	for _, f := range params.Files {
+		if len(f.Content) > pagesAssetMaxFileSize {
+			return PagesProjectDeployment{}, errors.Errorf("file %s exceeds the maximum size of %d bytes", f.Path, pagesAssetMaxFileSize)
+		}
+		hash := f.Hash()
+		manifest[f.manifestPath()] = hash
+		files[hash] = f
+	}
+
+	jwt, err := api.pagesUploadToken(ctx, accountID, projectName)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	hashes := make([]string, 0, len(files))
+	for hash := range files {
+		hashes = append(hashes, hash)
+	}
+	sort.Strings(hashes)
+	missing, err := api.pagesMissingAssets(ctx, jwt, hashes)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	var bucket []pagesAssetUpload
+	bucketSize := 0
+	for _, hash := range missing {
+		f, ok := files[hash]
+		if !ok {
+			continue
+		}
+		if len(bucket) > 0 && (bucketSize+len(f.Content) > pagesAssetMaxBucketSize || len(bucket) == pagesAssetMaxBucketFiles) {
+			if err := api.uploadPagesAssets(ctx, jwt, bucket); err != nil {
+				return PagesProjectDeployment{}, err
+			}
+			bucket, bucketSize = nil, 0
+		}
+
+		upload := pagesAssetUpload{Key: hash, Value: base64.StdEncoding.EncodeToString(f.Content), Base64: true}
+		upload.Metadata.ContentType = f.contentType()
+		bucket = append(bucket, upload)
+		bucketSize += len(f.Content)
+	}
+	if len(bucket) > 0 {
+		if err := api.uploadPagesAssets(ctx, jwt, bucket); err != nil {
+			return PagesProjectDeployment{}, err
+		}
+	}
+
+	// Refresh all hashes so assets already stored are kept for this
+	// deployment.
+	if err := api.pagesAssetsRequest(ctx, jwt, "/pages/assets/upsert-hashes", map[string][]string{"hashes": hashes}, nil); err != nil {
+		return PagesProjectDeployment{}, err
+	}
+
+	manifestJSON, err := json.Marshal(manifest)
+	if err != nil {
+		return PagesProjectDeployment{}, err
+	}
+	fields := map[string]string{"manifest": string(manifestJSON)}
+	if params.Branch != "" {
+		fields["branch"] = params.Branch
+	}
+	return api.createPagesDeployment(ctx, accountID, projectName, fields)
+}
+
+// pagesUploadToken returns the JWT authorising asset uploads to a project.
+func (api *API) pagesUploadToken(ctx context.Context, accountID, projectName string) (string, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri+"/upload-token", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r struct {
+		Response
+		Result struct {
+			JWT string `json:"jwt"`
+		} `json:"result"`
+	}
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.JWT, nil
+}
+
+// pagesMissingAssets returns which of hashes have not been uploaded yet.
+func (api *API) pagesMissingAssets(ctx context.Context, jwt string, hashes []string) ([]string, error) {
+	var missing []string
+	err := api.pagesAssetsRequest(ctx, jwt, "/pages/assets/check-missing", map[string][]string{"hashes": hashes}, &missing)
+	return missing, err
+}
+
+func (api *API) uploadPagesAssets(ctx context.Context, jwt string, assets []pagesAssetUpload) error {
+	return api.pagesAssetsRequest(ctx, jwt, "/pages/assets/upload", assets, nil)
+}
+
+// pagesAssetsRequest calls the asset endpoints, which are authorised with
+// the upload token instead of the client's credentials.
+func (api *API) pagesAssetsRequest(ctx context.Context, jwt, uri string, params, result interface{}) error {
+	headers := make(http.Header)
+	headers.Set("Authorization", fmt.Sprintf("Bearer %s", jwt))
+
+	res, err := api.makeRequestWithAuthTypeAndHeaders(ctx, http.MethodPost, uri, params, 0, headers)
+	if err != nil {
+		return err
+	}
+	if result == nil {
+		return nil
+	}
+
+	r := struct {
+		Response
+		Result interface{} `json:"result"`
+	}{Result: result}
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return errors.Wrap(err, errUnmarshalError)
+	}
+	return nil
+}
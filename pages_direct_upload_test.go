@@ -0,0 +1,108 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPagesAssetFileHash(t *testing.T) {
+	index := PagesAssetFile{Path: "index.html", Content: []byte("<h1>hello</h1>")}
+	copied := PagesAssetFile{Path: "/blog/index.html", Content: []byte("<h1>hello</h1>")}
+	text := PagesAssetFile{Path: "index.txt", Content: []byte("<h1>hello</h1>")}
+
+	assert.Len(t, index.Hash(), 32)
+	assert.Equal(t, index.Hash(), copied.Hash())
+	assert.NotEqual(t, index.Hash(), text.Hash())
+	assert.Equal(t, "text/html; charset=utf-8", index.contentType())
+	assert.Equal(t, "/blog/index.html", copied.manifestPath())
+}
+
+func TestPagesAssetFilesFromDir(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "css"), 0755))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "index.html"), []byte("<h1>hello</h1>"), 0644))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "css", "site.css"), []byte("h1{}"), 0644))
+
+	files, err := PagesAssetFilesFromDir(dir)
+	if assert.NoError(t, err) {
+		sort.Slice(files, func(i, j int) bool { return files[i].Path < files[j].Path })
+		assert.Equal(t, []PagesAssetFile{
+			{Path: filepath.Join("css", "site.css"), Content: []byte("h1{}")},
+			{Path: "index.html", Content: []byte("<h1>hello</h1>")},
+		}, files)
+	}
+}
+
+func TestCreatePagesDirectUploadDeployment(t *testing.T) {
+	setup()
+	defer teardown()
+
+	index := PagesAssetFile{Path: "index.html", Content: []byte("<h1>hello</h1>")}
+	css := PagesAssetFile{Path: "css/site.css", Content: []byte("h1{}")}
+	hashes := []string{index.Hash(), css.Hash()}
+	sort.Strings(hashes)
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/upload-token", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"jwt": "upload-jwt"}}`)
+	})
+	mux.HandleFunc("/pages/assets/check-missing", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "Bearer upload-jwt", r.Header.Get("Authorization"))
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, fmt.Sprintf(`{"hashes": ["%s", "%s"]}`, hashes[0], hashes[1]), string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": ["%s"]}`, css.Hash())
+	})
+	mux.HandleFunc("/pages/assets/upload", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "Bearer upload-jwt", r.Header.Get("Authorization"))
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, fmt.Sprintf(`[{"key": "%s", "value": "aDF7fQ==", "metadata": {"contentType": "text/css; charset=utf-8"}, "base64": true}]`, css.Hash()), string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	})
+	mux.HandleFunc("/pages/assets/upsert-hashes", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "Bearer upload-jwt", r.Header.Get("Authorization"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": true}`)
+	})
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/deployments", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		if assert.NoError(t, r.ParseMultipartForm(1<<20)) {
+			assert.Equal(t, "feature", r.FormValue("branch"))
+			var manifest map[string]string
+			if assert.NoError(t, json.Unmarshal([]byte(r.FormValue("manifest")), &manifest)) {
+				assert.Equal(t, map[string]string{"/index.html": index.Hash(), "/css/site.css": css.Hash()}, manifest)
+			}
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesDeploymentJSON)
+	})
+
+	actual, err := client.CreatePagesDirectUploadDeployment(context.Background(), testAccountID, "example", PagesDirectUploadParams{
+		Branch: "feature",
+		Files:  []PagesAssetFile{index, css},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "0012e50b-fa5d-44db-8cb5-1f372785dcbe", actual.ID)
+	}
+
+	_, err = client.CreatePagesDirectUploadDeployment(context.Background(), testAccountID, "example", PagesDirectUploadParams{})
+	assert.EqualError(t, err, "direct upload deployment must contain at least one file")
+}
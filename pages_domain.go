@@ -0,0 +1,203 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Pages custom domain statuses.
+const (
+	PagesDomainStatusInitializing = "initializing"
+	PagesDomainStatusPending      = "pending"
+	PagesDomainStatusActive       = "active"
+	PagesDomainStatusDeactivated  = "deactivated"
+	PagesDomainStatusBlocked      = "blocked"
+	PagesDomainStatusError        = "error"
+)
+
+// pagesDomainPollInterval is the delay between status checks of a custom
+// domain that is being validated.
+var pagesDomainPollInterval = 5 * time.Second
+
+// PagesDomain is a custom domain of a Pages project.
+type PagesDomain struct {
+	ID                   string                      `json:"id"`
+	Name                 string                      `json:"name"`
+	Status               string                      `json:"status"`
+	VerificationData     PagesDomainVerificationData `json:"verification_data"`
+	ValidationData       PagesDomainValidationData   `json:"validation_data"`
+	ZoneTag              string                      `json:"zone_tag"`
+	CertificateAuthority string                      `json:"certificate_authority,omitempty"`
+	CreatedOn            *time.Time                  `json:"created_on"`
+}
+
+// PagesDomainVerificationData is the status of the DNS verification of a
+// custom domain.
+type PagesDomainVerificationData struct {
+	Status       string `json:"status"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+// PagesDomainValidationData is the status of the certificate validation
+// of a custom domain, including the TXT record to create for TXT
+// validation.
+type PagesDomainValidationData struct {
+	Status       string `json:"status"`
+	Method       string `json:"method"`
+	ErrorMessage string `json:"error_message,omitempty"`
+	TXTName      string `json:"txt_name,omitempty"`
+	TXTValue     string `json:"txt_value,omitempty"`
+}
+
+// PagesDomainResponse is the API response containing a custom domain.
+type PagesDomainResponse struct {
+	Response
+	Result PagesDomain `json:"result"`
+}
+
+// PagesDomainsResponse is the API response containing the custom domains
+// of a project.
+type PagesDomainsResponse struct {
+	Response
+	Result []PagesDomain `json:"result"`
+}
+
+// PagesDomains returns the custom domains of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-domains-get-domains
+func (api *API) PagesDomains(ctx context.Context, accountID, projectName string) ([]PagesDomain, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return []PagesDomain{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri+"/domains", nil)
+	if err != nil {
+		return []PagesDomain{}, err
+	}
+
+	var r PagesDomainsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PagesDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// PagesDomain returns a single custom domain of a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-domains-get-domain
+func (api *API) PagesDomain(ctx context.Context, accountID, projectName, domainName string) (PagesDomain, error) {
+	uri, err := pagesDomainURI(accountID, projectName, domainName)
+	if err != nil {
+		return PagesDomain{}, err
+	}
+	return api.pagesDomainRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// AddPagesDomain adds a custom domain to a Pages project. The domain is
+// active once its DNS and certificate validation complete; see
+// WaitForPagesDomain.
+//
+// API reference: https://api.cloudflare.com/#pages-domains-add-domain
+func (api *API) AddPagesDomain(ctx context.Context, accountID, projectName, domainName string) (PagesDomain, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return PagesDomain{}, err
+	}
+	if domainName == "" {
+		return PagesDomain{}, errors.New("domain name cannot be empty")
+	}
+
+	params := struct {
+		Name string `json:"name"`
+	}{Name: domainName}
+	return api.pagesDomainRequest(ctx, http.MethodPost, uri+"/domains", params)
+}
+
+// PatchPagesDomain retries the validation of a custom domain whose
+// validation failed or timed out.
+//
+// API reference: https://api.cloudflare.com/#pages-domains-patch-domain
+func (api *API) PatchPagesDomain(ctx context.Context, accountID, projectName, domainName string) (PagesDomain, error) {
+	uri, err := pagesDomainURI(accountID, projectName, domainName)
+	if err != nil {
+		return PagesDomain{}, err
+	}
+	return api.pagesDomainRequest(ctx, http.MethodPatch, uri, nil)
+}
+
+// DeletePagesDomain removes a custom domain from a Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-domains-delete-domain
+func (api *API) DeletePagesDomain(ctx context.Context, accountID, projectName, domainName string) error {
+	uri, err := pagesDomainURI(accountID, projectName, domainName)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// WaitForPagesDomain polls a custom domain until it is active, returning
+// the domain. It returns an error if validation fails, the domain is
+// blocked or deactivated, or ctx is done first.
+func (api *API) WaitForPagesDomain(ctx context.Context, accountID, projectName, domainName string) (PagesDomain, error) {
+	for {
+		domain, err := api.PagesDomain(ctx, accountID, projectName, domainName)
+		if err != nil {
+			return PagesDomain{}, err
+		}
+
+		switch domain.Status {
+		case PagesDomainStatusActive:
+			return domain, nil
+		case PagesDomainStatusInitializing, PagesDomainStatusPending:
+		case PagesDomainStatusError:
+			msg := domain.ValidationData.ErrorMessage
+			if msg == "" {
+				msg = domain.VerificationData.ErrorMessage
+			}
+			return domain, errors.Errorf("validation of domain %s failed: %s", domainName, msg)
+		default:
+			return domain, errors.Errorf("domain %s is %s", domainName, domain.Status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return domain, ctx.Err()
+		case <-time.After(pagesDomainPollInterval):
+		}
+	}
+}
+
+func (api *API) pagesDomainRequest(ctx context.Context, method, uri string, params interface{}) (PagesDomain, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return PagesDomain{}, err
+	}
+
+	var r PagesDomainResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesDomain{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func pagesDomainURI(accountID, projectName, domainName string) (string, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return "", err
+	}
+	if domainName == "" {
+		return "", errors.New("domain name cannot be empty")
+	}
+	return fmt.Sprintf("%s/domains/%s", uri, domainName), nil
+}
@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPagesDomainJSON = `{
+	"id": "9a7806061c88ada191ed06f989cc3dac",
+	"name": "example.com",
+	"status": "%s",
+	"verification_data": {"status": "active"},
+	"validation_data": {"status": "%s", "method": "http", "error_message": "%s"},
+	"zone_tag": "023e105f4ecef8ad9ca31a8372d0c353",
+	"created_on": "2022-08-15T18:00:00Z"
+}`
+
+func TestPagesDomains(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [`+testPagesDomainJSON+`]}`, "active", "active", "")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains", handler)
+
+	createdOn, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+
+	actual, err := client.PagesDomains(context.Background(), testAccountID, "example")
+	if assert.NoError(t, err) {
+		assert.Equal(t, []PagesDomain{{
+			ID:               "9a7806061c88ada191ed06f989cc3dac",
+			Name:             "example.com",
+			Status:           PagesDomainStatusActive,
+			VerificationData: PagesDomainVerificationData{Status: "active"},
+			ValidationData:   PagesDomainValidationData{Status: "active", Method: "http"},
+			ZoneTag:          "023e105f4ecef8ad9ca31a8372d0c353",
+			CreatedOn:        &createdOn,
+		}}, actual)
+	}
+}
+
+func TestAddPagesDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "example.com"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": `+testPagesDomainJSON+`}`, "initializing", "initializing", "")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains", handler)
+
+	actual, err := client.AddPagesDomain(context.Background(), testAccountID, "example", "example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, PagesDomainStatusInitializing, actual.Status)
+	}
+
+	_, err = client.AddPagesDomain(context.Background(), testAccountID, "example", "")
+	assert.EqualError(t, err, "domain name cannot be empty")
+}
+
+func TestPatchPagesDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": `+testPagesDomainJSON+`}`, "pending", "pending", "")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains/example.com", handler)
+
+	actual, err := client.PatchPagesDomain(context.Background(), testAccountID, "example", "example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, PagesDomainStatusPending, actual.Status)
+	}
+}
+
+func TestDeletePagesDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains/example.com", handler)
+
+	assert.NoError(t, client.DeletePagesDomain(context.Background(), testAccountID, "example", "example.com"))
+}
+
+func TestWaitForPagesDomain(t *testing.T) {
+	setup()
+	defer teardown()
+
+	interval := pagesDomainPollInterval
+	pagesDomainPollInterval = time.Millisecond
+	defer func() { pagesDomainPollInterval = interval }()
+
+	statuses := []string{"initializing", "pending", "active"}
+	calls := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": `+testPagesDomainJSON+`}`, statuses[calls], statuses[calls], "")
+		calls++
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains/example.com", handler)
+
+	actual, err := client.WaitForPagesDomain(context.Background(), testAccountID, "example", "example.com")
+	if assert.NoError(t, err) {
+		assert.Equal(t, PagesDomainStatusActive, actual.Status)
+		assert.Equal(t, 3, calls)
+	}
+}
+
+func TestWaitForPagesDomainError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": `+testPagesDomainJSON+`}`, "error", "error", "CAA record prevents issuance")
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example/domains/example.com", handler)
+
+	_, err := client.WaitForPagesDomain(context.Background(), testAccountID, "example", "example.com")
+	assert.EqualError(t, err, "validation of domain example.com failed: CAA record prevents issuance")
+}
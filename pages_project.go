@@ -0,0 +1,217 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// PagesProject is a Cloudflare Pages project.
+type PagesProject struct {
+	Name                string                        `json:"name,omitempty"`
+	ID                  string                        `json:"id,omitempty"`
+	CreatedOn           *time.Time                    `json:"created_on,omitempty"`
+	SubDomain           string                        `json:"subdomain,omitempty"`
+	Domains             []string                      `json:"domains,omitempty"`
+	Source              *PagesProjectSource           `json:"source,omitempty"`
+	BuildConfig         PagesProjectBuildConfig       `json:"build_config"`
+	DeploymentConfigs   PagesProjectDeploymentConfigs `json:"deployment_configs"`
+	LatestDeployment    *PagesProjectDeployment       `json:"latest_deployment,omitempty"`
+	CanonicalDeployment *PagesProjectDeployment       `json:"canonical_deployment,omitempty"`
+	ProductionBranch    string                        `json:"production_branch,omitempty"`
+}
+
+// PagesProjectSource is the Git repository a project is built from.
+type PagesProjectSource struct {
+	Type   string                    `json:"type"`
+	Config *PagesProjectSourceConfig `json:"config"`
+}
+
+// PagesProjectSourceConfig configures the Git repository of a project.
+type PagesProjectSourceConfig struct {
+	Owner              string `json:"owner"`
+	RepoName           string `json:"repo_name"`
+	ProductionBranch   string `json:"production_branch"`
+	PRCommentsEnabled  bool   `json:"pr_comments_enabled"`
+	DeploymentsEnabled bool   `json:"deployments_enabled"`
+}
+
+// PagesProjectBuildConfig is the build configuration of a project.
+type PagesProjectBuildConfig struct {
+	BuildCommand      string `json:"build_command"`
+	DestinationDir    string `json:"destination_dir"`
+	RootDir           string `json:"root_dir"`
+	WebAnalyticsTag   string `json:"web_analytics_tag,omitempty"`
+	WebAnalyticsToken string `json:"web_analytics_token,omitempty"`
+}
+
+// PagesProjectDeploymentConfigs holds the per environment configuration
+// of a project.
+type PagesProjectDeploymentConfigs struct {
+	Preview    PagesProjectDeploymentConfigEnvironment `json:"preview"`
+	Production PagesProjectDeploymentConfigEnvironment `json:"production"`
+}
+
+// PagesProjectDeploymentConfigEnvironment is the configuration of the
+// preview or production environment of a project.
+//
+// When updating a project, a nil environment variable removes it.
+type PagesProjectDeploymentConfigEnvironment struct {
+	EnvVars                 map[string]*PagesProjectDeploymentVar `json:"env_vars,omitempty"`
+	CompatibilityDate       string                                `json:"compatibility_date,omitempty"`
+	CompatibilityFlags      []string                              `json:"compatibility_flags,omitempty"`
+	KvNamespaces            map[string]PagesNamespaceBinding      `json:"kv_namespaces,omitempty"`
+	DurableObjectNamespaces map[string]PagesNamespaceBinding      `json:"durable_object_namespaces,omitempty"`
+	D1Databases             map[string]PagesD1Binding             `json:"d1_databases,omitempty"`
+	R2Bindings              map[string]PagesR2Binding             `json:"r2_buckets,omitempty"`
+}
+
+// PagesProjectDeploymentVar is the value of an environment variable.
+type PagesProjectDeploymentVar struct {
+	Value string `json:"value"`
+}
+
+// PagesNamespaceBinding binds a Workers KV or Durable Object namespace to a
+// Pages Functions variable.
+type PagesNamespaceBinding struct {
+	NamespaceID string `json:"namespace_id"`
+}
+
+// PagesD1Binding binds a D1 database to a Pages Functions variable.
+type PagesD1Binding struct {
+	ID string `json:"id"`
+}
+
+// PagesR2Binding binds an R2 bucket to a Pages Functions variable.
+type PagesR2Binding struct {
+	Name string `json:"name"`
+}
+
+// PagesProjectResponse is the API response containing a Pages project.
+type PagesProjectResponse struct {
+	Response
+	Result PagesProject `json:"result"`
+}
+
+// PagesProjectsResponse is the API response containing a list of Pages
+// projects.
+type PagesProjectsResponse struct {
+	Response
+	ResultInfo `json:"result_info"`
+	Result     []PagesProject `json:"result"`
+}
+
+// ListPagesProjects returns the Pages projects of an account.
+//
+// API reference: https://api.cloudflare.com/#pages-project-get-projects
+func (api *API) ListPagesProjects(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]PagesProject, ResultInfo, error) {
+	if accountID == "" {
+		return []PagesProject{}, ResultInfo{}, errors.New(errMissingAccountID)
+	}
+
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pages/projects", accountID)
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []PagesProject{}, ResultInfo{}, err
+	}
+
+	var r PagesProjectsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []PagesProject{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// PagesProject returns a single Pages project by name.
+//
+// API reference: https://api.cloudflare.com/#pages-project-get-project
+func (api *API) PagesProject(ctx context.Context, accountID, projectName string) (PagesProject, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return PagesProject{}, err
+	}
+	return api.pagesProjectRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreatePagesProject creates a new Pages project.
+//
+// API reference: https://api.cloudflare.com/#pages-project-create-project
+func (api *API) CreatePagesProject(ctx context.Context, accountID string, project PagesProject) (PagesProject, error) {
+	if accountID == "" {
+		return PagesProject{}, errors.New(errMissingAccountID)
+	}
+	if project.Name == "" {
+		return PagesProject{}, errors.New("project name cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/pages/projects", accountID)
+	return api.pagesProjectRequest(ctx, http.MethodPost, uri, project)
+}
+
+// UpdatePagesProject updates the settings of a Pages project. Fields left
+// empty in project are not changed.
+//
+// API reference: https://api.cloudflare.com/#pages-project-update-project
+func (api *API) UpdatePagesProject(ctx context.Context, accountID, projectName string, project PagesProject) (PagesProject, error) {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return PagesProject{}, err
+	}
+	return api.pagesProjectRequest(ctx, http.MethodPatch, uri, project)
+}
+
+// DeletePagesProject deletes a Pages project and all its deployments.
+//
+// API reference: https://api.cloudflare.com/#pages-project-delete-project
+func (api *API) DeletePagesProject(ctx context.Context, accountID, projectName string) error {
+	uri, err := pagesProjectURI(accountID, projectName)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) pagesProjectRequest(ctx context.Context, method, uri string, params interface{}) (PagesProject, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return PagesProject{}, err
+	}
+
+	var r PagesProjectResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return PagesProject{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func pagesProjectURI(accountID, projectName string) (string, error) {
+	if accountID == "" {
+		return "", errors.New(errMissingAccountID)
+	}
+	if projectName == "" {
+		return "", errors.New("project name cannot be empty")
+	}
+	return fmt.Sprintf("/accounts/%s/pages/projects/%s", accountID, projectName), nil
+}
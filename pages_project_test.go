@@ -0,0 +1,215 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testPagesProjectJSON = `{
+	"name": "example",
+	"id": "7b162ea7-7367-4d67-bcde-1160995d5",
+	"subdomain": "example.pages.dev",
+	"domains": ["example.pages.dev", "example.com"],
+	"source": {
+		"type": "github",
+		"config": {
+			"owner": "cloudflare",
+			"repo_name": "example",
+			"production_branch": "main",
+			"pr_comments_enabled": true,
+			"deployments_enabled": true
+		}
+	},
+	"build_config": {
+		"build_command": "npm run build",
+		"destination_dir": "build",
+		"root_dir": "/"
+	},
+	"deployment_configs": {
+		"preview": {
+			"env_vars": {"BUILD_VERSION": {"value": "3.3"}}
+		},
+		"production": {
+			"env_vars": {"BUILD_VERSION": {"value": "3.3"}},
+			"compatibility_date": "2022-08-15",
+			"kv_namespaces": {"KV": {"namespace_id": "5eb63bbbe01eeed093cb22bb8f5acdc3"}},
+			"d1_databases": {"DB": {"id": "445e2955-951a-43f8-a35b-a4d0c8138f63"}},
+			"r2_buckets": {"BUCKET": {"name": "assets"}}
+		}
+	},
+	"production_branch": "main"
+}`
+
+var expectedPagesProject = PagesProject{
+	Name:      "example",
+	ID:        "7b162ea7-7367-4d67-bcde-1160995d5",
+	SubDomain: "example.pages.dev",
+	Domains:   []string{"example.pages.dev", "example.com"},
+	Source: &PagesProjectSource{
+		Type: "github",
+		Config: &PagesProjectSourceConfig{
+			Owner:              "cloudflare",
+			RepoName:           "example",
+			ProductionBranch:   "main",
+			PRCommentsEnabled:  true,
+			DeploymentsEnabled: true,
+		},
+	},
+	BuildConfig: PagesProjectBuildConfig{
+		BuildCommand:   "npm run build",
+		DestinationDir: "build",
+		RootDir:        "/",
+	},
+	DeploymentConfigs: PagesProjectDeploymentConfigs{
+		Preview: PagesProjectDeploymentConfigEnvironment{
+			EnvVars: map[string]*PagesProjectDeploymentVar{"BUILD_VERSION": {Value: "3.3"}},
+		},
+		Production: PagesProjectDeploymentConfigEnvironment{
+			EnvVars:           map[string]*PagesProjectDeploymentVar{"BUILD_VERSION": {Value: "3.3"}},
+			CompatibilityDate: "2022-08-15",
+			KvNamespaces:      map[string]PagesNamespaceBinding{"KV": {NamespaceID: "5eb63bbbe01eeed093cb22bb8f5acdc3"}},
+			D1Databases:       map[string]PagesD1Binding{"DB": {ID: "445e2955-951a-43f8-a35b-a4d0c8138f63"}},
+			R2Bindings:        map[string]PagesR2Binding{"BUCKET": {Name: "assets"}},
+		},
+	},
+	ProductionBranch: "main",
+}
+
+func TestListPagesProjects(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "1", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [%s],
+			"result_info": {"page": 1, "per_page": 10, "count": 1, "total_count": 1}
+		}`, testPagesProjectJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects", handler)
+
+	actual, resultInfo, err := client.ListPagesProjects(context.Background(), testAccountID, PaginationOptions{Page: 1, PerPage: 10})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []PagesProject{expectedPagesProject}, actual)
+		assert.Equal(t, 1, resultInfo.Total)
+	}
+}
+
+func TestPagesProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesProjectJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example", handler)
+
+	actual, err := client.PagesProject(context.Background(), testAccountID, "example")
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedPagesProject, actual)
+	}
+
+	_, err = client.PagesProject(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "project name cannot be empty")
+}
+
+func TestCreatePagesProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"name": "example",
+				"build_config": {"build_command": "npm run build", "destination_dir": "build", "root_dir": ""},
+				"deployment_configs": {"preview": {}, "production": {}},
+				"production_branch": "main"
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesProjectJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects", handler)
+
+	actual, err := client.CreatePagesProject(context.Background(), testAccountID, PagesProject{
+		Name:             "example",
+		BuildConfig:      PagesProjectBuildConfig{BuildCommand: "npm run build", DestinationDir: "build"},
+		ProductionBranch: "main",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedPagesProject, actual)
+	}
+
+	_, err = client.CreatePagesProject(context.Background(), testAccountID, PagesProject{})
+	assert.EqualError(t, err, "project name cannot be empty")
+}
+
+func TestUpdatePagesProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"build_config": {"build_command": "", "destination_dir": "", "root_dir": ""},
+				"deployment_configs": {
+					"preview": {},
+					"production": {"env_vars": {"BUILD_VERSION": {"value": "3.4"}, "OLD": null}}
+				}
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testPagesProjectJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example", handler)
+
+	_, err := client.UpdatePagesProject(context.Background(), testAccountID, "example", PagesProject{
+		DeploymentConfigs: PagesProjectDeploymentConfigs{
+			Production: PagesProjectDeploymentConfigEnvironment{
+				EnvVars: map[string]*PagesProjectDeploymentVar{
+					"BUILD_VERSION": {Value: "3.4"},
+					"OLD":           nil,
+				},
+			},
+		},
+	})
+	assert.NoError(t, err)
+}
+
+func TestDeletePagesProject(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": null}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/pages/projects/example", handler)
+
+	assert.NoError(t, client.DeletePagesProject(context.Background(), testAccountID, "example"))
+
+	err := client.DeletePagesProject(context.Background(), "", "example")
+	assert.EqualError(t, err, errMissingAccountID)
+}
@@ -17,13 +17,15 @@ const (
 	RulesetKindSchema  RulesetKind = "schema"
 	RulesetKindZone    RulesetKind = "zone"
 
-	RulesetPhaseDDoSL7                     RulesetPhase = "ddos_l7"
-	RulesetPhaseHTTPRequestFirewallCustom  RulesetPhase = "http_request_firewall_custom"
-	RulesetPhaseHTTPRequestFirewallManaged RulesetPhase = "http_request_firewall_managed"
-	RulesetPhaseHTTPRequestMain            RulesetPhase = "http_request_main"
-	RulesetPhaseHTTPRequestSanitize        RulesetPhase = "http_request_sanitize"
-	RulesetPhaseHTTPRequestTransform       RulesetPhase = "http_request_transform"
-	RulesetPhaseMagicTransit               RulesetPhase = "magic_transit"
+	RulesetPhaseDDoSL7                       RulesetPhase = "ddos_l7"
+	RulesetPhaseHTTPRequestFirewallCustom    RulesetPhase = "http_request_firewall_custom"
+	RulesetPhaseHTTPRequestFirewallManaged   RulesetPhase = "http_request_firewall_managed"
+	RulesetPhaseHTTPRequestLateTransform     RulesetPhase = "http_request_late_transform"
+	RulesetPhaseHTTPRequestMain              RulesetPhase = "http_request_main"
+	RulesetPhaseHTTPRequestSanitize          RulesetPhase = "http_request_sanitize"
+	RulesetPhaseHTTPRequestTransform         RulesetPhase = "http_request_transform"
+	RulesetPhaseHTTPResponseHeadersTransform RulesetPhase = "http_response_headers_transform"
+	RulesetPhaseMagicTransit                 RulesetPhase = "magic_transit"
 
 	RulesetRuleActionBlock                RulesetRuleAction = "block"
 	RulesetRuleActionChallenge            RulesetRuleAction = "challenge"
@@ -32,6 +34,7 @@ const (
 	RulesetRuleActionForceConnectionClose RulesetRuleAction = "force_connection_close"
 	RulesetRuleActionJSChallenge          RulesetRuleAction = "js_challenge"
 	RulesetRuleActionLog                  RulesetRuleAction = "log"
+	RulesetRuleActionManagedChallenge     RulesetRuleAction = "managed_challenge"
 	RulesetRuleActionRewrite              RulesetRuleAction = "rewrite"
 	RulesetRuleActionScore                RulesetRuleAction = "score"
 	RulesetRuleActionSkip                 RulesetRuleAction = "skip"
@@ -86,6 +89,40 @@ type RulesetRuleActionParameters struct {
 	URI       RulesetRuleActionParametersURI                   `json:"uri,omitempty"`
 	Headers   map[string]RulesetRuleActionParametersHTTPHeader `json:"headers,omitempty"`
 	Products  []RulesetActionParameterProduct                  `json:"products,omitempty"`
+	Overrides *RulesetRuleActionParametersOverrides            `json:"overrides,omitempty"`
+}
+
+// MarshalJSON omits the URI when it is empty, so that rules which do not
+// rewrite the URI do not send an empty "uri" object.
+func (p RulesetRuleActionParameters) MarshalJSON() ([]byte, error) {
+	type params RulesetRuleActionParameters
+	var uri *RulesetRuleActionParametersURI
+	if p.URI != (RulesetRuleActionParametersURI{}) {
+		uri = &p.URI
+	}
+	return json.Marshal(struct {
+		params
+		URI *RulesetRuleActionParametersURI `json:"uri,omitempty"`
+	}{params(p), uri})
+}
+
+// RulesetRuleActionParametersOverrides overrides the behaviour of the rules
+// of a managed ruleset executed by an "execute" rule, either for all rules
+// or for individual rules.
+type RulesetRuleActionParametersOverrides struct {
+	Enabled          *bool                                      `json:"enabled,omitempty"`
+	Action           RulesetRuleAction                          `json:"action,omitempty"`
+	SensitivityLevel string                                     `json:"sensitivity_level,omitempty"`
+	Rules            []RulesetRuleActionParametersRulesOverride `json:"rules,omitempty"`
+}
+
+// RulesetRuleActionParametersRulesOverride overrides the behaviour of a
+// single rule of a managed ruleset.
+type RulesetRuleActionParametersRulesOverride struct {
+	ID               string            `json:"id"`
+	Enabled          *bool             `json:"enabled,omitempty"`
+	Action           RulesetRuleAction `json:"action,omitempty"`
+	SensitivityLevel string            `json:"sensitivity_level,omitempty"`
 }
 
 // RulesetRuleActionParametersURI holds the URI struct for an action parameter.
@@ -95,9 +132,29 @@ type RulesetRuleActionParametersURI struct {
 	Origin bool                                `json:"origin,omitempty"`
 }
 
+// MarshalJSON omits the path and query when they are empty, so that
+// rewriting one does not send an empty rewrite of the other.
+func (u RulesetRuleActionParametersURI) MarshalJSON() ([]byte, error) {
+	type uri RulesetRuleActionParametersURI
+	var path *RulesetRuleActionParametersURIPath
+	if u.Path != (RulesetRuleActionParametersURIPath{}) {
+		path = &u.Path
+	}
+	var query *RulesetRuleActionParametersURIQuery
+	if u.Query != (RulesetRuleActionParametersURIQuery{}) {
+		query = &u.Query
+	}
+	return json.Marshal(struct {
+		uri
+		Path  *RulesetRuleActionParametersURIPath  `json:"path,omitempty"`
+		Query *RulesetRuleActionParametersURIQuery `json:"query,omitempty"`
+	}{uri(u), path, query})
+}
+
 // RulesetRuleActionParametersURIPath holds the path specific portion of a URI
 // action parameter.
 type RulesetRuleActionParametersURIPath struct {
+	Value      string `json:"value,omitempty"`
 	Expression string `json:"expression,omitempty"`
 }
 
@@ -318,3 +375,66 @@ func (api *API) updateRuleset(ctx context.Context, identifierType RouteRoot, ide
 
 	return result.Result, nil
 }
+
+// GetZoneRulesetPhase returns the entry point ruleset of a zone for the
+// given phase.
+//
+// API reference: https://developers.cloudflare.com/ruleset-engine/rulesets-api/view/#view-a-specific-version-of-a-phase-entry-point-ruleset
+func (api *API) GetZoneRulesetPhase(ctx context.Context, zoneID string, phase RulesetPhase) (Ruleset, error) {
+	return api.getRulesetPhase(ctx, ZoneRouteRoot, zoneID, phase)
+}
+
+// GetAccountRulesetPhase returns the entry point ruleset of an account for
+// the given phase.
+//
+// API reference: https://developers.cloudflare.com/ruleset-engine/rulesets-api/view/#view-a-specific-version-of-a-phase-entry-point-ruleset
+func (api *API) GetAccountRulesetPhase(ctx context.Context, accountID string, phase RulesetPhase) (Ruleset, error) {
+	return api.getRulesetPhase(ctx, AccountRouteRoot, accountID, phase)
+}
+
+func (api *API) getRulesetPhase(ctx context.Context, identifierType RouteRoot, identifier string, phase RulesetPhase) (Ruleset, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint", identifierType, identifier, phase)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	result := GetRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
+
+// UpdateZoneRulesetPhase replaces the rules of the entry point ruleset of a
+// zone for the given phase, creating the ruleset if it does not exist.
+//
+// API reference: https://developers.cloudflare.com/ruleset-engine/rulesets-api/update/
+func (api *API) UpdateZoneRulesetPhase(ctx context.Context, zoneID string, phase RulesetPhase, description string, rules []RulesetRule) (Ruleset, error) {
+	return api.updateRulesetPhase(ctx, ZoneRouteRoot, zoneID, phase, description, rules)
+}
+
+// UpdateAccountRulesetPhase replaces the rules of the entry point ruleset of
+// an account for the given phase, creating the ruleset if it does not exist.
+//
+// API reference: https://developers.cloudflare.com/ruleset-engine/rulesets-api/update/
+func (api *API) UpdateAccountRulesetPhase(ctx context.Context, accountID string, phase RulesetPhase, description string, rules []RulesetRule) (Ruleset, error) {
+	return api.updateRulesetPhase(ctx, AccountRouteRoot, accountID, phase, description, rules)
+}
+
+func (api *API) updateRulesetPhase(ctx context.Context, identifierType RouteRoot, identifier string, phase RulesetPhase, description string, rules []RulesetRule) (Ruleset, error) {
+	uri := fmt.Sprintf("/%s/%s/rulesets/phases/%s/entrypoint", identifierType, identifier, phase)
+	payload := UpdateRulesetRequest{Description: description, Rules: rules}
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, payload)
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	result := UpdateRulesetResponse{}
+	if err := json.Unmarshal(res, &result); err != nil {
+		return Ruleset{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return result.Result, nil
+}
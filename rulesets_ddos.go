@@ -0,0 +1,129 @@
+package cloudflare
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// DDoSL7ManagedRulesetID is the ID of the HTTP DDoS Attack Protection
+// managed ruleset executed from the ddos_l7 phase.
+const DDoSL7ManagedRulesetID = "4d21379b4f9f4bb088e0729962c8b3cf"
+
+// Sensitivity levels of the HTTP DDoS Attack Protection rules, from most to
+// least sensitive. "eoff" essentially turns a rule off.
+const (
+	DDoSSensitivityHigh   = "default"
+	DDoSSensitivityMedium = "medium"
+	DDoSSensitivityLow    = "low"
+	DDoSSensitivityOff    = "eoff"
+)
+
+var (
+	validDDoSL7Actions = []string{
+		string(RulesetRuleActionBlock),
+		string(RulesetRuleActionManagedChallenge),
+		string(RulesetRuleActionChallenge),
+		string(RulesetRuleActionJSChallenge),
+		string(RulesetRuleActionLog),
+	}
+	validDDoSSensitivityLevels = []string{
+		DDoSSensitivityHigh,
+		DDoSSensitivityMedium,
+		DDoSSensitivityLow,
+		DDoSSensitivityOff,
+	}
+)
+
+// DDoSL7Override changes the action and/or sensitivity level of HTTP DDoS
+// Attack Protection rules. Empty fields keep the managed default.
+type DDoSL7Override struct {
+	Action           RulesetRuleAction
+	SensitivityLevel string
+}
+
+// DDoSL7Overrides holds the overrides applied to all HTTP DDoS Attack
+// Protection rules and, keyed by rule ID, to individual rules.
+type DDoSL7Overrides struct {
+	Global DDoSL7Override
+	Rules  map[string]DDoSL7Override
+}
+
+// UpdateZoneDDoSL7Overrides sets the overrides of the HTTP DDoS Attack
+// Protection managed ruleset in the ddos_l7 entry point ruleset of a zone.
+//
+// The overrides replace any existing ones. The entry point ruleset is
+// created if it does not exist, and other rules in it are preserved.
+//
+// API reference: https://developers.cloudflare.com/ddos-protection/managed-rulesets/http/http-overrides/configure-api/
+func (api *API) UpdateZoneDDoSL7Overrides(ctx context.Context, zoneID string, overrides DDoSL7Overrides) (Ruleset, error) {
+	params, err := overrides.actionParameters()
+	if err != nil {
+		return Ruleset{}, err
+	}
+
+	entrypoint, err := api.GetZoneRulesetPhase(ctx, zoneID, RulesetPhaseDDoSL7)
+	if err != nil && !isNotFoundError(err) {
+		return Ruleset{}, err
+	}
+
+	rules := make([]RulesetRule, 0, len(entrypoint.Rules)+1)
+	found := false
+	for _, r := range entrypoint.Rules {
+		if r.Action == RulesetRuleActionExecute && r.ActionParameters != nil && r.ActionParameters.ID == DDoSL7ManagedRulesetID {
+			r.ActionParameters.Overrides = params.Overrides
+			found = true
+		}
+		rules = append(rules, r)
+	}
+	if !found {
+		rules = append(rules, RulesetRule{
+			Action:           RulesetRuleActionExecute,
+			ActionParameters: params,
+			Expression:       "true",
+			Description:      "Execute HTTP DDoS Attack Protection managed ruleset",
+			Enabled:          true,
+		})
+	}
+
+	return api.UpdateZoneRulesetPhase(ctx, zoneID, RulesetPhaseDDoSL7, entrypoint.Description, rules)
+}
+
+func (o DDoSL7Overrides) actionParameters() (*RulesetRuleActionParameters, error) {
+	if err := o.Global.validate(); err != nil {
+		return nil, err
+	}
+
+	overrides := &RulesetRuleActionParametersOverrides{
+		Action:           o.Global.Action,
+		SensitivityLevel: o.Global.SensitivityLevel,
+	}
+	for id, rule := range o.Rules {
+		if id == "" {
+			return nil, errors.New("DDoS rule ID cannot be empty")
+		}
+		if err := rule.validate(); err != nil {
+			return nil, errors.Wrapf(err, "rule %s", id)
+		}
+		overrides.Rules = append(overrides.Rules, RulesetRuleActionParametersRulesOverride{
+			ID:               id,
+			Action:           rule.Action,
+			SensitivityLevel: rule.SensitivityLevel,
+		})
+	}
+	// Keep the payload stable regardless of map iteration order.
+	sort.Slice(overrides.Rules, func(i, j int) bool { return overrides.Rules[i].ID < overrides.Rules[j].ID })
+
+	return &RulesetRuleActionParameters{ID: DDoSL7ManagedRulesetID, Overrides: overrides}, nil
+}
+
+func (o DDoSL7Override) validate() error {
+	if o.Action != "" && !contains(validDDoSL7Actions, string(o.Action)) {
+		return errors.Errorf("invalid DDoS action %q", o.Action)
+	}
+	if o.SensitivityLevel != "" && !contains(validDDoSSensitivityLevels, o.SensitivityLevel) {
+		return errors.Errorf("invalid DDoS sensitivity level %q", o.SensitivityLevel)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateZoneDDoSL7Overrides(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {
+					"id": "2c0fc9fa937b11eaa1b71c4d701ab86e",
+					"name": "default",
+					"description": "DDoS overrides",
+					"kind": "zone",
+					"phase": "ddos_l7",
+					"rules": [
+						{
+							"id": "1",
+							"action": "execute",
+							"action_parameters": {"id": "4d21379b4f9f4bb088e0729962c8b3cf", "overrides": {"sensitivity_level": "low"}},
+							"expression": "true",
+							"description": "",
+							"enabled": true
+						}
+					]
+				}
+			}`)
+		case http.MethodPut:
+			body, err := ioutil.ReadAll(r.Body)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{
+					"description": "DDoS overrides",
+					"rules": [
+						{
+							"id": "1",
+							"action": "execute",
+							"action_parameters": {
+								"id": "4d21379b4f9f4bb088e0729962c8b3cf",
+								"overrides": {
+									"action": "managed_challenge",
+									"rules": [
+										{"id": "a", "sensitivity_level": "eoff"},
+										{"id": "b", "action": "block", "sensitivity_level": "medium"}
+									]
+								}
+							},
+							"expression": "true",
+							"description": "",
+							"enabled": true
+						}
+					]
+				}`, string(body))
+			}
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "default", "description": "DDoS overrides", "kind": "zone", "phase": "ddos_l7", "rules": []}
+			}`)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/ddos_l7/entrypoint", handler)
+
+	ruleset, err := client.UpdateZoneDDoSL7Overrides(context.Background(), testZoneID, DDoSL7Overrides{
+		Global: DDoSL7Override{Action: RulesetRuleActionManagedChallenge},
+		Rules: map[string]DDoSL7Override{
+			"b": {Action: RulesetRuleActionBlock, SensitivityLevel: DDoSSensitivityMedium},
+			"a": {SensitivityLevel: DDoSSensitivityOff},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, RulesetPhaseDDoSL7, ruleset.Phase)
+	}
+}
+
+func TestUpdateZoneDDoSL7OverridesCreatesEntrypoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		if r.Method == http.MethodGet {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 10003, "message": "could not find entrypoint ruleset in the ddos_l7 phase"}], "messages": [], "result": null}`)
+			return
+		}
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"description": "",
+				"rules": [
+					{
+						"action": "execute",
+						"action_parameters": {
+							"id": "4d21379b4f9f4bb088e0729962c8b3cf",
+							"overrides": {"sensitivity_level": "low"}
+						},
+						"expression": "true",
+						"description": "Execute HTTP DDoS Attack Protection managed ruleset",
+						"enabled": true
+					}
+				]
+			}`, string(body))
+		}
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "2c0fc9fa937b11eaa1b71c4d701ab86e", "name": "default", "description": "", "kind": "zone", "phase": "ddos_l7", "rules": []}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/rulesets/phases/ddos_l7/entrypoint", handler)
+
+	_, err := client.UpdateZoneDDoSL7Overrides(context.Background(), testZoneID, DDoSL7Overrides{
+		Global: DDoSL7Override{SensitivityLevel: DDoSSensitivityLow},
+	})
+	assert.NoError(t, err)
+}
+
+func TestUpdateZoneDDoSL7OverridesValidation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateZoneDDoSL7Overrides(context.Background(), testZoneID, DDoSL7Overrides{
+		Global: DDoSL7Override{Action: RulesetRuleActionSkip},
+	})
+	assert.EqualError(t, err, `invalid DDoS action "skip"`)
+
+	_, err = client.UpdateZoneDDoSL7Overrides(context.Background(), testZoneID, DDoSL7Overrides{
+		Rules: map[string]DDoSL7Override{"a": {SensitivityLevel: "high"}},
+	})
+	assert.EqualError(t, err, `rule a: invalid DDoS sensitivity level "high"`)
+}
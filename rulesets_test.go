@@ -2,6 +2,7 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"testing"
@@ -409,3 +410,19 @@ func TestUpdateRuleset(t *testing.T) {
 		assert.Equal(t, want, accountActual)
 	}
 }
+
+func TestRulesetRuleActionParametersMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(RulesetRuleActionParameters{
+		Headers: map[string]RulesetRuleActionParametersHTTPHeader{"X-Powered-By": {Operation: "remove"}},
+	})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"headers": {"X-Powered-By": {"operation": "remove"}}}`, string(b))
+	}
+
+	b, err = json.Marshal(RulesetRuleActionParameters{
+		URI: RulesetRuleActionParametersURI{Path: RulesetRuleActionParametersURIPath{Value: "/news"}},
+	})
+	if assert.NoError(t, err) {
+		assert.JSONEq(t, `{"uri": {"path": {"value": "/news"}}}`, string(b))
+	}
+}
@@ -0,0 +1,120 @@
+package cloudflare
+
+import (
+	"github.com/pkg/errors"
+)
+
+// TransformRuleURLRewrite describes a URL rewrite. The path and query can
+// each be rewritten to either a static value or the result of an
+// expression, but not both.
+type TransformRuleURLRewrite struct {
+	Path            string
+	PathExpression  string
+	Query           string
+	QueryExpression string
+}
+
+// SetHTTPHeader returns a header transform that sets a header to a static
+// value.
+func SetHTTPHeader(value string) RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation: string(RulesetRuleActionParametersHTTPHeaderOperationSet),
+		Value:     value,
+	}
+}
+
+// SetHTTPHeaderExpression returns a header transform that sets a header to
+// the result of an expression, such as `ip.src`.
+func SetHTTPHeaderExpression(expression string) RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation:  string(RulesetRuleActionParametersHTTPHeaderOperationSet),
+		Expression: expression,
+	}
+}
+
+// RemoveHTTPHeader returns a header transform that removes a header.
+func RemoveHTTPHeader() RulesetRuleActionParametersHTTPHeader {
+	return RulesetRuleActionParametersHTTPHeader{
+		Operation: string(RulesetRuleActionParametersHTTPHeaderOperationRemove),
+	}
+}
+
+// NewURLRewriteRule returns an enabled rule that rewrites the URL of
+// requests matching expression. The rule belongs in a ruleset of the
+// RulesetPhaseHTTPRequestTransform phase.
+//
+// API reference: https://developers.cloudflare.com/rules/transform/url-rewrite/
+func NewURLRewriteRule(description, expression string, rewrite TransformRuleURLRewrite) (RulesetRule, error) {
+	if rewrite.Path != "" && rewrite.PathExpression != "" {
+		return RulesetRule{}, errors.New("URL rewrite path cannot have both a value and an expression")
+	}
+	if rewrite.Query != "" && rewrite.QueryExpression != "" {
+		return RulesetRule{}, errors.New("URL rewrite query cannot have both a value and an expression")
+	}
+
+	uri := RulesetRuleActionParametersURI{
+		Path:  RulesetRuleActionParametersURIPath{Value: rewrite.Path, Expression: rewrite.PathExpression},
+		Query: RulesetRuleActionParametersURIQuery{Value: rewrite.Query, Expression: rewrite.QueryExpression},
+	}
+	if uri == (RulesetRuleActionParametersURI{}) {
+		return RulesetRule{}, errors.New("URL rewrite must change the path or the query")
+	}
+
+	return newTransformRule(description, expression, &RulesetRuleActionParameters{URI: uri})
+}
+
+// NewHTTPHeaderRule returns an enabled rule that applies the header
+// transforms, keyed by header name, to requests or responses matching
+// expression. Whether request or response headers are modified depends on
+// the phase of the ruleset the rule is added to:
+// RulesetPhaseHTTPRequestLateTransform or
+// RulesetPhaseHTTPResponseHeadersTransform.
+//
+// API reference: https://developers.cloudflare.com/rules/transform/request-header-modification/
+func NewHTTPHeaderRule(description, expression string, headers map[string]RulesetRuleActionParametersHTTPHeader) (RulesetRule, error) {
+	if len(headers) == 0 {
+		return RulesetRule{}, errors.New("header transform must modify at least one header")
+	}
+	return newTransformRule(description, expression, &RulesetRuleActionParameters{Headers: headers})
+}
+
+// NewTransformRuleset returns the zone entry point ruleset of a transform
+// phase containing rules, ready to be passed to CreateZoneRuleset.
+func NewTransformRuleset(name string, phase RulesetPhase, rules []RulesetRule) (Ruleset, error) {
+	switch phase {
+	case RulesetPhaseHTTPRequestTransform:
+		for _, r := range rules {
+			if r.ActionParameters != nil && len(r.ActionParameters.Headers) > 0 {
+				return Ruleset{}, errors.Errorf("header transforms are not allowed in the %s phase", phase)
+			}
+		}
+	case RulesetPhaseHTTPRequestLateTransform, RulesetPhaseHTTPResponseHeadersTransform:
+		for _, r := range rules {
+			if r.ActionParameters != nil && r.ActionParameters.URI != (RulesetRuleActionParametersURI{}) {
+				return Ruleset{}, errors.Errorf("URL rewrites are not allowed in the %s phase", phase)
+			}
+		}
+	default:
+		return Ruleset{}, errors.Errorf("%s is not a transform phase", phase)
+	}
+
+	return Ruleset{
+		Name:  name,
+		Kind:  RulesetKindZone,
+		Phase: phase,
+		Rules: rules,
+	}, nil
+}
+
+func newTransformRule(description, expression string, params *RulesetRuleActionParameters) (RulesetRule, error) {
+	if expression == "" {
+		return RulesetRule{}, errors.New("rule expression cannot be empty")
+	}
+	return RulesetRule{
+		Action:           RulesetRuleActionRewrite,
+		ActionParameters: params,
+		Expression:       expression,
+		Description:      description,
+		Enabled:          true,
+	}, nil
+}
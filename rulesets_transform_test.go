@@ -0,0 +1,94 @@
+package cloudflare
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewURLRewriteRule(t *testing.T) {
+	rule, err := NewURLRewriteRule("rewrite blog", `http.request.uri.path eq "/blog"`, TransformRuleURLRewrite{
+		Path:            "/news",
+		QueryExpression: `concat("src=", http.request.uri.path)`,
+	})
+	if assert.NoError(t, err) {
+		b, err := json.Marshal(rule)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"action": "rewrite",
+				"action_parameters": {
+					"uri": {
+						"path": {"value": "/news"},
+						"query": {"expression": "concat(\"src=\", http.request.uri.path)"}
+					}
+				},
+				"expression": "http.request.uri.path eq \"/blog\"",
+				"description": "rewrite blog",
+				"enabled": true
+			}`, string(b))
+		}
+	}
+
+	_, err = NewURLRewriteRule("", "true", TransformRuleURLRewrite{Path: "/a", PathExpression: "lower(http.request.uri.path)"})
+	assert.EqualError(t, err, "URL rewrite path cannot have both a value and an expression")
+
+	_, err = NewURLRewriteRule("", "true", TransformRuleURLRewrite{})
+	assert.EqualError(t, err, "URL rewrite must change the path or the query")
+
+	_, err = NewURLRewriteRule("", "", TransformRuleURLRewrite{Path: "/a"})
+	assert.EqualError(t, err, "rule expression cannot be empty")
+}
+
+func TestNewHTTPHeaderRule(t *testing.T) {
+	rule, err := NewHTTPHeaderRule("security headers", "true", map[string]RulesetRuleActionParametersHTTPHeader{
+		"X-Frame-Options": SetHTTPHeader("DENY"),
+		"X-Client-IP":     SetHTTPHeaderExpression("ip.src"),
+		"X-Powered-By":    RemoveHTTPHeader(),
+	})
+	if assert.NoError(t, err) {
+		b, err := json.Marshal(rule)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"action": "rewrite",
+				"action_parameters": {
+					"headers": {
+						"X-Frame-Options": {"operation": "set", "value": "DENY"},
+						"X-Client-IP": {"operation": "set", "expression": "ip.src"},
+						"X-Powered-By": {"operation": "remove"}
+					}
+				},
+				"expression": "true",
+				"description": "security headers",
+				"enabled": true
+			}`, string(b))
+		}
+	}
+
+	_, err = NewHTTPHeaderRule("", "true", nil)
+	assert.EqualError(t, err, "header transform must modify at least one header")
+}
+
+func TestNewTransformRuleset(t *testing.T) {
+	rewrite, _ := NewURLRewriteRule("", "true", TransformRuleURLRewrite{Path: "/"})
+	headers, _ := NewHTTPHeaderRule("", "true", map[string]RulesetRuleActionParametersHTTPHeader{"X-Powered-By": RemoveHTTPHeader()})
+
+	ruleset, err := NewTransformRuleset("default", RulesetPhaseHTTPResponseHeadersTransform, []RulesetRule{headers})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Ruleset{
+			Name:  "default",
+			Kind:  RulesetKindZone,
+			Phase: RulesetPhaseHTTPResponseHeadersTransform,
+			Rules: []RulesetRule{headers},
+		}, ruleset)
+	}
+
+	_, err = NewTransformRuleset("default", RulesetPhaseHTTPRequestTransform, []RulesetRule{headers})
+	assert.EqualError(t, err, "header transforms are not allowed in the http_request_transform phase")
+
+	_, err = NewTransformRuleset("default", RulesetPhaseHTTPRequestLateTransform, []RulesetRule{rewrite})
+	assert.EqualError(t, err, "URL rewrites are not allowed in the http_request_late_transform phase")
+
+	_, err = NewTransformRuleset("default", RulesetPhaseHTTPRequestFirewallCustom, nil)
+	assert.EqualError(t, err, "http_request_firewall_custom is not a transform phase")
+}
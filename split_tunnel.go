@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// SplitTunnelMode represents whether the split tunnel entries of a device
+// settings policy are included in or excluded from the WARP tunnel.
+type SplitTunnelMode string
+
+// These constants represent all valid split tunnel modes.
+const (
+	SplitTunnelInclude SplitTunnelMode = "include"
+	SplitTunnelExclude SplitTunnelMode = "exclude"
+)
+
+// SplitTunnel represents a single split tunnel entry. Either Address (a CIDR)
+// or Host (a domain) should be set.
+type SplitTunnel struct {
+	Address     string `json:"address,omitempty"`
+	Host        string `json:"host,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// SplitTunnelResponse represents the response from the split tunnel
+// endpoints.
+type SplitTunnelResponse struct {
+	Response
+	Result []SplitTunnel `json:"result"`
+}
+
+// ListSplitTunnels returns the split tunnel entries of the default device
+// settings policy for the given mode.
+//
+// API reference: https://api.cloudflare.com/#device-policy-get-split-tunnel-exclude-list
+func (api *API) ListSplitTunnels(ctx context.Context, accountID string, mode SplitTunnelMode) ([]SplitTunnel, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s", AccountRouteRoot, accountID, mode)
+
+	return api.splitTunnelRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// ListSplitTunnelsDeviceSettingsPolicy returns the split tunnel entries of a
+// custom device settings policy for the given mode.
+//
+// API reference: https://api.cloudflare.com/#device-policy-get-split-tunnel-exclude-list
+func (api *API) ListSplitTunnelsDeviceSettingsPolicy(ctx context.Context, accountID, policyID string, mode SplitTunnelMode) ([]SplitTunnel, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s/%s", AccountRouteRoot, accountID, policyID, mode)
+
+	return api.splitTunnelRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateSplitTunnel replaces the split tunnel entries of the default device
+// settings policy for the given mode.
+//
+// API reference: https://api.cloudflare.com/#device-policy-set-split-tunnel-exclude-list
+func (api *API) UpdateSplitTunnel(ctx context.Context, accountID string, mode SplitTunnelMode, tunnels []SplitTunnel) ([]SplitTunnel, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s", AccountRouteRoot, accountID, mode)
+
+	return api.splitTunnelRequest(ctx, http.MethodPut, uri, tunnels)
+}
+
+// UpdateSplitTunnelDeviceSettingsPolicy replaces the split tunnel entries of
+// a custom device settings policy for the given mode.
+//
+// API reference: https://api.cloudflare.com/#device-policy-set-split-tunnel-exclude-list
+func (api *API) UpdateSplitTunnelDeviceSettingsPolicy(ctx context.Context, accountID, policyID string, mode SplitTunnelMode, tunnels []SplitTunnel) ([]SplitTunnel, error) {
+	uri := fmt.Sprintf("/%s/%s/devices/policy/%s/%s", AccountRouteRoot, accountID, policyID, mode)
+
+	return api.splitTunnelRequest(ctx, http.MethodPut, uri, tunnels)
+}
+
+func (api *API) splitTunnelRequest(ctx context.Context, method, uri string, params interface{}) ([]SplitTunnel, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return []SplitTunnel{}, err
+	}
+
+	var splitTunnelResponse SplitTunnelResponse
+	err = json.Unmarshal(res, &splitTunnelResponse)
+	if err != nil {
+		return []SplitTunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return splitTunnelResponse.Result, nil
+}
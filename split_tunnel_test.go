@@ -0,0 +1,83 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListSplitTunnels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"address": "192.0.2.0/24", "description": "office"},
+				{"host": "*.example.com", "description": "internal"}
+			]
+		}
+		`)
+	}
+
+	want := []SplitTunnel{
+		{Address: "192.0.2.0/24", Description: "office"},
+		{Host: "*.example.com", Description: "internal"},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/exclude", handler)
+
+	actual, err := client.ListSplitTunnels(context.Background(), testAccountID, SplitTunnelExclude)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/a842fa8a-a583-482e-9cd9-eb43362949fd/include", handler)
+
+	actual, err = client.ListSplitTunnelsDeviceSettingsPolicy(context.Background(), testAccountID, "a842fa8a-a583-482e-9cd9-eb43362949fd", SplitTunnelInclude)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateSplitTunnel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `[{"address":"192.0.2.0/24","description":"office"}]`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"address": "192.0.2.0/24", "description": "office"}
+			]
+		}
+		`)
+	}
+
+	tunnels := []SplitTunnel{{Address: "192.0.2.0/24", Description: "office"}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/devices/policy/include", handler)
+
+	actual, err := client.UpdateSplitTunnel(context.Background(), testAccountID, SplitTunnelInclude, tunnels)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, tunnels, actual)
+	}
+}
@@ -10,6 +10,20 @@ import (
 	"github.com/pkg/errors"
 )
 
+// These constants represent the ways a custom certificate can be chained.
+const (
+	SSLBundleMethodUbiquitous = "ubiquitous"
+	SSLBundleMethodOptimal    = "optimal"
+	SSLBundleMethodForce      = "force"
+)
+
+// These constants represent the types of custom certificate that can be
+// uploaded.
+const (
+	SSLTypeLegacyCustom = "legacy_custom"
+	SSLTypeSNICustom    = "sni_custom"
+)
+
 // ZoneCustomSSL represents custom SSL certificate metadata.
 type ZoneCustomSSL struct {
 	ID              string                       `json:"id"`
@@ -19,6 +33,7 @@ type ZoneCustomSSL struct {
 	Status          string                       `json:"status"`
 	BundleMethod    string                       `json:"bundle_method"`
 	GeoRestrictions ZoneCustomSSLGeoRestrictions `json:"geo_restrictions"`
+	Policy          string                       `json:"policy,omitempty"`
 	ZoneID          string                       `json:"zone_id"`
 	UploadedOn      time.Time                    `json:"uploaded_on"`
 	ModifiedOn      time.Time                    `json:"modified_on"`
@@ -52,6 +67,7 @@ type ZoneCustomSSLOptions struct {
 	PrivateKey      string                        `json:"private_key"`
 	BundleMethod    string                        `json:"bundle_method,omitempty"`
 	GeoRestrictions *ZoneCustomSSLGeoRestrictions `json:"geo_restrictions,omitempty"`
+	Policy          string                        `json:"policy,omitempty"`
 	Type            string                        `json:"type,omitempty"`
 }
 
@@ -126,6 +142,31 @@ func (api *API) UpdateSSL(ctx context.Context, zoneID, certificateID string, opt
 	return r.Result, nil
 }
 
+// ZoneCustomSSLSettingsOptions represents the parameters to change the
+// settings of a custom SSL certificate without replacing the certificate.
+type ZoneCustomSSLSettingsOptions struct {
+	BundleMethod    string                        `json:"bundle_method,omitempty"`
+	GeoRestrictions *ZoneCustomSSLGeoRestrictions `json:"geo_restrictions,omitempty"`
+	Policy          string                        `json:"policy,omitempty"`
+}
+
+// UpdateSSLSettings changes the bundle method, geo restrictions or policy
+// of a custom SSL certificate, keeping the certificate and private key.
+//
+// API reference: https://api.cloudflare.com/#custom-ssl-for-a-zone-edit-ssl-configuration
+func (api *API) UpdateSSLSettings(ctx context.Context, zoneID, certificateID string, options ZoneCustomSSLSettingsOptions) (ZoneCustomSSL, error) {
+	uri := fmt.Sprintf("/zones/%s/custom_certificates/%s", zoneID, certificateID)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, options)
+	if err != nil {
+		return ZoneCustomSSL{}, err
+	}
+	var r zoneCustomSSLResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return ZoneCustomSSL{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
 // ReprioritizeSSL allows you to change the priority (which is served for a given
 // request) of custom SSL certificates associated with the given zone.
 //
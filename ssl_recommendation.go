@@ -0,0 +1,48 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// SSLRecommendation represents the SSL/TLS encryption mode recommended for a
+// zone after Cloudflare has scanned its origin.
+type SSLRecommendation struct {
+	ID         string     `json:"id"`
+	Value      string     `json:"value"`
+	Editable   bool       `json:"editable"`
+	ModifiedOn *time.Time `json:"modified_on,omitempty"`
+}
+
+// SSLRecommendationResponse is the API response, containing the SSL
+// recommendation of a zone.
+type SSLRecommendationResponse struct {
+	Response
+	Result SSLRecommendation `json:"result"`
+}
+
+// SSLRecommendation returns the SSL/TLS encryption mode recommended for a
+// zone.
+//
+// API reference: https://api.cloudflare.com/#ssl-tls-mode-recommendation-ssl-tls-recommendation
+func (api *API) SSLRecommendation(ctx context.Context, zoneID string) (SSLRecommendation, error) {
+	uri := fmt.Sprintf("/zones/%s/ssl/recommendation", zoneID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return SSLRecommendation{}, err
+	}
+
+	var recommendationResponse SSLRecommendationResponse
+	err = json.Unmarshal(res, &recommendationResponse)
+	if err != nil {
+		return SSLRecommendation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return recommendationResponse.Result, nil
+}
@@ -0,0 +1,49 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSSLRecommendation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ssl_recommender",
+				"value": "strict",
+				"editable": true,
+				"modified_on": "2014-01-01T05:20:00Z"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ssl/recommendation", handler)
+
+	modifiedOn, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00Z")
+	want := SSLRecommendation{
+		ID:         "ssl_recommender",
+		Value:      "strict",
+		Editable:   true,
+		ModifiedOn: &modifiedOn,
+	}
+
+	actual, err := client.SSLRecommendation(context.Background(), testZoneID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
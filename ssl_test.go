@@ -288,6 +288,58 @@ func TestUpdateSSL(t *testing.T) {
 	assert.Error(t, err)
 }
 
+func TestUpdateSSLSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		b, err := ioutil.ReadAll(r.Body)
+		defer r.Body.Close()
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"bundle_method":"force","policy":"(country: US) or (region: EU)"}`, string(b))
+		}
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+          "success": true,
+          "errors": [],
+          "messages": [],
+          "result": {
+            "id": "7e7b8deba8538af625850b7b2530034c",
+            "hosts": [
+              "example.com"
+            ],
+            "status": "active",
+            "bundle_method": "force",
+            "policy": "(country: US) or (region: EU)",
+            "zone_id": "023e105f4ecef8ad9ca31a8372d0c353",
+            "priority": 1
+          }
+        }`)
+	}
+
+	mux.HandleFunc("/zones/023e105f4ecef8ad9ca31a8372d0c353/custom_certificates/7e7b8deba8538af625850b7b2530034c", handler)
+
+	want := ZoneCustomSSL{
+		ID:           "7e7b8deba8538af625850b7b2530034c",
+		Hosts:        []string{"example.com"},
+		Status:       "active",
+		BundleMethod: SSLBundleMethodForce,
+		Policy:       "(country: US) or (region: EU)",
+		ZoneID:       "023e105f4ecef8ad9ca31a8372d0c353",
+		Priority:     1,
+	}
+
+	actual, err := client.UpdateSSLSettings(context.Background(), "023e105f4ecef8ad9ca31a8372d0c353", "7e7b8deba8538af625850b7b2530034c", ZoneCustomSSLSettingsOptions{
+		BundleMethod: SSLBundleMethodForce,
+		Policy:       "(country: US) or (region: EU)",
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
 func TestReprioritizeSSL(t *testing.T) {
 	setup()
 	defer teardown()
@@ -0,0 +1,283 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Stream video processing states.
+const (
+	StreamVideoStatePendingUpload = "pendingupload"
+	StreamVideoStateDownloading   = "downloading"
+	StreamVideoStateQueued        = "queued"
+	StreamVideoStateInProgress    = "inprogress"
+	StreamVideoStateReady         = "ready"
+	StreamVideoStateError         = "error"
+)
+
+// StreamVideo is a video stored in Cloudflare Stream.
+type StreamVideo struct {
+	UID                   string                 `json:"uid"`
+	Creator               string                 `json:"creator,omitempty"`
+	Thumbnail             string                 `json:"thumbnail"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct"`
+	ReadyToStream         bool                   `json:"readyToStream"`
+	Status                StreamVideoStatus      `json:"status"`
+	Meta                  map[string]interface{} `json:"meta"`
+	Created               *time.Time             `json:"created"`
+	Modified              *time.Time             `json:"modified"`
+	Uploaded              *time.Time             `json:"uploaded,omitempty"`
+	UploadExpiry          *time.Time             `json:"uploadExpiry,omitempty"`
+	Size                  int64                  `json:"size"`
+	Preview               string                 `json:"preview"`
+	AllowedOrigins        []string               `json:"allowedOrigins"`
+	RequireSignedURLs     bool                   `json:"requireSignedURLs"`
+	MaxSizeBytes          int64                  `json:"maxSizeBytes,omitempty"`
+	MaxDurationSeconds    int                    `json:"maxDurationSeconds,omitempty"`
+	Duration              float64                `json:"duration"`
+	Input                 StreamVideoInput       `json:"input"`
+	Playback              StreamVideoPlayback    `json:"playback"`
+	Watermark             *StreamWatermark       `json:"watermark,omitempty"`
+}
+
+// StreamVideoStatus is the processing state of a video.
+type StreamVideoStatus struct {
+	State           string `json:"state"`
+	PctComplete     string `json:"pctComplete,omitempty"`
+	ErrorReasonCode string `json:"errorReasonCode,omitempty"`
+	ErrorReasonText string `json:"errorReasonText,omitempty"`
+}
+
+// StreamVideoInput is the dimensions of the uploaded video.
+type StreamVideoInput struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// StreamVideoPlayback holds the manifest URLs of a video.
+type StreamVideoPlayback struct {
+	HLS  string `json:"hls"`
+	Dash string `json:"dash"`
+}
+
+// StreamListParams filters and orders the videos returned by
+// ListStreamVideos.
+type StreamListParams struct {
+	// After and Before restrict the videos to those created in that range.
+	After  *time.Time
+	Before *time.Time
+	// Search matches against the name of the videos.
+	Search string
+	Status string
+	// Asc lists the oldest videos first.
+	Asc   bool
+	Limit int
+}
+
+// Encode encodes the params as a URL query string.
+func (p StreamListParams) Encode() string {
+	v := url.Values{}
+	if p.After != nil {
+		v.Set("after", p.After.Format(time.RFC3339))
+	}
+	if p.Before != nil {
+		v.Set("before", p.Before.Format(time.RFC3339))
+	}
+	if p.Search != "" {
+		v.Set("search", p.Search)
+	}
+	if p.Status != "" {
+		v.Set("status", p.Status)
+	}
+	if p.Asc {
+		v.Set("asc", "true")
+	}
+	if p.Limit > 0 {
+		v.Set("limit", strconv.Itoa(p.Limit))
+	}
+	return v.Encode()
+}
+
+// StreamDirectUploadParams configures a direct creator upload. The
+// uploader needs no credentials; only MaxDurationSeconds is required.
+type StreamDirectUploadParams struct {
+	MaxDurationSeconds    int                    `json:"maxDurationSeconds"`
+	Expiry                *time.Time             `json:"expiry,omitempty"`
+	Creator               string                 `json:"creator,omitempty"`
+	Meta                  map[string]interface{} `json:"meta,omitempty"`
+	RequireSignedURLs     bool                   `json:"requireSignedURLs,omitempty"`
+	AllowedOrigins        []string               `json:"allowedOrigins,omitempty"`
+	ThumbnailTimestampPct float64                `json:"thumbnailTimestampPct,omitempty"`
+	Watermark             *StreamWatermarkRef    `json:"watermark,omitempty"`
+}
+
+// StreamDirectUpload is a one-time URL a creator uploads a video to with a
+// multipart POST, and the UID the video will have.
+type StreamDirectUpload struct {
+	UploadURL string `json:"uploadURL"`
+	UID       string `json:"uid"`
+}
+
+// StreamVideoResponse is the API response containing a video.
+type StreamVideoResponse struct {
+	Response
+	Result StreamVideo `json:"result"`
+}
+
+// StreamVideosResponse is the API response containing a list of videos.
+type StreamVideosResponse struct {
+	Response
+	Result []StreamVideo `json:"result"`
+	Total  string        `json:"total,omitempty"`
+	Range  string        `json:"range,omitempty"`
+}
+
+// StreamDirectUploadResponse is the API response containing a direct
+// creator upload URL.
+type StreamDirectUploadResponse struct {
+	Response
+	Result StreamDirectUpload `json:"result"`
+}
+
+// ListStreamVideos returns the videos of an account, newest first unless
+// params.Asc is set. At most 1000 videos are returned per call; use
+// params.Before or params.After to page through more.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-list-videos
+func (api *API) ListStreamVideos(ctx context.Context, accountID string, params StreamListParams) ([]StreamVideo, error) {
+	if accountID == "" {
+		return []StreamVideo{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream", accountID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamVideo{}, err
+	}
+
+	var r StreamVideosResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamVideo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// StreamVideo returns the details of a video.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-video-details
+func (api *API) StreamVideo(ctx context.Context, accountID, videoID string) (StreamVideo, error) {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return StreamVideo{}, err
+	}
+
+	var r StreamVideoResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamVideo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteStreamVideo deletes a video and its copies.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-delete-video
+func (api *API) DeleteStreamVideo(ctx context.Context, accountID, videoID string) error {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// CreateStreamDirectUploadURL creates a one-time URL that lets a creator
+// upload a video without access to the account's credentials.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-upload-videos-via-direct-upload-urls
+func (api *API) CreateStreamDirectUploadURL(ctx context.Context, accountID string, params StreamDirectUploadParams) (StreamDirectUpload, error) {
+	if accountID == "" {
+		return StreamDirectUpload{}, errors.New(errMissingAccountID)
+	}
+	if params.MaxDurationSeconds <= 0 {
+		return StreamDirectUpload{}, errors.New("max duration seconds must be positive")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/direct_upload", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, params)
+	if err != nil {
+		return StreamDirectUpload{}, err
+	}
+
+	var r StreamDirectUploadResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamDirectUpload{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// multipartFormBody builds a multipart form of fields and, if r is not
+// nil, a "file" part named fileName read from r.
+func multipartFormBody(fields map[string]string, fileName string, r io.Reader) (string, []byte, error) {
+	buf := &bytes.Buffer{}
+	mpw := multipart.NewWriter(buf)
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := mpw.WriteField(name, fields[name]); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if r != nil {
+		part, err := mpw.CreateFormFile("file", fileName)
+		if err != nil {
+			return "", nil, err
+		}
+		if _, err := io.Copy(part, r); err != nil {
+			return "", nil, errors.Wrap(err, "could not read file content")
+		}
+	}
+
+	if err := mpw.Close(); err != nil {
+		return "", nil, err
+	}
+	return mpw.FormDataContentType(), buf.Bytes(), nil
+}
+
+func streamVideoURI(accountID, videoID string) (string, error) {
+	if accountID == "" {
+		return "", errors.New(errMissingAccountID)
+	}
+	if videoID == "" {
+		return "", errors.New("video ID cannot be empty")
+	}
+	return fmt.Sprintf("/accounts/%s/stream/%s", accountID, videoID), nil
+}
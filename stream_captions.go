@@ -0,0 +1,110 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// StreamCaption is a captions track of a video in one language.
+type StreamCaption struct {
+	// Language is a BCP 47 language tag, such as "en" or "pt-BR".
+	Language string `json:"language"`
+	Label    string `json:"label"`
+}
+
+// StreamCaptionResponse is the API response containing a captions track.
+type StreamCaptionResponse struct {
+	Response
+	Result StreamCaption `json:"result"`
+}
+
+// StreamCaptionsResponse is the API response containing the captions
+// tracks of a video.
+type StreamCaptionsResponse struct {
+	Response
+	Result []StreamCaption `json:"result"`
+}
+
+// ListStreamCaptions returns the captions tracks of a video.
+//
+// API reference: https://api.cloudflare.com/#stream-subtitles/captions-list-captions-or-subtitles
+func (api *API) ListStreamCaptions(ctx context.Context, accountID, videoID string) ([]StreamCaption, error) {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return []StreamCaption{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri+"/captions", nil)
+	if err != nil {
+		return []StreamCaption{}, err
+	}
+
+	var r StreamCaptionsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamCaption{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UploadStreamCaption uploads a WebVTT captions file read from r as the
+// captions of a video in language, replacing any existing ones.
+//
+// API reference: https://api.cloudflare.com/#stream-subtitles/captions-upload-captions-or-subtitles
+func (api *API) UploadStreamCaption(ctx context.Context, accountID, videoID, language string, r io.Reader) (StreamCaption, error) {
+	uri, err := streamCaptionURI(accountID, videoID, language)
+	if err != nil {
+		return StreamCaption{}, err
+	}
+	if r == nil {
+		return StreamCaption{}, errors.New("captions file cannot be empty")
+	}
+
+	contentType, body, err := multipartFormBody(nil, language+".vtt", r)
+	if err != nil {
+		return StreamCaption{}, err
+	}
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+
+	res, err := api.makeRequestContextWithHeaders(ctx, http.MethodPut, uri, body, headers)
+	if err != nil {
+		return StreamCaption{}, err
+	}
+
+	var resp StreamCaptionResponse
+	err = json.Unmarshal(res, &resp)
+	if err != nil {
+		return StreamCaption{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return resp.Result, nil
+}
+
+// DeleteStreamCaption removes the captions of a video in language.
+//
+// API reference: https://api.cloudflare.com/#stream-subtitles/captions-delete-captions-or-subtitles
+func (api *API) DeleteStreamCaption(ctx context.Context, accountID, videoID, language string) error {
+	uri, err := streamCaptionURI(accountID, videoID, language)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func streamCaptionURI(accountID, videoID, language string) (string, error) {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return "", err
+	}
+	if language == "" {
+		return "", errors.New("caption language cannot be empty")
+	}
+	return fmt.Sprintf("%s/captions/%s", uri, language), nil
+}
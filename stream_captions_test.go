@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListStreamCaptions(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"label": "English", "language": "en"}]}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/captions", handler)
+
+	actual, err := client.ListStreamCaptions(context.Background(), testAccountID, testStreamVideoID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []StreamCaption{{Label: "English", Language: "en"}}, actual)
+	}
+}
+
+func TestUploadStreamCaption(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		file, header, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			content, _ := ioutil.ReadAll(file)
+			assert.Equal(t, "WEBVTT\n", string(content))
+			assert.Equal(t, "en.vtt", header.Filename)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"label": "English", "language": "en"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/captions/en", handler)
+
+	actual, err := client.UploadStreamCaption(context.Background(), testAccountID, testStreamVideoID, "en", strings.NewReader("WEBVTT\n"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, StreamCaption{Label: "English", Language: "en"}, actual)
+	}
+
+	_, err = client.UploadStreamCaption(context.Background(), testAccountID, testStreamVideoID, "", strings.NewReader("WEBVTT\n"))
+	assert.EqualError(t, err, "caption language cannot be empty")
+
+	_, err = client.UploadStreamCaption(context.Background(), testAccountID, testStreamVideoID, "en", nil)
+	assert.EqualError(t, err, "captions file cannot be empty")
+}
+
+func TestDeleteStreamCaption(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/captions/en", handler)
+
+	assert.NoError(t, client.DeleteStreamCaption(context.Background(), testAccountID, testStreamVideoID, "en"))
+}
@@ -0,0 +1,76 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// StreamDownload is the MP4 download of a video. The file is generated
+// asynchronously; URL serves it once Status is "ready".
+type StreamDownload struct {
+	Status          string  `json:"status"`
+	URL             string  `json:"url"`
+	PercentComplete float64 `json:"percentComplete"`
+}
+
+// StreamDownloads holds the downloads of a video.
+type StreamDownloads struct {
+	Default *StreamDownload `json:"default,omitempty"`
+}
+
+// StreamDownloadsResponse is the API response containing the downloads of
+// a video.
+type StreamDownloadsResponse struct {
+	Response
+	Result StreamDownloads `json:"result"`
+}
+
+// CreateStreamDownloads enables the MP4 download of a video.
+//
+// API reference: https://api.cloudflare.com/#stream-mp4-downloads-create-downloads
+func (api *API) CreateStreamDownloads(ctx context.Context, accountID, videoID string) (StreamDownloads, error) {
+	return api.streamDownloadsRequest(ctx, http.MethodPost, accountID, videoID)
+}
+
+// StreamDownloads returns the downloads of a video and their progress.
+//
+// API reference: https://api.cloudflare.com/#stream-mp4-downloads-list-downloads
+func (api *API) StreamDownloads(ctx context.Context, accountID, videoID string) (StreamDownloads, error) {
+	return api.streamDownloadsRequest(ctx, http.MethodGet, accountID, videoID)
+}
+
+// DeleteStreamDownloads disables the downloads of a video and deletes the
+// generated files.
+//
+// API reference: https://api.cloudflare.com/#stream-mp4-downloads-delete-downloads
+func (api *API) DeleteStreamDownloads(ctx context.Context, accountID, videoID string) error {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri+"/downloads", nil)
+	return err
+}
+
+func (api *API) streamDownloadsRequest(ctx context.Context, method, accountID, videoID string) (StreamDownloads, error) {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return StreamDownloads{}, err
+	}
+
+	res, err := api.makeRequestContext(ctx, method, uri+"/downloads", nil)
+	if err != nil {
+		return StreamDownloads{}, err
+	}
+
+	var r StreamDownloadsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamDownloads{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
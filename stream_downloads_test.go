@@ -0,0 +1,75 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateStreamDownloads(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"default": {"status": "inprogress", "url": "https://videodelivery.net/`+testStreamVideoID+`/downloads/default.mp4", "percentComplete": 75.5}}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/downloads", handler)
+
+	want := StreamDownloads{Default: &StreamDownload{
+		Status:          "inprogress",
+		URL:             "https://videodelivery.net/" + testStreamVideoID + "/downloads/default.mp4",
+		PercentComplete: 75.5,
+	}}
+
+	actual, err := client.CreateStreamDownloads(context.Background(), testAccountID, testStreamVideoID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestStreamDownloads(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/downloads", handler)
+
+	actual, err := client.StreamDownloads(context.Background(), testAccountID, testStreamVideoID)
+	if assert.NoError(t, err) {
+		assert.Nil(t, actual.Default)
+	}
+
+	_, err = client.StreamDownloads(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "video ID cannot be empty")
+}
+
+func TestDeleteStreamDownloads(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "ok"}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/downloads", handler)
+
+	assert.NoError(t, client.DeleteStreamDownloads(context.Background(), testAccountID, testStreamVideoID))
+}
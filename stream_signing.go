@@ -0,0 +1,253 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// streamSignedTokenDefaultTTL is how long signed tokens are valid for when
+// no expiry is given, matching the API's default.
+const streamSignedTokenDefaultTTL = time.Hour
+
+// StreamSigningKey is a key used to sign playback tokens for videos that
+// require signed URLs. PEM and JWK are only returned when the key is
+// created.
+type StreamSigningKey struct {
+	ID      string     `json:"id"`
+	PEM     string     `json:"pem,omitempty"`
+	JWK     string     `json:"jwk,omitempty"`
+	Created *time.Time `json:"created"`
+}
+
+// StreamAccessRule allows or blocks playback of a signed token. Rules are
+// evaluated in order and the first match applies.
+type StreamAccessRule struct {
+	// Type is "any", "ip.src" or "ip.geoip.country".
+	Type    string   `json:"type"`
+	Country []string `json:"country,omitempty"`
+	IP      []string `json:"ip,omitempty"`
+	// Action is "allow" or "block".
+	Action string `json:"action"`
+}
+
+// StreamSignedTokenParams restricts the playback a signed token grants.
+type StreamSignedTokenParams struct {
+	// Expiry defaults to one hour from now.
+	Expiry       *time.Time
+	NotBefore    *time.Time
+	Downloadable bool
+	AccessRules  []StreamAccessRule
+}
+
+type streamSignedTokenClaims struct {
+	Subject      string             `json:"sub,omitempty"`
+	KeyID        string             `json:"kid,omitempty"`
+	Expiry       int64              `json:"exp,omitempty"`
+	NotBefore    int64              `json:"nbf,omitempty"`
+	Downloadable bool               `json:"downloadable,omitempty"`
+	AccessRules  []StreamAccessRule `json:"accessRules,omitempty"`
+}
+
+func (p StreamSignedTokenParams) claims() streamSignedTokenClaims {
+	c := streamSignedTokenClaims{Downloadable: p.Downloadable, AccessRules: p.AccessRules}
+	if p.Expiry != nil {
+		c.Expiry = p.Expiry.Unix()
+	}
+	if p.NotBefore != nil {
+		c.NotBefore = p.NotBefore.Unix()
+	}
+	return c
+}
+
+// StreamSigningKeyResponse is the API response containing a signing key.
+type StreamSigningKeyResponse struct {
+	Response
+	Result StreamSigningKey `json:"result"`
+}
+
+// StreamSigningKeysResponse is the API response containing the signing
+// keys of an account.
+type StreamSigningKeysResponse struct {
+	Response
+	Result []StreamSigningKey `json:"result"`
+}
+
+// StreamSignedTokenResponse is the API response containing a signed token.
+type StreamSignedTokenResponse struct {
+	Response
+	Result struct {
+		Token string `json:"token"`
+	} `json:"result"`
+}
+
+// CreateStreamSigningKey creates a key for signing playback tokens
+// locally with SignStreamToken. The private key is only returned here.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-create-a-signing-key
+func (api *API) CreateStreamSigningKey(ctx context.Context, accountID string) (StreamSigningKey, error) {
+	if accountID == "" {
+		return StreamSigningKey{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeyResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamSigningKey{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// ListStreamSigningKeys returns the IDs and creation dates of the signing
+// keys of an account.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-list-signing-keys
+func (api *API) ListStreamSigningKeys(ctx context.Context, accountID string) ([]StreamSigningKey, error) {
+	if accountID == "" {
+		return []StreamSigningKey{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamSigningKey{}, err
+	}
+
+	var r StreamSigningKeysResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamSigningKey{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// DeleteStreamSigningKey deletes a signing key, invalidating the tokens
+// signed with it.
+//
+// API reference: https://api.cloudflare.com/#stream-signing-keys-delete-signing-keys
+func (api *API) DeleteStreamSigningKey(ctx context.Context, accountID, keyID string) error {
+	if accountID == "" {
+		return errors.New(errMissingAccountID)
+	}
+	if keyID == "" {
+		return errors.New("signing key ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/keys/%s", accountID, keyID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+// CreateStreamSignedToken has the API sign a playback token for a video.
+// It suits low volumes; use SignStreamToken to sign tokens without an API
+// call per token.
+//
+// API reference: https://api.cloudflare.com/#stream-videos-create-signed-url-tokens-for-videos
+func (api *API) CreateStreamSignedToken(ctx context.Context, accountID, videoID string, params StreamSignedTokenParams) (string, error) {
+	uri, err := streamVideoURI(accountID, videoID)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri+"/token", params.claims())
+	if err != nil {
+		return "", err
+	}
+
+	var r StreamSignedTokenResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result.Token, nil
+}
+
+// SignStreamToken signs a playback token for a video locally with a key
+// from CreateStreamSigningKey. The token replaces the video ID in playback
+// URLs.
+func SignStreamToken(key StreamSigningKey, videoID string, params StreamSignedTokenParams) (string, error) {
+	if key.ID == "" || key.PEM == "" {
+		return "", errors.New("signing key ID and PEM cannot be empty")
+	}
+	if videoID == "" {
+		return "", errors.New("video ID cannot be empty")
+	}
+
+	privateKey, err := parseStreamSigningKey(key.PEM)
+	if err != nil {
+		return "", err
+	}
+
+	claims := params.claims()
+	claims.Subject = videoID
+	claims.KeyID = key.ID
+	if claims.Expiry == 0 {
+		claims.Expiry = time.Now().Add(streamSignedTokenDefaultTTL).Unix()
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": key.ID})
+	if err != nil {
+		return "", err
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", errors.Wrap(err, "could not sign token")
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parseStreamSigningKey parses the private key of a signing key, which the
+// API returns as base64 encoded PEM.
+func parseStreamSigningKey(encoded string) (*rsa.PrivateKey, error) {
+	pemBytes := []byte(encoded)
+	if !strings.HasPrefix(encoded, "-----BEGIN") {
+		decoded, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not decode signing key")
+		}
+		pemBytes = decoded
+	}
+
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("signing key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse signing key")
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("signing key is not an RSA key")
+	}
+	return rsaKey, nil
+}
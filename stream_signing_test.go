@@ -0,0 +1,157 @@
+package cloudflare
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateStreamSigningKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "8f926b2b01f383510025a78a4dcbf6a", "pem": "LS0tLS1CRUdJTi", "jwk": "eyJ1c2UiOiJzaWci", "created": "2022-08-15T18:00:00Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/keys", handler)
+
+	created, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+
+	actual, err := client.CreateStreamSigningKey(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, StreamSigningKey{ID: "8f926b2b01f383510025a78a4dcbf6a", PEM: "LS0tLS1CRUdJTi", JWK: "eyJ1c2UiOiJzaWci", Created: &created}, actual)
+	}
+}
+
+func TestListStreamSigningKeys(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": [{"id": "8f926b2b01f383510025a78a4dcbf6a", "created": "2022-08-15T18:00:00Z"}]}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/keys", handler)
+
+	actual, err := client.ListStreamSigningKeys(context.Background(), testAccountID)
+	if assert.NoError(t, err) && assert.Len(t, actual, 1) {
+		assert.Equal(t, "8f926b2b01f383510025a78a4dcbf6a", actual[0].ID)
+	}
+}
+
+func TestDeleteStreamSigningKey(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": "ok"}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/keys/8f926b2b01f383510025a78a4dcbf6a", handler)
+
+	assert.NoError(t, client.DeleteStreamSigningKey(context.Background(), testAccountID, "8f926b2b01f383510025a78a4dcbf6a"))
+
+	err := client.DeleteStreamSigningKey(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "signing key ID cannot be empty")
+}
+
+func TestCreateStreamSignedToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"exp": 1660590000,
+				"downloadable": true,
+				"accessRules": [
+					{"type": "ip.geoip.country", "country": ["US"], "action": "allow"},
+					{"type": "any", "action": "block"}
+				]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": {"token": "eyJhbGciOiJSUzI1NiIsImtpZCI6IjhmOTI2YjJi"}}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID+"/token", handler)
+
+	exp := time.Unix(1660590000, 0)
+	actual, err := client.CreateStreamSignedToken(context.Background(), testAccountID, testStreamVideoID, StreamSignedTokenParams{
+		Expiry:       &exp,
+		Downloadable: true,
+		AccessRules: []StreamAccessRule{
+			{Type: "ip.geoip.country", Country: []string{"US"}, Action: "allow"},
+			{Type: "any", Action: "block"},
+		},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, "eyJhbGciOiJSUzI1NiIsImtpZCI6IjhmOTI2YjJi", actual)
+	}
+}
+
+func TestSignStreamToken(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if !assert.NoError(t, err) {
+		return
+	}
+	pemBytes := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(privateKey)})
+	key := StreamSigningKey{ID: "8f926b2b01f383510025a78a4dcbf6a", PEM: base64.StdEncoding.EncodeToString(pemBytes)}
+
+	exp := time.Unix(1660590000, 0)
+	token, err := SignStreamToken(key, testStreamVideoID, StreamSignedTokenParams{Expiry: &exp})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	parts := strings.Split(token, ".")
+	if !assert.Len(t, parts, 3) {
+		return
+	}
+
+	header, _ := base64.RawURLEncoding.DecodeString(parts[0])
+	assert.JSONEq(t, `{"alg": "RS256", "kid": "8f926b2b01f383510025a78a4dcbf6a"}`, string(header))
+
+	payload, _ := base64.RawURLEncoding.DecodeString(parts[1])
+	var claims map[string]interface{}
+	if assert.NoError(t, json.Unmarshal(payload, &claims)) {
+		assert.Equal(t, testStreamVideoID, claims["sub"])
+		assert.Equal(t, "8f926b2b01f383510025a78a4dcbf6a", claims["kid"])
+		assert.Equal(t, float64(1660590000), claims["exp"])
+	}
+
+	signature, _ := base64.RawURLEncoding.DecodeString(parts[2])
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	assert.NoError(t, rsa.VerifyPKCS1v15(&privateKey.PublicKey, crypto.SHA256, digest[:], signature))
+
+	_, err = SignStreamToken(StreamSigningKey{ID: key.ID, PEM: "bm90IGEga2V5"}, testStreamVideoID, StreamSignedTokenParams{})
+	assert.EqualError(t, err, "signing key is not PEM encoded")
+}
@@ -0,0 +1,160 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStreamVideoID = "ea95132c15732412d22c1476fa83f27a"
+
+const testStreamVideoJSON = `{
+	"uid": "ea95132c15732412d22c1476fa83f27a",
+	"creator": "creator-id_abcde12345",
+	"thumbnail": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/thumbnails/thumbnail.jpg",
+	"thumbnailTimestampPct": 0.529241,
+	"readyToStream": true,
+	"status": {"state": "ready", "pctComplete": "100.000000"},
+	"meta": {"name": "demo.mp4"},
+	"created": "2022-08-15T18:00:00Z",
+	"modified": "2022-08-15T18:05:00Z",
+	"size": 4190963,
+	"preview": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/watch",
+	"allowedOrigins": ["example.com"],
+	"requireSignedURLs": true,
+	"duration": 5.5,
+	"input": {"width": 1920, "height": 1080},
+	"playback": {
+		"hls": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/manifest/video.m3u8",
+		"dash": "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/manifest/video.mpd"
+	}
+}`
+
+func expectedStreamVideo() StreamVideo {
+	created, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+	modified, _ := time.Parse(time.RFC3339, "2022-08-15T18:05:00Z")
+
+	return StreamVideo{
+		UID:                   testStreamVideoID,
+		Creator:               "creator-id_abcde12345",
+		Thumbnail:             "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/thumbnails/thumbnail.jpg",
+		ThumbnailTimestampPct: 0.529241,
+		ReadyToStream:         true,
+		Status:                StreamVideoStatus{State: StreamVideoStateReady, PctComplete: "100.000000"},
+		Meta:                  map[string]interface{}{"name": "demo.mp4"},
+		Created:               &created,
+		Modified:              &modified,
+		Size:                  4190963,
+		Preview:               "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/watch",
+		AllowedOrigins:        []string{"example.com"},
+		RequireSignedURLs:     true,
+		Duration:              5.5,
+		Input:                 StreamVideoInput{Width: 1920, Height: 1080},
+		Playback: StreamVideoPlayback{
+			HLS:  "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/manifest/video.m3u8",
+			Dash: "https://customer-m033z5x00ks6nunl.cloudflarestream.com/ea95132c15732412d22c1476fa83f27a/manifest/video.mpd",
+		},
+	}
+}
+
+func TestListStreamVideos(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2022-08-01T00:00:00Z", r.URL.Query().Get("before"))
+		assert.Equal(t, "ready", r.URL.Query().Get("status"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s], "total": "1", "range": "1"}`, testStreamVideoJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream", handler)
+
+	before, _ := time.Parse(time.RFC3339, "2022-08-01T00:00:00Z")
+	actual, err := client.ListStreamVideos(context.Background(), testAccountID, StreamListParams{Before: &before, Status: StreamVideoStateReady})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []StreamVideo{expectedStreamVideo()}, actual)
+	}
+}
+
+func TestStreamVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testStreamVideoJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID, handler)
+
+	actual, err := client.StreamVideo(context.Background(), testAccountID, testStreamVideoID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, expectedStreamVideo(), actual)
+	}
+
+	_, err = client.StreamVideo(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "video ID cannot be empty")
+}
+
+func TestDeleteStreamVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/"+testStreamVideoID, handler)
+
+	assert.NoError(t, client.DeleteStreamVideo(context.Background(), testAccountID, testStreamVideoID))
+}
+
+func TestCreateStreamDirectUploadURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"maxDurationSeconds": 3600, "requireSignedURLs": true, "allowedOrigins": ["example.com"]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"uploadURL": "https://upload.videodelivery.net/ea95132c15732412d22c1476fa83f27a",
+				"uid": "ea95132c15732412d22c1476fa83f27a"
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/direct_upload", handler)
+
+	actual, err := client.CreateStreamDirectUploadURL(context.Background(), testAccountID, StreamDirectUploadParams{
+		MaxDurationSeconds: 3600,
+		RequireSignedURLs:  true,
+		AllowedOrigins:     []string{"example.com"},
+	})
+	if assert.NoError(t, err) {
+		assert.Equal(t, StreamDirectUpload{
+			UploadURL: "https://upload.videodelivery.net/ea95132c15732412d22c1476fa83f27a",
+			UID:       testStreamVideoID,
+		}, actual)
+	}
+
+	_, err = client.CreateStreamDirectUploadURL(context.Background(), testAccountID, StreamDirectUploadParams{})
+	assert.EqualError(t, err, "max duration seconds must be positive")
+}
@@ -0,0 +1,224 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const tusVersion = "1.0.0"
+
+// Chunk sizes of resumable uploads. Chunks other than the last must be at
+// least StreamTUSMinChunkSize and a multiple of 256 KiB.
+const (
+	StreamTUSMinChunkSize     = 5 << 20
+	StreamTUSDefaultChunkSize = 50 << 20
+	streamTUSChunkMultiple    = 256 << 10
+)
+
+// StreamTUSUploadParams describes a video to upload with the tus
+// resumable upload protocol.
+type StreamTUSUploadParams struct {
+	// Size is the total size of the video in bytes.
+	Size               int64
+	Name               string
+	RequireSignedURLs  bool
+	MaxDurationSeconds int
+}
+
+// StreamTUSUpload is a resumable upload. URL accepts the video's bytes
+// and does not require the account's credentials.
+type StreamTUSUpload struct {
+	URL     string
+	VideoID string
+}
+
+// CreateStreamTUSUpload starts a resumable upload of a video. The content
+// is sent with ResumeStreamTUSUpload.
+//
+// API reference: https://developers.cloudflare.com/stream/uploading-videos/upload-video-file/#resumable-uploads-with-tus-for-large-files
+func (api *API) CreateStreamTUSUpload(ctx context.Context, accountID string, params StreamTUSUploadParams) (StreamTUSUpload, error) {
+	if accountID == "" {
+		return StreamTUSUpload{}, errors.New(errMissingAccountID)
+	}
+	if params.Size <= 0 {
+		return StreamTUSUpload{}, errors.New("upload size must be positive")
+	}
+
+	var metadata []string
+	if params.Name != "" {
+		metadata = append(metadata, "name "+base64.StdEncoding.EncodeToString([]byte(params.Name)))
+	}
+	if params.RequireSignedURLs {
+		metadata = append(metadata, "requiresignedurls")
+	}
+	if params.MaxDurationSeconds > 0 {
+		metadata = append(metadata, "maxDurationSeconds "+base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(params.MaxDurationSeconds))))
+	}
+
+	headers := make(http.Header)
+	headers.Set("Tus-Resumable", tusVersion)
+	headers.Set("Upload-Length", strconv.FormatInt(params.Size, 10))
+	if len(metadata) > 0 {
+		headers.Set("Upload-Metadata", strings.Join(metadata, ","))
+	}
+
+	if err := api.rateLimiter.Wait(ctx); err != nil {
+		return StreamTUSUpload{}, errors.Wrap(err, "Error caused by request rate limiting")
+	}
+	resp, err := api.request(ctx, http.MethodPost, fmt.Sprintf("/accounts/%s/stream", accountID), nil, api.authType, headers)
+	if err != nil {
+		return StreamTUSUpload{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated {
+		return StreamTUSUpload{}, tusResponseError(resp)
+	}
+
+	upload := StreamTUSUpload{
+		URL:     resp.Header.Get("Location"),
+		VideoID: resp.Header.Get("Stream-Media-Id"),
+	}
+	if upload.URL == "" {
+		return StreamTUSUpload{}, errors.New("tus upload response did not include a location")
+	}
+	return upload, nil
+}
+
+// StreamTUSUploadOffset returns how many bytes of a resumable upload the
+// server has received, which is where an interrupted upload resumes.
+func (api *API) StreamTUSUploadOffset(ctx context.Context, uploadURL string) (int64, error) {
+	resp, err := api.tusRequest(ctx, http.MethodHead, uploadURL, nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return 0, tusResponseError(resp)
+	}
+	return tusUploadOffset(resp)
+}
+
+// ResumeStreamTUSUpload sends r, which must be positioned at offset, to a
+// resumable upload in chunks of chunkSize bytes (StreamTUSDefaultChunkSize
+// if zero). It returns the offset reached, so a failed upload can be
+// resumed from there.
+func (api *API) ResumeStreamTUSUpload(ctx context.Context, uploadURL string, offset int64, r io.Reader, chunkSize int) (int64, error) {
+	if chunkSize == 0 {
+		chunkSize = StreamTUSDefaultChunkSize
+	}
+	if chunkSize < StreamTUSMinChunkSize || chunkSize%streamTUSChunkMultiple != 0 {
+		return offset, errors.Errorf("chunk size must be a multiple of %d bytes of at least %d bytes", streamTUSChunkMultiple, StreamTUSMinChunkSize)
+	}
+
+	buf := make([]byte, chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if err == io.EOF {
+			return offset, nil
+		}
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return offset, errors.Wrap(err, "could not read upload content")
+		}
+
+		headers := make(http.Header)
+		headers.Set("Upload-Offset", strconv.FormatInt(offset, 10))
+		headers.Set("Content-Type", "application/offset+octet-stream")
+
+		resp, reqErr := api.tusRequest(ctx, http.MethodPatch, uploadURL, bytes.NewReader(buf[:n]), headers)
+		if reqErr != nil {
+			return offset, reqErr
+		}
+		if resp.StatusCode != http.StatusNoContent {
+			reqErr = tusResponseError(resp)
+			resp.Body.Close()
+			return offset, reqErr
+		}
+		next, reqErr := tusUploadOffset(resp)
+		resp.Body.Close()
+		if reqErr != nil {
+			return offset, reqErr
+		}
+		offset = next
+
+		if err == io.ErrUnexpectedEOF {
+			return offset, nil
+		}
+	}
+}
+
+// UploadStreamVideo uploads a video read from r with the tus resumable
+// upload protocol and returns the upload, whose VideoID identifies the
+// new video. On failure, the upload can be continued with
+// StreamTUSUploadOffset and ResumeStreamTUSUpload.
+func (api *API) UploadStreamVideo(ctx context.Context, accountID string, params StreamTUSUploadParams, r io.Reader) (StreamTUSUpload, error) {
+	upload, err := api.CreateStreamTUSUpload(ctx, accountID, params)
+	if err != nil {
+		return StreamTUSUpload{}, err
+	}
+
+	offset, err := api.ResumeStreamTUSUpload(ctx, upload.URL, 0, r, 0)
+	if err != nil {
+		return upload, err
+	}
+	if offset != params.Size {
+		return upload, errors.Errorf("uploaded %d of %d bytes", offset, params.Size)
+	}
+	return upload, nil
+}
+
+// tusRequest makes a request to a tus upload URL. The URL authorises the
+// upload itself, so the client's credentials are not sent.
+func (api *API) tusRequest(ctx context.Context, method, uploadURL string, body io.Reader, headers http.Header) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, uploadURL, body)
+	if err != nil {
+		return nil, errors.Wrap(err, "HTTP request creation failed")
+	}
+
+	copyHeader(req.Header, headers)
+	req.Header.Set("Tus-Resumable", tusVersion)
+	if api.UserAgent != "" {
+		req.Header.Set("User-Agent", api.UserAgent)
+	}
+
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "HTTP request failed")
+	}
+	return resp, nil
+}
+
+func tusUploadOffset(resp *http.Response) (int64, error) {
+	offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid tus upload offset")
+	}
+	return offset, nil
+}
+
+func tusResponseError(resp *http.Response) error {
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "could not read response body")
+	}
+
+	errBody := &Response{}
+	if err := json.Unmarshal(respBody, &errBody); err != nil || len(errBody.Errors) == 0 {
+		return errors.Errorf("HTTP status %d: %s", resp.StatusCode, bytes.TrimSpace(respBody))
+	}
+	return &APIRequestError{
+		StatusCode: resp.StatusCode,
+		Errors:     errBody.Errors,
+	}
+}
@@ -0,0 +1,107 @@
+package cloudflare
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUploadStreamVideo(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("v"), StreamTUSMinChunkSize*2+10)
+	var received []byte
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "1.0.0", r.Header.Get("Tus-Resumable"))
+		assert.Equal(t, strconv.Itoa(len(content)), r.Header.Get("Upload-Length"))
+		assert.Equal(t, "name ZGVtby5tcDQ=,requiresignedurls", r.Header.Get("Upload-Metadata"))
+		w.Header().Set("Location", server.URL+"/tus/"+testStreamVideoID)
+		w.Header().Set("Stream-Media-Id", testStreamVideoID)
+		w.WriteHeader(http.StatusCreated)
+	})
+	mux.HandleFunc("/tus/"+testStreamVideoID, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		assert.Equal(t, "application/offset+octet-stream", r.Header.Get("Content-Type"))
+		assert.Empty(t, r.Header.Get("X-Auth-Key"))
+		assert.Equal(t, strconv.Itoa(len(received)), r.Header.Get("Upload-Offset"))
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			received = append(received, body...)
+		}
+		w.Header().Set("Upload-Offset", strconv.Itoa(len(received)))
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	upload, err := client.UploadStreamVideo(context.Background(), testAccountID, StreamTUSUploadParams{
+		Size:              int64(len(content)),
+		Name:              "demo.mp4",
+		RequireSignedURLs: true,
+	}, bytes.NewReader(content))
+	if assert.NoError(t, err) {
+		assert.Equal(t, testStreamVideoID, upload.VideoID)
+		assert.Equal(t, content, received)
+	}
+}
+
+func TestResumeStreamTUSUpload(t *testing.T) {
+	setup()
+	defer teardown()
+
+	content := bytes.Repeat([]byte("v"), StreamTUSMinChunkSize+10)
+	const resumeAt = 4
+
+	calls := 0
+	mux.HandleFunc("/tus/"+testStreamVideoID, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodHead:
+			w.Header().Set("Upload-Offset", strconv.Itoa(resumeAt))
+			w.WriteHeader(http.StatusOK)
+		case http.MethodPatch:
+			calls++
+			offset, _ := strconv.Atoi(r.Header.Get("Upload-Offset"))
+			body, _ := ioutil.ReadAll(r.Body)
+			w.Header().Set("Upload-Offset", strconv.Itoa(offset+len(body)))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected method %s", r.Method)
+		}
+	})
+
+	uploadURL := server.URL + "/tus/" + testStreamVideoID
+	offset, err := client.StreamTUSUploadOffset(context.Background(), uploadURL)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(resumeAt), offset)
+	}
+
+	offset, err = client.ResumeStreamTUSUpload(context.Background(), uploadURL, offset, bytes.NewReader(content[resumeAt:]), StreamTUSMinChunkSize)
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(len(content)), offset)
+		assert.Equal(t, 2, calls)
+	}
+
+	_, err = client.ResumeStreamTUSUpload(context.Background(), uploadURL, 0, bytes.NewReader(content), 1000)
+	assert.EqualError(t, err, fmt.Sprintf("chunk size must be a multiple of 262144 bytes of at least %d bytes", StreamTUSMinChunkSize))
+}
+
+func TestResumeStreamTUSUploadError(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/tus/"+testStreamVideoID, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, "upload offset mismatch")
+	})
+
+	offset, err := client.ResumeStreamTUSUpload(context.Background(), server.URL+"/tus/"+testStreamVideoID, 7, bytes.NewReader([]byte("video")), 0)
+	assert.EqualError(t, err, "HTTP status 409: upload offset mismatch")
+	assert.Equal(t, int64(7), offset)
+}
@@ -0,0 +1,188 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Watermark positions.
+const (
+	StreamWatermarkPositionUpperRight = "upperRight"
+	StreamWatermarkPositionUpperLeft  = "upperLeft"
+	StreamWatermarkPositionLowerLeft  = "lowerLeft"
+	StreamWatermarkPositionLowerRight = "lowerRight"
+	StreamWatermarkPositionCenter     = "center"
+)
+
+// StreamWatermark is a watermark profile, an image overlaid on videos
+// uploaded with it.
+type StreamWatermark struct {
+	UID            string     `json:"uid"`
+	Name           string     `json:"name"`
+	Size           int64      `json:"size"`
+	Height         int        `json:"height"`
+	Width          int        `json:"width"`
+	Created        *time.Time `json:"created"`
+	DownloadedFrom string     `json:"downloadedFrom"`
+	Opacity        float64    `json:"opacity"`
+	Padding        float64    `json:"padding"`
+	Scale          float64    `json:"scale"`
+	Position       string     `json:"position"`
+}
+
+// StreamWatermarkRef refers to the watermark profile to apply to an
+// upload.
+type StreamWatermarkRef struct {
+	UID string `json:"uid"`
+}
+
+// StreamWatermarkParams configures a new watermark profile. The image is
+// either uploaded or, if URL is set, fetched from URL.
+type StreamWatermarkParams struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+	// Opacity is between 0 (transparent) and 1 (opaque).
+	Opacity *float64 `json:"opacity,omitempty"`
+	// Padding is the blank space between the image and the edges of the
+	// video, as a ratio of the video's dimensions.
+	Padding *float64 `json:"padding,omitempty"`
+	// Scale is the size of the image relative to the video. 0 keeps the
+	// image at its original size.
+	Scale    *float64 `json:"scale,omitempty"`
+	Position string   `json:"position,omitempty"`
+}
+
+// StreamWatermarkResponse is the API response containing a watermark
+// profile.
+type StreamWatermarkResponse struct {
+	Response
+	Result StreamWatermark `json:"result"`
+}
+
+// StreamWatermarksResponse is the API response containing the watermark
+// profiles of an account.
+type StreamWatermarksResponse struct {
+	Response
+	Result []StreamWatermark `json:"result"`
+}
+
+// ListStreamWatermarks returns the watermark profiles of an account.
+//
+// API reference: https://api.cloudflare.com/#stream-watermark-profile-list-watermark-profiles
+func (api *API) ListStreamWatermarks(ctx context.Context, accountID string) ([]StreamWatermark, error) {
+	if accountID == "" {
+		return []StreamWatermark{}, errors.New(errMissingAccountID)
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks", accountID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []StreamWatermark{}, err
+	}
+
+	var r StreamWatermarksResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []StreamWatermark{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// StreamWatermark returns a watermark profile.
+//
+// API reference: https://api.cloudflare.com/#stream-watermark-profile-watermark-profile-details
+func (api *API) StreamWatermark(ctx context.Context, accountID, watermarkID string) (StreamWatermark, error) {
+	uri, err := streamWatermarkURI(accountID, watermarkID)
+	if err != nil {
+		return StreamWatermark{}, err
+	}
+	return api.streamWatermarkRequest(ctx, http.MethodGet, uri, nil, nil)
+}
+
+// CreateStreamWatermark creates a watermark profile from an image read
+// from image, or fetched from params.URL if image is nil.
+//
+// API reference: https://api.cloudflare.com/#stream-watermark-profile-create-watermark-profiles-via-basic-upload
+func (api *API) CreateStreamWatermark(ctx context.Context, accountID string, params StreamWatermarkParams, image io.Reader) (StreamWatermark, error) {
+	if accountID == "" {
+		return StreamWatermark{}, errors.New(errMissingAccountID)
+	}
+	if (image == nil) == (params.URL == "") {
+		return StreamWatermark{}, errors.New("watermark requires either an image or a URL")
+	}
+
+	uri := fmt.Sprintf("/accounts/%s/stream/watermarks", accountID)
+	if image == nil {
+		return api.streamWatermarkRequest(ctx, http.MethodPost, uri, params, nil)
+	}
+
+	fields := map[string]string{}
+	if params.Name != "" {
+		fields["name"] = params.Name
+	}
+	if params.Opacity != nil {
+		fields["opacity"] = strconv.FormatFloat(*params.Opacity, 'f', -1, 64)
+	}
+	if params.Padding != nil {
+		fields["padding"] = strconv.FormatFloat(*params.Padding, 'f', -1, 64)
+	}
+	if params.Scale != nil {
+		fields["scale"] = strconv.FormatFloat(*params.Scale, 'f', -1, 64)
+	}
+	if params.Position != "" {
+		fields["position"] = params.Position
+	}
+
+	contentType, body, err := multipartFormBody(fields, "watermark", image)
+	if err != nil {
+		return StreamWatermark{}, err
+	}
+	headers := make(http.Header)
+	headers.Set("Content-Type", contentType)
+	return api.streamWatermarkRequest(ctx, http.MethodPost, uri, body, headers)
+}
+
+// DeleteStreamWatermark deletes a watermark profile. Videos already
+// watermarked with it are not changed.
+//
+// API reference: https://api.cloudflare.com/#stream-watermark-profile-delete-watermark-profiles
+func (api *API) DeleteStreamWatermark(ctx context.Context, accountID, watermarkID string) error {
+	uri, err := streamWatermarkURI(accountID, watermarkID)
+	if err != nil {
+		return err
+	}
+
+	_, err = api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
+
+func (api *API) streamWatermarkRequest(ctx context.Context, method, uri string, params interface{}, headers http.Header) (StreamWatermark, error) {
+	res, err := api.makeRequestContextWithHeaders(ctx, method, uri, params, headers)
+	if err != nil {
+		return StreamWatermark{}, err
+	}
+
+	var r StreamWatermarkResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return StreamWatermark{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func streamWatermarkURI(accountID, watermarkID string) (string, error) {
+	if accountID == "" {
+		return "", errors.New(errMissingAccountID)
+	}
+	if watermarkID == "" {
+		return "", errors.New("watermark ID cannot be empty")
+	}
+	return fmt.Sprintf("/accounts/%s/stream/watermarks/%s", accountID, watermarkID), nil
+}
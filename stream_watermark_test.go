@@ -0,0 +1,160 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testStreamWatermarkJSON = `{
+	"uid": "ea95132c15732412d22c1476fa83f27a",
+	"size": 29472,
+	"height": 600,
+	"width": 400,
+	"created": "2022-08-15T18:00:00Z",
+	"downloadedFrom": "https://company.com/logo.png",
+	"name": "Marketing Videos",
+	"opacity": 0.75,
+	"padding": 0.1,
+	"scale": 0.1,
+	"position": "center"
+}`
+
+func testStreamWatermark() StreamWatermark {
+	created, _ := time.Parse(time.RFC3339, "2022-08-15T18:00:00Z")
+	return StreamWatermark{
+		UID:            "ea95132c15732412d22c1476fa83f27a",
+		Size:           29472,
+		Height:         600,
+		Width:          400,
+		Created:        &created,
+		DownloadedFrom: "https://company.com/logo.png",
+		Name:           "Marketing Videos",
+		Opacity:        0.75,
+		Padding:        0.1,
+		Scale:          0.1,
+		Position:       StreamWatermarkPositionCenter,
+	}
+}
+
+func TestListStreamWatermarks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": [%s]}`, testStreamWatermarkJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks", handler)
+
+	actual, err := client.ListStreamWatermarks(context.Background(), testAccountID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, []StreamWatermark{testStreamWatermark()}, actual)
+	}
+}
+
+func TestStreamWatermark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testStreamWatermarkJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks/ea95132c15732412d22c1476fa83f27a", handler)
+
+	actual, err := client.StreamWatermark(context.Background(), testAccountID, "ea95132c15732412d22c1476fa83f27a")
+	if assert.NoError(t, err) {
+		assert.Equal(t, testStreamWatermark(), actual)
+	}
+
+	_, err = client.StreamWatermark(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "watermark ID cannot be empty")
+}
+
+func TestCreateStreamWatermarkFromURL(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "Marketing Videos", "url": "https://company.com/logo.png", "opacity": 0.75, "position": "center"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testStreamWatermarkJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks", handler)
+
+	opacity := 0.75
+	actual, err := client.CreateStreamWatermark(context.Background(), testAccountID, StreamWatermarkParams{
+		Name:     "Marketing Videos",
+		URL:      "https://company.com/logo.png",
+		Opacity:  &opacity,
+		Position: StreamWatermarkPositionCenter,
+	}, nil)
+	if assert.NoError(t, err) {
+		assert.Equal(t, testStreamWatermark(), actual)
+	}
+}
+
+func TestCreateStreamWatermarkFromImage(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.True(t, strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"))
+		file, _, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			content, _ := ioutil.ReadAll(file)
+			assert.Equal(t, "PNG", string(content))
+		}
+		assert.Equal(t, "Marketing Videos", r.FormValue("name"))
+		assert.Equal(t, "0.1", r.FormValue("scale"))
+		assert.Equal(t, "", r.FormValue("opacity"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": %s}`, testStreamWatermarkJSON)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks", handler)
+
+	scale := 0.1
+	actual, err := client.CreateStreamWatermark(context.Background(), testAccountID, StreamWatermarkParams{
+		Name:  "Marketing Videos",
+		Scale: &scale,
+	}, strings.NewReader("PNG"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, testStreamWatermark(), actual)
+	}
+
+	_, err = client.CreateStreamWatermark(context.Background(), testAccountID, StreamWatermarkParams{}, nil)
+	assert.EqualError(t, err, "watermark requires either an image or a URL")
+}
+
+func TestDeleteStreamWatermark(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"success": true, "errors": [], "messages": [], "result": ""}`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/stream/watermarks/ea95132c15732412d22c1476fa83f27a", handler)
+
+	assert.NoError(t, client.DeleteStreamWatermark(context.Background(), testAccountID, "ea95132c15732412d22c1476fa83f27a"))
+}
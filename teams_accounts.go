@@ -0,0 +1,166 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TeamsAccount represents the Gateway account details.
+type TeamsAccount struct {
+	GatewayTag   string `json:"gateway_tag"`   // Internal teams ID
+	ProviderName string `json:"provider_name"` // Auth provider
+	ID           string `json:"id"`            // cloudflare account ID
+}
+
+// TeamsAccountResponse is the API response, containing information on teams
+// account.
+type TeamsAccountResponse struct {
+	Response
+	Result TeamsAccount `json:"result"`
+}
+
+// TeamsConfiguration represents the Gateway configuration of an account.
+type TeamsConfiguration struct {
+	Settings  TeamsAccountSettings `json:"settings"`
+	CreatedAt *time.Time           `json:"created_at,omitempty"`
+	UpdatedAt *time.Time           `json:"updated_at,omitempty"`
+}
+
+// TeamsConfigResponse is the API response, containing the Gateway
+// configuration of an account.
+type TeamsConfigResponse struct {
+	Response
+	Result TeamsConfiguration `json:"result"`
+}
+
+// TeamsAccountSettings holds the Gateway settings of an account.
+type TeamsAccountSettings struct {
+	Antivirus             *TeamsAntivirus             `json:"antivirus,omitempty"`
+	TLSDecrypt            *TeamsTLSDecrypt            `json:"tls_decrypt,omitempty"`
+	ActivityLog           *TeamsActivityLog           `json:"activity_log,omitempty"`
+	BlockPage             *TeamsBlockPage             `json:"block_page,omitempty"`
+	BodyScanning          *TeamsBodyScanning          `json:"body_scanning,omitempty"`
+	FIPS                  *TeamsFIPS                  `json:"fips,omitempty"`
+	ExtendedEmailMatching *TeamsExtendedEmailMatching `json:"extended_email_matching,omitempty"`
+}
+
+// TeamsAntivirus configures anti-virus scanning of uploads and downloads.
+type TeamsAntivirus struct {
+	EnabledDownloadPhase bool `json:"enabled_download_phase"`
+	EnabledUploadPhase   bool `json:"enabled_upload_phase"`
+	FailClosed           bool `json:"fail_closed"`
+}
+
+// TeamsTLSDecrypt configures TLS decryption of proxied HTTPS traffic.
+type TeamsTLSDecrypt struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TeamsActivityLog configures whether Gateway activity is logged.
+type TeamsActivityLog struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TeamsBlockPage customises the page shown when Gateway blocks a request.
+type TeamsBlockPage struct {
+	Enabled         *bool  `json:"enabled,omitempty"`
+	FooterText      string `json:"footer_text,omitempty"`
+	HeaderText      string `json:"header_text,omitempty"`
+	LogoPath        string `json:"logo_path,omitempty"`
+	BackgroundColor string `json:"background_color,omitempty"`
+	Name            string `json:"name,omitempty"`
+	MailtoAddress   string `json:"mailto_address,omitempty"`
+	MailtoSubject   string `json:"mailto_subject,omitempty"`
+	SuppressFooter  *bool  `json:"suppress_footer,omitempty"`
+}
+
+// TeamsInspectionMode represents how deeply request bodies are scanned.
+type TeamsInspectionMode string
+
+// These constants represent all valid body scanning inspection modes.
+const (
+	TeamsShallowInspectionMode TeamsInspectionMode = "shallow"
+	TeamsDeepInspectionMode    TeamsInspectionMode = "deep"
+)
+
+// TeamsBodyScanning configures scanning of HTTP request and response bodies.
+type TeamsBodyScanning struct {
+	InspectionMode TeamsInspectionMode `json:"inspection_mode,omitempty"`
+}
+
+// TeamsFIPS configures whether only FIPS compliant TLS ciphers are used.
+type TeamsFIPS struct {
+	TLS bool `json:"tls"`
+}
+
+// TeamsExtendedEmailMatching configures whether email matching in policies
+// also matches on plus-addressed and dotted variants of an address.
+type TeamsExtendedEmailMatching struct {
+	Enabled bool `json:"enabled"`
+}
+
+// TeamsAccount returns the Gateway account details.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-accounts-get-zero-trust-account-information
+func (api *API) TeamsAccount(ctx context.Context, accountID string) (TeamsAccount, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TeamsAccount{}, err
+	}
+
+	var teamsAccountResponse TeamsAccountResponse
+	err = json.Unmarshal(res, &teamsAccountResponse)
+	if err != nil {
+		return TeamsAccount{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsAccountResponse.Result, nil
+}
+
+// TeamsAccountConfiguration returns the Gateway configuration of an account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-accounts-get-zero-trust-account-configuration
+func (api *API) TeamsAccountConfiguration(ctx context.Context, accountID string) (TeamsConfiguration, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/configuration", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TeamsConfiguration{}, err
+	}
+
+	var teamsConfigResponse TeamsConfigResponse
+	err = json.Unmarshal(res, &teamsConfigResponse)
+	if err != nil {
+		return TeamsConfiguration{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsConfigResponse.Result, nil
+}
+
+// TeamsAccountUpdateConfiguration updates the Gateway configuration of an
+// account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-accounts-update-zero-trust-account-configuration
+func (api *API) TeamsAccountUpdateConfiguration(ctx context.Context, accountID string, config TeamsConfiguration) (TeamsConfiguration, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/configuration", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, config)
+	if err != nil {
+		return TeamsConfiguration{}, err
+	}
+
+	var teamsConfigResponse TeamsConfigResponse
+	err = json.Unmarshal(res, &teamsConfigResponse)
+	if err != nil {
+		return TeamsConfiguration{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsConfigResponse.Result, nil
+}
@@ -0,0 +1,145 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsAccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"gateway_tag": "12345",
+				"provider_name": "cf"
+			}
+		}
+		`, testAccountID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway", handler)
+
+	actual, err := client.TeamsAccount(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, TeamsAccount{ID: testAccountID, GatewayTag: "12345", ProviderName: "cf"}, actual)
+	}
+}
+
+func TestTeamsAccountConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"settings": {
+					"antivirus": {
+						"enabled_download_phase": true,
+						"enabled_upload_phase": false,
+						"fail_closed": true
+					},
+					"tls_decrypt": {"enabled": true},
+					"activity_log": {"enabled": true},
+					"block_page": {
+						"enabled": true,
+						"name": "Cloudflare",
+						"footer_text": "--footer--",
+						"header_text": "--header--",
+						"logo_path": "https://logos.com/a.png",
+						"background_color": "#ff0000"
+					},
+					"body_scanning": {"inspection_mode": "deep"},
+					"fips": {"tls": true},
+					"extended_email_matching": {"enabled": true}
+				}
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/configuration", handler)
+
+	enabled := true
+	want := TeamsConfiguration{
+		Settings: TeamsAccountSettings{
+			Antivirus: &TeamsAntivirus{
+				EnabledDownloadPhase: true,
+				FailClosed:           true,
+			},
+			TLSDecrypt:  &TeamsTLSDecrypt{Enabled: true},
+			ActivityLog: &TeamsActivityLog{Enabled: true},
+			BlockPage: &TeamsBlockPage{
+				Enabled:         &enabled,
+				Name:            "Cloudflare",
+				FooterText:      "--footer--",
+				HeaderText:      "--header--",
+				LogoPath:        "https://logos.com/a.png",
+				BackgroundColor: "#ff0000",
+			},
+			BodyScanning:          &TeamsBodyScanning{InspectionMode: TeamsDeepInspectionMode},
+			FIPS:                  &TeamsFIPS{TLS: true},
+			ExtendedEmailMatching: &TeamsExtendedEmailMatching{Enabled: true},
+		},
+	}
+
+	actual, err := client.TeamsAccountConfiguration(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestTeamsAccountUpdateConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"settings": {
+					"tls_decrypt": {"enabled": false},
+					"activity_log": {"enabled": false}
+				}
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/configuration", handler)
+
+	config := TeamsConfiguration{
+		Settings: TeamsAccountSettings{
+			TLSDecrypt:  &TeamsTLSDecrypt{Enabled: false},
+			ActivityLog: &TeamsActivityLog{Enabled: false},
+		},
+	}
+
+	actual, err := client.TeamsAccountUpdateConfiguration(context.Background(), testAccountID, config)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, config, actual)
+	}
+}
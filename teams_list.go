@@ -5,11 +5,22 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/pkg/errors"
 )
 
+// These constants represent all valid Teams List types.
+const (
+	TeamsListTypeSerial = "SERIAL"
+	TeamsListTypeURL    = "URL"
+	TeamsListTypeDomain = "DOMAIN"
+	TeamsListTypeEmail  = "EMAIL"
+	TeamsListTypeIP     = "IP"
+)
+
 // TeamsList represents a Teams List.
 type TeamsList struct {
 	ID          string          `json:"id,omitempty"`
@@ -103,11 +114,31 @@ func (api *API) TeamsList(ctx context.Context, accountID, listID string) (TeamsL
 	return teamsListDetailResponse.Result, nil
 }
 
-// TeamsListItems returns all list items for a list.
+// TeamsListItems returns the first page of list items for a list. Use
+// ListTeamsListItems to request other pages or AllTeamsListItems to fetch
+// every item.
 //
 // API reference: https://api.cloudflare.com/#teams-lists-teams-list-items
 func (api *API) TeamsListItems(ctx context.Context, accountID, listID string) ([]TeamsListItem, ResultInfo, error) {
+	return api.ListTeamsListItems(ctx, accountID, listID, PaginationOptions{})
+}
+
+// ListTeamsListItems returns a page of list items for a list.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-teams-list-items
+func (api *API) ListTeamsListItems(ctx context.Context, accountID, listID string, pageOpts PaginationOptions) ([]TeamsListItem, ResultInfo, error) {
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
 	uri := fmt.Sprintf("/%s/%s/gateway/lists/%s/items", AccountRouteRoot, accountID, listID)
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
 
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
@@ -123,6 +154,30 @@ func (api *API) TeamsListItems(ctx context.Context, accountID, listID string) ([
 	return teamsListItemsListResponse.Result, teamsListItemsListResponse.ResultInfo, nil
 }
 
+// AllTeamsListItems returns every item in a list, fetching each page of
+// results in turn.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-teams-list-items
+func (api *API) AllTeamsListItems(ctx context.Context, accountID, listID string) ([]TeamsListItem, error) {
+	var items []TeamsListItem
+
+	pageOpts := PaginationOptions{Page: 1, PerPage: 100}
+	for {
+		i, resultInfo, err := api.ListTeamsListItems(ctx, accountID, listID, pageOpts)
+		if err != nil {
+			return []TeamsListItem{}, err
+		}
+		items = append(items, i...)
+
+		if len(i) == 0 || resultInfo.TotalPages <= pageOpts.Page {
+			break
+		}
+		pageOpts.Page++
+	}
+
+	return items, nil
+}
+
 // CreateTeamsList creates a new teams list.
 //
 // API reference: https://api.cloudflare.com/#teams-lists-create-teams-list
@@ -201,6 +256,29 @@ func (api *API) PatchTeamsList(ctx context.Context, accountID string, listPatch
 	return teamsListDetailResponse.Result, nil
 }
 
+// AppendTeamsListItems adds items to an existing teams list.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-patch-teams-list
+func (api *API) AppendTeamsListItems(ctx context.Context, accountID, listID string, items []TeamsListItem) (TeamsList, error) {
+	return api.PatchTeamsList(ctx, accountID, PatchTeamsList{
+		ID:     listID,
+		Append: items,
+		Remove: []string{},
+	})
+}
+
+// RemoveTeamsListItems removes the items with the given values from an
+// existing teams list.
+//
+// API reference: https://api.cloudflare.com/#teams-lists-patch-teams-list
+func (api *API) RemoveTeamsListItems(ctx context.Context, accountID, listID string, values []string) (TeamsList, error) {
+	return api.PatchTeamsList(ctx, accountID, PatchTeamsList{
+		ID:     listID,
+		Append: []TeamsListItem{},
+		Remove: values,
+	})
+}
+
 // DeleteTeamsList deletes a teams list.
 //
 // API reference: https://api.cloudflare.com/#teams-lists-delete-teams-list
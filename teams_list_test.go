@@ -3,6 +3,7 @@ package cloudflare
 import (
 	"context"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"testing"
 	"time"
@@ -331,3 +332,86 @@ func TestDeleteTeamsList(t *testing.T) {
 
 	assert.NoError(t, err)
 }
+
+func TestAllTeamsListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"value": "example-%s.com"
+				}
+			],
+			"result_info": {
+				"page": %s,
+				"per_page": 100,
+				"total_pages": 2,
+				"count": 1,
+				"total_count": 2
+			}
+		}
+		`, page, page)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/lists/480f4f69-1a28-4fdd-9240-1ed29f0ac1db/items", handler)
+
+	actual, err := client.AllTeamsListItems(context.Background(), testAccountID, "480f4f69-1a28-4fdd-9240-1ed29f0ac1db")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []TeamsListItem{{Value: "example-1.com"}, {Value: "example-2.com"}}, actual)
+	}
+}
+
+func TestAppendAndRemoveTeamsListItems(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var expectedBody string
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, expectedBody, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+				"name": "My Domain List",
+				"type": "DOMAIN",
+				"count": 1
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/lists/480f4f69-1a28-4fdd-9240-1ed29f0ac1db", handler)
+
+	want := TeamsList{
+		ID:    "480f4f69-1a28-4fdd-9240-1ed29f0ac1db",
+		Name:  "My Domain List",
+		Type:  TeamsListTypeDomain,
+		Count: 1,
+	}
+
+	expectedBody = `{"id":"480f4f69-1a28-4fdd-9240-1ed29f0ac1db","append":[{"value":"example.com"}],"remove":[]}`
+	actual, err := client.AppendTeamsListItems(context.Background(), testAccountID, "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", []TeamsListItem{{Value: "example.com"}})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	expectedBody = `{"id":"480f4f69-1a28-4fdd-9240-1ed29f0ac1db","append":[],"remove":["example.org"]}`
+	actual, err = client.RemoveTeamsListItems(context.Background(), testAccountID, "480f4f69-1a28-4fdd-9240-1ed29f0ac1db", []string{"example.org"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
@@ -0,0 +1,183 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TeamsLocation represents a Gateway DNS filtering location.
+type TeamsLocation struct {
+	ID                    string                 `json:"id,omitempty"`
+	Name                  string                 `json:"name"`
+	Networks              []TeamsLocationNetwork `json:"networks,omitempty"`
+	PolicyIDs             []string               `json:"policy_ids,omitempty"`
+	IP                    string                 `json:"ip,omitempty"`
+	Subdomain             string                 `json:"doh_subdomain,omitempty"`
+	AnonymizedLogsEnabled bool                   `json:"anonymized_logs_enabled,omitempty"`
+	IPv4Destination       string                 `json:"ipv4_destination,omitempty"`
+	ClientDefault         bool                   `json:"client_default,omitempty"`
+	ECSSupport            *bool                  `json:"ecs_support,omitempty"`
+	CreatedAt             *time.Time             `json:"created_at,omitempty"`
+	UpdatedAt             *time.Time             `json:"updated_at,omitempty"`
+}
+
+// TeamsLocationNetwork represents a source network, in CIDR notation, that
+// is associated with a Gateway location.
+type TeamsLocationNetwork struct {
+	ID      string `json:"id,omitempty"`
+	Network string `json:"network"`
+}
+
+// TeamsLocationsListResponse represents the response from the list
+// teams locations endpoint.
+type TeamsLocationsListResponse struct {
+	Result []TeamsLocation `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// TeamsLocationDetailResponse is the API response, containing a single
+// teams location.
+type TeamsLocationDetailResponse struct {
+	Response
+	Result TeamsLocation `json:"result"`
+}
+
+// TeamsLocations returns the first page of Gateway locations within an
+// account. Use ListTeamsLocations to request other pages.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-list-teams-locations
+func (api *API) TeamsLocations(ctx context.Context, accountID string) ([]TeamsLocation, ResultInfo, error) {
+	return api.ListTeamsLocations(ctx, accountID, PaginationOptions{})
+}
+
+// ListTeamsLocations returns a page of Gateway locations within an account.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-list-teams-locations
+func (api *API) ListTeamsLocations(ctx context.Context, accountID string, pageOpts PaginationOptions) ([]TeamsLocation, ResultInfo, error) {
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := fmt.Sprintf("/%s/%s/gateway/locations", AccountRouteRoot, accountID)
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []TeamsLocation{}, ResultInfo{}, err
+	}
+
+	var teamsLocationsListResponse TeamsLocationsListResponse
+	err = json.Unmarshal(res, &teamsLocationsListResponse)
+	if err != nil {
+		return []TeamsLocation{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsLocationsListResponse.Result, teamsLocationsListResponse.ResultInfo, nil
+}
+
+// TeamsLocation returns a single Gateway location based on the location ID.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-teams-location-details
+func (api *API) TeamsLocation(ctx context.Context, accountID, locationID string) (TeamsLocation, error) {
+	uri := fmt.Sprintf(
+		"/%s/%s/gateway/locations/%s",
+		AccountRouteRoot,
+		accountID,
+		locationID,
+	)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TeamsLocation{}, err
+	}
+
+	var teamsLocationDetailResponse TeamsLocationDetailResponse
+	err = json.Unmarshal(res, &teamsLocationDetailResponse)
+	if err != nil {
+		return TeamsLocation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsLocationDetailResponse.Result, nil
+}
+
+// CreateTeamsLocation creates a new Gateway location.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-create-teams-location
+func (api *API) CreateTeamsLocation(ctx context.Context, accountID string, teamsLocation TeamsLocation) (TeamsLocation, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/locations", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, teamsLocation)
+	if err != nil {
+		return TeamsLocation{}, err
+	}
+
+	var teamsLocationDetailResponse TeamsLocationDetailResponse
+	err = json.Unmarshal(res, &teamsLocationDetailResponse)
+	if err != nil {
+		return TeamsLocation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsLocationDetailResponse.Result, nil
+}
+
+// UpdateTeamsLocation updates an existing Gateway location.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-update-teams-location
+func (api *API) UpdateTeamsLocation(ctx context.Context, accountID string, teamsLocation TeamsLocation) (TeamsLocation, error) {
+	if teamsLocation.ID == "" {
+		return TeamsLocation{}, errors.Errorf("teams location ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf(
+		"/%s/%s/gateway/locations/%s",
+		AccountRouteRoot,
+		accountID,
+		teamsLocation.ID,
+	)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, teamsLocation)
+	if err != nil {
+		return TeamsLocation{}, err
+	}
+
+	var teamsLocationDetailResponse TeamsLocationDetailResponse
+	err = json.Unmarshal(res, &teamsLocationDetailResponse)
+	if err != nil {
+		return TeamsLocation{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsLocationDetailResponse.Result, nil
+}
+
+// DeleteTeamsLocation deletes a Gateway location.
+//
+// API reference: https://api.cloudflare.com/#teams-locations-delete-teams-location
+func (api *API) DeleteTeamsLocation(ctx context.Context, accountID, teamsLocationID string) error {
+	uri := fmt.Sprintf(
+		"/%s/%s/gateway/locations/%s",
+		AccountRouteRoot,
+		accountID,
+		teamsLocationID,
+	)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,190 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsLocations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "0f8185414dec4a5e9ff0e8a7b3ab4d08",
+					"name": "Austin Office",
+					"networks": [{"id": "2f5d3f1a", "network": "198.51.100.0/24"}],
+					"policy_ids": [],
+					"ip": "2a06:98c1:54::c2",
+					"doh_subdomain": "oli3n9zkz5",
+					"anonymized_logs_enabled": false,
+					"ipv4_destination": "172.64.36.1",
+					"client_default": true,
+					"ecs_support": true,
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	ecsSupport := true
+
+	want := []TeamsLocation{{
+		ID:                    "0f8185414dec4a5e9ff0e8a7b3ab4d08",
+		Name:                  "Austin Office",
+		Networks:              []TeamsLocationNetwork{{ID: "2f5d3f1a", Network: "198.51.100.0/24"}},
+		PolicyIDs:             []string{},
+		IP:                    "2a06:98c1:54::c2",
+		Subdomain:             "oli3n9zkz5",
+		AnonymizedLogsEnabled: false,
+		IPv4Destination:       "172.64.36.1",
+		ClientDefault:         true,
+		ECSSupport:            &ecsSupport,
+		CreatedAt:             &createdAt,
+		UpdatedAt:             &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/locations", handler)
+
+	actual, _, err := client.TeamsLocations(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestListTeamsLocations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		assert.Equal(t, "1", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "0f8185414dec4a5e9ff0e8a7b3ab4d08",
+					"name": "Austin Office"
+				}
+			],
+			"result_info": {
+				"page": 2,
+				"per_page": 1,
+				"count": 1,
+				"total_count": 2
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/locations", handler)
+
+	actual, resultInfo, err := client.ListTeamsLocations(context.Background(), testAccountID, PaginationOptions{Page: 2, PerPage: 1})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, []TeamsLocation{{ID: "0f8185414dec4a5e9ff0e8a7b3ab4d08", Name: "Austin Office"}}, actual)
+		assert.Equal(t, ResultInfo{Page: 2, PerPage: 1, Count: 1, Total: 2}, resultInfo)
+	}
+}
+
+func TestCreateTeamsLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "0f8185414dec4a5e9ff0e8a7b3ab4d08",
+				"name": "Austin Office",
+				"networks": [{"id": "2f5d3f1a", "network": "198.51.100.0/24"}],
+				"doh_subdomain": "oli3n9zkz5",
+				"ecs_support": false
+			}
+		}
+		`)
+	}
+
+	ecsSupport := false
+
+	want := TeamsLocation{
+		ID:         "0f8185414dec4a5e9ff0e8a7b3ab4d08",
+		Name:       "Austin Office",
+		Networks:   []TeamsLocationNetwork{{ID: "2f5d3f1a", Network: "198.51.100.0/24"}},
+		Subdomain:  "oli3n9zkz5",
+		ECSSupport: &ecsSupport,
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/locations", handler)
+
+	actual, err := client.CreateTeamsLocation(context.Background(), testAccountID, TeamsLocation{
+		Name:       "Austin Office",
+		Networks:   []TeamsLocationNetwork{{Network: "198.51.100.0/24"}},
+		ECSSupport: &ecsSupport,
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateTeamsLocationWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateTeamsLocation(context.Background(), testAccountID, TeamsLocation{Name: "Austin Office"})
+	assert.EqualError(t, err, "teams location ID cannot be empty")
+}
+
+func TestDeleteTeamsLocation(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/locations/0f8185414dec4a5e9ff0e8a7b3ab4d08", handler)
+
+	err := client.DeleteTeamsLocation(context.Background(), testAccountID, "0f8185414dec4a5e9ff0e8a7b3ab4d08")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,136 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TeamsProxyEndpoint represents a Gateway proxy endpoint. Only requests from
+// the listed source IPs are accepted by the endpoint.
+type TeamsProxyEndpoint struct {
+	ID        string     `json:"id,omitempty"`
+	Name      string     `json:"name"`
+	IPs       []string   `json:"ips"`
+	Subdomain string     `json:"subdomain,omitempty"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// TeamsProxyEndpointListResponse represents the response from the list
+// teams proxy endpoints endpoint.
+type TeamsProxyEndpointListResponse struct {
+	Result []TeamsProxyEndpoint `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// TeamsProxyEndpointDetailResponse is the API response, containing a single
+// teams proxy endpoint.
+type TeamsProxyEndpointDetailResponse struct {
+	Response
+	Result TeamsProxyEndpoint `json:"result"`
+}
+
+// TeamsProxyEndpoints returns all Gateway proxy endpoints within an account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-proxy-endpoints-list-proxy-endpoints
+func (api *API) TeamsProxyEndpoints(ctx context.Context, accountID string) ([]TeamsProxyEndpoint, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/proxy_endpoints", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []TeamsProxyEndpoint{}, ResultInfo{}, err
+	}
+
+	var teamsProxyEndpointListResponse TeamsProxyEndpointListResponse
+	err = json.Unmarshal(res, &teamsProxyEndpointListResponse)
+	if err != nil {
+		return []TeamsProxyEndpoint{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsProxyEndpointListResponse.Result, teamsProxyEndpointListResponse.ResultInfo, nil
+}
+
+// TeamsProxyEndpoint returns a single Gateway proxy endpoint based on the
+// endpoint ID.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-proxy-endpoints-proxy-endpoint-details
+func (api *API) TeamsProxyEndpoint(ctx context.Context, accountID, proxyEndpointID string) (TeamsProxyEndpoint, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/proxy_endpoints/%s", AccountRouteRoot, accountID, proxyEndpointID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TeamsProxyEndpoint{}, err
+	}
+
+	var teamsProxyEndpointDetailResponse TeamsProxyEndpointDetailResponse
+	err = json.Unmarshal(res, &teamsProxyEndpointDetailResponse)
+	if err != nil {
+		return TeamsProxyEndpoint{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsProxyEndpointDetailResponse.Result, nil
+}
+
+// CreateTeamsProxyEndpoint creates a new Gateway proxy endpoint.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-proxy-endpoints-create-proxy-endpoint
+func (api *API) CreateTeamsProxyEndpoint(ctx context.Context, accountID string, proxyEndpoint TeamsProxyEndpoint) (TeamsProxyEndpoint, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/proxy_endpoints", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, proxyEndpoint)
+	if err != nil {
+		return TeamsProxyEndpoint{}, err
+	}
+
+	var teamsProxyEndpointDetailResponse TeamsProxyEndpointDetailResponse
+	err = json.Unmarshal(res, &teamsProxyEndpointDetailResponse)
+	if err != nil {
+		return TeamsProxyEndpoint{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsProxyEndpointDetailResponse.Result, nil
+}
+
+// UpdateTeamsProxyEndpoint updates an existing Gateway proxy endpoint.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-proxy-endpoints-update-proxy-endpoint
+func (api *API) UpdateTeamsProxyEndpoint(ctx context.Context, accountID string, proxyEndpoint TeamsProxyEndpoint) (TeamsProxyEndpoint, error) {
+	if proxyEndpoint.ID == "" {
+		return TeamsProxyEndpoint{}, errors.Errorf("teams proxy endpoint ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/gateway/proxy_endpoints/%s", AccountRouteRoot, accountID, proxyEndpoint.ID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, proxyEndpoint)
+	if err != nil {
+		return TeamsProxyEndpoint{}, err
+	}
+
+	var teamsProxyEndpointDetailResponse TeamsProxyEndpointDetailResponse
+	err = json.Unmarshal(res, &teamsProxyEndpointDetailResponse)
+	if err != nil {
+		return TeamsProxyEndpoint{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsProxyEndpointDetailResponse.Result, nil
+}
+
+// DeleteTeamsProxyEndpoint deletes a Gateway proxy endpoint.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-gateway-proxy-endpoints-delete-proxy-endpoint
+func (api *API) DeleteTeamsProxyEndpoint(ctx context.Context, accountID, proxyEndpointID string) error {
+	uri := fmt.Sprintf("/%s/%s/gateway/proxy_endpoints/%s", AccountRouteRoot, accountID, proxyEndpointID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
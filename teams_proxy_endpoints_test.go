@@ -0,0 +1,165 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsProxyEndpoints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "ed35569b41ce4d1facfe683550f54086",
+					"name": "Office",
+					"ips": ["192.0.2.1/32"],
+					"subdomain": "q1w2e3r4t5",
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z"
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+
+	want := []TeamsProxyEndpoint{{
+		ID:        "ed35569b41ce4d1facfe683550f54086",
+		Name:      "Office",
+		IPs:       []string{"192.0.2.1/32"},
+		Subdomain: "q1w2e3r4t5",
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/proxy_endpoints", handler)
+
+	actual, _, err := client.TeamsProxyEndpoints(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateTeamsProxyEndpoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ed35569b41ce4d1facfe683550f54086",
+				"name": "Office",
+				"ips": ["192.0.2.1/32"],
+				"subdomain": "q1w2e3r4t5"
+			}
+		}
+		`)
+	}
+
+	want := TeamsProxyEndpoint{
+		ID:        "ed35569b41ce4d1facfe683550f54086",
+		Name:      "Office",
+		IPs:       []string{"192.0.2.1/32"},
+		Subdomain: "q1w2e3r4t5",
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/proxy_endpoints", handler)
+
+	actual, err := client.CreateTeamsProxyEndpoint(context.Background(), testAccountID, TeamsProxyEndpoint{
+		Name: "Office",
+		IPs:  []string{"192.0.2.1/32"},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateTeamsProxyEndpoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "ed35569b41ce4d1facfe683550f54086",
+				"name": "Office",
+				"ips": ["192.0.2.1/32", "192.0.2.2/32"],
+				"subdomain": "q1w2e3r4t5"
+			}
+		}
+		`)
+	}
+
+	want := TeamsProxyEndpoint{
+		ID:        "ed35569b41ce4d1facfe683550f54086",
+		Name:      "Office",
+		IPs:       []string{"192.0.2.1/32", "192.0.2.2/32"},
+		Subdomain: "q1w2e3r4t5",
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/proxy_endpoints/ed35569b41ce4d1facfe683550f54086", handler)
+
+	actual, err := client.UpdateTeamsProxyEndpoint(context.Background(), testAccountID, want)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateTeamsProxyEndpoint(context.Background(), testAccountID, TeamsProxyEndpoint{})
+	assert.EqualError(t, err, "teams proxy endpoint ID cannot be empty")
+}
+
+func TestDeleteTeamsProxyEndpoint(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/proxy_endpoints/ed35569b41ce4d1facfe683550f54086", handler)
+
+	err := client.DeleteTeamsProxyEndpoint(context.Background(), testAccountID, "ed35569b41ce4d1facfe683550f54086")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,267 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TeamsGatewayAction represents the action a Gateway rule takes when its
+// filters match.
+type TeamsGatewayAction string
+
+// These constants represent all valid Gateway rule actions.
+const (
+	TeamsGatewayActionAllow        TeamsGatewayAction = "allow"
+	TeamsGatewayActionBlock        TeamsGatewayAction = "block"
+	TeamsGatewayActionSafeSearch   TeamsGatewayAction = "safesearch"
+	TeamsGatewayActionYTRestricted TeamsGatewayAction = "ytrestricted"
+	TeamsGatewayActionOn           TeamsGatewayAction = "on"
+	TeamsGatewayActionOff          TeamsGatewayAction = "off"
+	TeamsGatewayActionScan         TeamsGatewayAction = "scan"
+	TeamsGatewayActionNoScan       TeamsGatewayAction = "noscan"
+	TeamsGatewayActionIsolate      TeamsGatewayAction = "isolate"
+	TeamsGatewayActionNoIsolate    TeamsGatewayAction = "noisolate"
+	TeamsGatewayActionOverride     TeamsGatewayAction = "override"
+	TeamsGatewayActionL4Override   TeamsGatewayAction = "l4_override"
+	TeamsGatewayActionEgress       TeamsGatewayAction = "egress"
+	TeamsGatewayActionAuditSSH     TeamsGatewayAction = "audit_ssh"
+)
+
+// TeamsFilterType represents the traffic type a Gateway rule applies to.
+type TeamsFilterType string
+
+// These constants represent all valid Gateway rule filter types.
+const (
+	TeamsFilterHTTP TeamsFilterType = "http"
+	TeamsFilterDNS  TeamsFilterType = "dns"
+	TeamsFilterL4   TeamsFilterType = "l4"
+)
+
+// TeamsGatewayUntrustedCertAction represents the action taken when an
+// untrusted origin certificate is encountered.
+type TeamsGatewayUntrustedCertAction string
+
+// These constants represent all valid untrusted certificate actions.
+const (
+	TeamsGatewayUntrustedCertPassthrough TeamsGatewayUntrustedCertAction = "pass_through"
+	TeamsGatewayUntrustedCertBlock       TeamsGatewayUntrustedCertAction = "block"
+	TeamsGatewayUntrustedCertError       TeamsGatewayUntrustedCertAction = "error"
+)
+
+// TeamsRule represents a Gateway policy rule.
+type TeamsRule struct {
+	ID            string             `json:"id,omitempty"`
+	Name          string             `json:"name"`
+	Description   string             `json:"description"`
+	Precedence    uint64             `json:"precedence"`
+	Enabled       bool               `json:"enabled"`
+	Action        TeamsGatewayAction `json:"action"`
+	Filters       []TeamsFilterType  `json:"filters"`
+	Traffic       string             `json:"traffic"`
+	Identity      string             `json:"identity"`
+	DevicePosture string             `json:"device_posture"`
+	Version       uint64             `json:"version,omitempty"`
+	RuleSettings  TeamsRuleSettings  `json:"rule_settings,omitempty"`
+	Schedule      *TeamsRuleSchedule `json:"schedule,omitempty"`
+	CreatedAt     *time.Time         `json:"created_at,omitempty"`
+	UpdatedAt     *time.Time         `json:"updated_at,omitempty"`
+	DeletedAt     *time.Time         `json:"deleted_at,omitempty"`
+}
+
+// TeamsRuleSettings contains the action specific settings of a Gateway rule.
+type TeamsRuleSettings struct {
+	// whether to enable the custom block page
+	BlockPageEnabled bool `json:"block_page_enabled"`
+
+	// the text to show on the block page
+	BlockReason string `json:"block_reason"`
+
+	// override host/ip for DNS override rules
+	OverrideHost string   `json:"override_host"`
+	OverrideIPs  []string `json:"override_ips"`
+
+	// settings for the L4 override action
+	L4Override *TeamsL4OverrideSettings `json:"l4override,omitempty"`
+
+	// browser isolation (BISO) controls
+	BISOAdminControls *TeamsBISOAdminControlSettings `json:"biso_admin_controls,omitempty"`
+
+	// whether to disable DNSSEC validation (for DNS resolution)
+	InsecureDisableDNSSECValidation bool `json:"insecure_disable_dnssec_validation"`
+
+	// action to take on untrusted origin certificates
+	UntrustedCertSettings *UntrustedCertSettings `json:"untrusted_cert,omitempty"`
+
+	// egress IPs used by the egress action
+	EgressSettings *EgressSettings `json:"egress,omitempty"`
+
+	// settings for the audit SSH action
+	AuditSSH *AuditSSHSettings `json:"audit_ssh,omitempty"`
+
+	// headers to add to proxied HTTP requests
+	AddHeaders http.Header `json:"add_headers,omitempty"`
+}
+
+// TeamsL4OverrideSettings are used to override the destination of L4
+// traffic.
+type TeamsL4OverrideSettings struct {
+	IP   string `json:"ip,omitempty"`
+	Port int    `json:"port,omitempty"`
+}
+
+// TeamsBISOAdminControlSettings represents the browser isolation controls
+// applied to isolated sessions.
+type TeamsBISOAdminControlSettings struct {
+	DisablePrinting  bool `json:"dp"`
+	DisableCopyPaste bool `json:"dcp"`
+	DisableDownload  bool `json:"dd"`
+	DisableUpload    bool `json:"du"`
+	DisableKeyboard  bool `json:"dk"`
+}
+
+// UntrustedCertSettings configures the action taken when an untrusted
+// origin certificate is encountered.
+type UntrustedCertSettings struct {
+	Action TeamsGatewayUntrustedCertAction `json:"action"`
+}
+
+// EgressSettings configures the egress IPs used by the egress action.
+type EgressSettings struct {
+	Ipv6Range    string `json:"ipv6"`
+	Ipv4         string `json:"ipv4"`
+	Ipv4Fallback string `json:"ipv4_fallback"`
+}
+
+// AuditSSHSettings configures the audit SSH action.
+type AuditSSHSettings struct {
+	CommandLogging bool `json:"command_logging"`
+}
+
+// TeamsRuleSchedule limits the times at which a Gateway rule is active. Each
+// day is a comma separated list of time ranges, e.g. "08:00-12:30,13:30-17:00".
+type TeamsRuleSchedule struct {
+	Monday    string `json:"mon,omitempty"`
+	Tuesday   string `json:"tue,omitempty"`
+	Wednesday string `json:"wed,omitempty"`
+	Thursday  string `json:"thu,omitempty"`
+	Friday    string `json:"fri,omitempty"`
+	Saturday  string `json:"sat,omitempty"`
+	Sunday    string `json:"sun,omitempty"`
+	TimeZone  string `json:"time_zone,omitempty"`
+}
+
+// TeamsRulesResponse represents the response from the list teams rules
+// endpoint.
+type TeamsRulesResponse struct {
+	Response
+	Result []TeamsRule `json:"result"`
+}
+
+// TeamsRuleResponse is the API response, containing a single teams rule.
+type TeamsRuleResponse struct {
+	Response
+	Result TeamsRule `json:"result"`
+}
+
+// TeamsRules returns all Gateway rules within an account.
+//
+// API reference: https://api.cloudflare.com/#teams-rules-properties
+func (api *API) TeamsRules(ctx context.Context, accountID string) ([]TeamsRule, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/rules", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []TeamsRule{}, err
+	}
+
+	var teamsRulesResponse TeamsRulesResponse
+	err = json.Unmarshal(res, &teamsRulesResponse)
+	if err != nil {
+		return []TeamsRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsRulesResponse.Result, nil
+}
+
+// TeamsRule returns a single Gateway rule based on the rule ID.
+//
+// API reference: https://api.cloudflare.com/#teams-rules-properties
+func (api *API) TeamsRule(ctx context.Context, accountID, ruleID string) (TeamsRule, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/rules/%s", AccountRouteRoot, accountID, ruleID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TeamsRule{}, err
+	}
+
+	var teamsRuleResponse TeamsRuleResponse
+	err = json.Unmarshal(res, &teamsRuleResponse)
+	if err != nil {
+		return TeamsRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsRuleResponse.Result, nil
+}
+
+// CreateTeamsRule creates a new Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#teams-rules-properties
+func (api *API) CreateTeamsRule(ctx context.Context, accountID string, rule TeamsRule) (TeamsRule, error) {
+	uri := fmt.Sprintf("/%s/%s/gateway/rules", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, rule)
+	if err != nil {
+		return TeamsRule{}, err
+	}
+
+	var teamsRuleResponse TeamsRuleResponse
+	err = json.Unmarshal(res, &teamsRuleResponse)
+	if err != nil {
+		return TeamsRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsRuleResponse.Result, nil
+}
+
+// UpdateTeamsRule updates an existing Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#teams-rules-properties
+func (api *API) UpdateTeamsRule(ctx context.Context, accountID string, rule TeamsRule) (TeamsRule, error) {
+	if rule.ID == "" {
+		return TeamsRule{}, errors.Errorf("teams rule ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/gateway/rules/%s", AccountRouteRoot, accountID, rule.ID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, rule)
+	if err != nil {
+		return TeamsRule{}, err
+	}
+
+	var teamsRuleResponse TeamsRuleResponse
+	err = json.Unmarshal(res, &teamsRuleResponse)
+	if err != nil {
+		return TeamsRule{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return teamsRuleResponse.Result, nil
+}
+
+// DeleteTeamsRule deletes a Gateway rule.
+//
+// API reference: https://api.cloudflare.com/#teams-rules-properties
+func (api *API) DeleteTeamsRule(ctx context.Context, accountID, ruleID string) error {
+	uri := fmt.Sprintf("/%s/%s/gateway/rules/%s", AccountRouteRoot, accountID, ruleID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
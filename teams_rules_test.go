@@ -0,0 +1,186 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamsRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "7559a944-3dd7-41bf-b183-360a814a8c36",
+					"name": "block bad websites",
+					"description": "rule description",
+					"precedence": 1000,
+					"enabled": false,
+					"action": "block",
+					"filters": ["dns"],
+					"traffic": "any(dns.domains[*] == \"example.com\")",
+					"identity": "",
+					"device_posture": "",
+					"version": 1,
+					"rule_settings": {
+						"block_page_enabled": true,
+						"block_reason": "not allowed",
+						"override_host": "",
+						"override_ips": null,
+						"insecure_disable_dnssec_validation": false
+					},
+					"schedule": {
+						"mon": "08:00-12:30,13:30-17:00",
+						"time_zone": "America/New_York"
+					},
+					"created_at": "2014-01-01T05:20:00.12345Z",
+					"updated_at": "2014-01-01T05:20:00.12345Z"
+				}
+			]
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+	updatedAt, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00.12345Z")
+
+	want := []TeamsRule{{
+		ID:          "7559a944-3dd7-41bf-b183-360a814a8c36",
+		Name:        "block bad websites",
+		Description: "rule description",
+		Precedence:  1000,
+		Enabled:     false,
+		Action:      TeamsGatewayActionBlock,
+		Filters:     []TeamsFilterType{TeamsFilterDNS},
+		Traffic:     `any(dns.domains[*] == "example.com")`,
+		Version:     1,
+		RuleSettings: TeamsRuleSettings{
+			BlockPageEnabled: true,
+			BlockReason:      "not allowed",
+		},
+		Schedule: &TeamsRuleSchedule{
+			Monday:   "08:00-12:30,13:30-17:00",
+			TimeZone: "America/New_York",
+		},
+		CreatedAt: &createdAt,
+		UpdatedAt: &updatedAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/rules", handler)
+
+	actual, err := client.TeamsRules(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateTeamsRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.Contains(t, string(body), `"biso_admin_controls":{"dp":true,"dcp":true,"dd":false,"du":false,"dk":false}`)
+		assert.Contains(t, string(body), `"untrusted_cert":{"action":"block"}`)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "7559a944-3dd7-41bf-b183-360a814a8c36",
+				"name": "isolate social media",
+				"description": "",
+				"precedence": 2000,
+				"enabled": true,
+				"action": "isolate",
+				"filters": ["http"],
+				"traffic": "http.request.host == \"social.example.com\"",
+				"identity": "",
+				"device_posture": "",
+				"rule_settings": {
+					"block_page_enabled": false,
+					"block_reason": "",
+					"override_host": "",
+					"override_ips": null,
+					"biso_admin_controls": {"dp": true, "dcp": true, "dd": false, "du": false, "dk": false},
+					"insecure_disable_dnssec_validation": false,
+					"untrusted_cert": {"action": "block"}
+				}
+			}
+		}
+		`)
+	}
+
+	rule := TeamsRule{
+		Name:       "isolate social media",
+		Precedence: 2000,
+		Enabled:    true,
+		Action:     TeamsGatewayActionIsolate,
+		Filters:    []TeamsFilterType{TeamsFilterHTTP},
+		Traffic:    `http.request.host == "social.example.com"`,
+		RuleSettings: TeamsRuleSettings{
+			BISOAdminControls: &TeamsBISOAdminControlSettings{
+				DisablePrinting:  true,
+				DisableCopyPaste: true,
+			},
+			UntrustedCertSettings: &UntrustedCertSettings{Action: TeamsGatewayUntrustedCertBlock},
+		},
+	}
+
+	want := rule
+	want.ID = "7559a944-3dd7-41bf-b183-360a814a8c36"
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/rules", handler)
+
+	actual, err := client.CreateTeamsRule(context.Background(), testAccountID, rule)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateTeamsRuleWithMissingID(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateTeamsRule(context.Background(), testAccountID, TeamsRule{})
+	assert.EqualError(t, err, "teams rule ID cannot be empty")
+}
+
+func TestDeleteTeamsRule(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/gateway/rules/7559a944-3dd7-41bf-b183-360a814a8c36", handler)
+
+	err := client.DeleteTeamsRule(context.Background(), testAccountID, "7559a944-3dd7-41bf-b183-360a814a8c36")
+	assert.NoError(t, err)
+}
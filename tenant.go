@@ -0,0 +1,135 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Tenant is a reseller organization on the Tenant platform, owning the
+// accounts it provisions for its customers.
+type Tenant struct {
+	ID          string       `json:"tenant_tag"`
+	Name        string       `json:"tenant_name"`
+	Type        string       `json:"tenant_type"`
+	Units       []TenantUnit `json:"tenant_units,omitempty"`
+	CustomerID  string       `json:"customer_id,omitempty"`
+	Entitlement string       `json:"entitlement_tier,omitempty"`
+	CreatedOn   *time.Time   `json:"created_on,omitempty"`
+}
+
+// TenantUnit is a unit of a tenant that accounts are created under.
+type TenantUnit struct {
+	ID string `json:"unit_tag"`
+}
+
+// TenantResponse is the API response containing a tenant.
+type TenantResponse struct {
+	Response
+	Result Tenant `json:"result"`
+}
+
+// Tenant returns the details of a tenant.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/get-tenant-details/
+func (api *API) Tenant(ctx context.Context, tenantID string) (Tenant, error) {
+	if tenantID == "" {
+		return Tenant{}, errors.New("tenant ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/tenants/%s", tenantID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Tenant{}, err
+	}
+
+	var r TenantResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Tenant{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// TenantAccounts returns the accounts provisioned under a tenant.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-accounts/
+func (api *API) TenantAccounts(ctx context.Context, tenantID string, pageOpts PaginationOptions) ([]Account, ResultInfo, error) {
+	if tenantID == "" {
+		return []Account{}, ResultInfo{}, errors.New("tenant ID cannot be empty")
+	}
+
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := fmt.Sprintf("/tenants/%s/accounts", tenantID)
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Account{}, ResultInfo{}, err
+	}
+
+	var r AccountListResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []Account{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// CreateTenantAccount creates a new account under a unit of a tenant.
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-accounts/#create-account
+func (api *API) CreateTenantAccount(ctx context.Context, tenantUnitID string, account Account) (Account, error) {
+	if tenantUnitID == "" {
+		return Account{}, errors.New("tenant unit ID cannot be empty")
+	}
+	if account.Name == "" {
+		return Account{}, errors.New("account name cannot be empty")
+	}
+
+	params := struct {
+		Account
+		Unit struct {
+			ID string `json:"id"`
+		} `json:"unit"`
+	}{Account: account}
+	params.Unit.ID = tenantUnitID
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, "/accounts", params)
+	if err != nil {
+		return Account{}, err
+	}
+
+	var r AccountDetailResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return Account{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// CreateTenantZone creates a zone in an account provisioned under a tenant.
+// zoneType is "full" or "partial".
+//
+// API reference: https://developers.cloudflare.com/tenant/how-to/manage-accounts/
+func (api *API) CreateTenantZone(ctx context.Context, accountID, name, zoneType string) (Zone, error) {
+	if accountID == "" {
+		return Zone{}, errors.New(errMissingAccountID)
+	}
+	return api.CreateZone(ctx, name, false, Account{ID: accountID}, zoneType)
+}
@@ -0,0 +1,132 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTenantID = "1b16db169c9cb7853009857198fae1b9"
+
+func TestTenant(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"tenant_tag": "1b16db169c9cb7853009857198fae1b9",
+				"tenant_name": "Example Reseller",
+				"tenant_type": "SILVER",
+				"tenant_units": [{"unit_tag": "a7c2e8e0fbb04b0f9c5a2b3d1e8f7c6d"}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/tenants/"+testTenantID, handler)
+
+	actual, err := client.Tenant(context.Background(), testTenantID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, Tenant{
+			ID:    testTenantID,
+			Name:  "Example Reseller",
+			Type:  "SILVER",
+			Units: []TenantUnit{{ID: "a7c2e8e0fbb04b0f9c5a2b3d1e8f7c6d"}},
+		}, actual)
+	}
+
+	_, err = client.Tenant(context.Background(), "")
+	assert.EqualError(t, err, "tenant ID cannot be empty")
+}
+
+func TestTenantAccounts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "2", r.URL.Query().Get("page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{"id": "01a7362d577a6c3019a474fd6f485823", "name": "Customer A", "type": "standard"}],
+			"result_info": {"page": 2, "per_page": 1, "count": 1, "total_count": 2}
+		}`)
+	}
+
+	mux.HandleFunc("/tenants/"+testTenantID+"/accounts", handler)
+
+	actual, resultInfo, err := client.TenantAccounts(context.Background(), testTenantID, PaginationOptions{Page: 2, PerPage: 1})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []Account{{ID: "01a7362d577a6c3019a474fd6f485823", Name: "Customer A", Type: AccountTypeStandard}}, actual)
+		assert.Equal(t, 2, resultInfo.Page)
+	}
+}
+
+func TestCreateTenantAccount(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "Customer A", "type": "standard", "unit": {"id": "a7c2e8e0fbb04b0f9c5a2b3d1e8f7c6d"}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "01a7362d577a6c3019a474fd6f485823", "name": "Customer A", "type": "standard"}
+		}`)
+	}
+
+	mux.HandleFunc("/accounts", handler)
+
+	actual, err := client.CreateTenantAccount(context.Background(), "a7c2e8e0fbb04b0f9c5a2b3d1e8f7c6d", Account{Name: "Customer A", Type: AccountTypeStandard})
+	if assert.NoError(t, err) {
+		assert.Equal(t, Account{ID: "01a7362d577a6c3019a474fd6f485823", Name: "Customer A", Type: AccountTypeStandard}, actual)
+	}
+
+	_, err = client.CreateTenantAccount(context.Background(), "", Account{Name: "Customer A"})
+	assert.EqualError(t, err, "tenant unit ID cannot be empty")
+}
+
+func TestCreateTenantZone(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"name": "example.com", "jump_start": false, "type": "full", "organization": {"id": "01a7362d577a6c3019a474fd6f485823"}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "023e105f4ecef8ad9ca31a8372d0c353", "name": "example.com"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones", handler)
+
+	actual, err := client.CreateTenantZone(context.Background(), "01a7362d577a6c3019a474fd6f485823", "example.com", "full")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "023e105f4ecef8ad9ca31a8372d0c353", actual.ID)
+	}
+}
@@ -0,0 +1,212 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TieredCacheType is the cache topology used by a zone.
+type TieredCacheType int
+
+// Tiered cache topologies
+const (
+	// TieredCacheOff disables tiered caching
+	TieredCacheOff TieredCacheType = iota
+
+	// TieredCacheGeneric uses every Cloudflare data center as an upper tier
+	TieredCacheGeneric
+
+	// TieredCacheSmart picks the upper tiers closest to the origin
+	TieredCacheSmart
+)
+
+func (t TieredCacheType) String() string {
+	switch t {
+	case TieredCacheGeneric:
+		return "generic"
+	case TieredCacheSmart:
+		return "smart"
+	default:
+		return "off"
+	}
+}
+
+// TieredCache is the tiered cache topology of a zone.
+type TieredCache struct {
+	Type         TieredCacheType
+	LastModified time.Time
+}
+
+// RegionalTieredCache is the regional tiered cache setting of a zone, which
+// adds a regional hub between lower tiers and the upper tier.
+type RegionalTieredCache struct {
+	ID         string    `json:"id,omitempty"`
+	Value      string    `json:"value"`
+	Editable   bool      `json:"editable,omitempty"`
+	ModifiedOn time.Time `json:"modified_on,omitempty"`
+}
+
+// RegionalTieredCacheResponse is the API response for the regional tiered
+// cache setting.
+type RegionalTieredCacheResponse struct {
+	Result RegionalTieredCache `json:"result"`
+	Response
+}
+
+// GetTieredCache returns the tiered cache topology of a zone, combining the
+// tiered caching and smart tiered cache topology settings.
+//
+// API reference: https://api.cloudflare.com/#smart-tiered-cache-get-smart-tiered-cache-setting
+func (api *API) GetTieredCache(ctx context.Context, zoneID string) (TieredCache, error) {
+	generic, err := api.ArgoTieredCaching(ctx, zoneID)
+	if err != nil {
+		return TieredCache{}, err
+	}
+	if generic.Value != "on" {
+		return TieredCache{Type: TieredCacheOff, LastModified: generic.ModifiedOn}, nil
+	}
+
+	smart, err := api.smartTieredCache(ctx, http.MethodGet, zoneID, nil)
+	if err != nil {
+		// The smart topology setting does not exist until it is first enabled.
+		if isNotFoundError(err) {
+			return TieredCache{Type: TieredCacheGeneric, LastModified: generic.ModifiedOn}, nil
+		}
+		return TieredCache{}, err
+	}
+	if smart.Value != "on" {
+		return TieredCache{Type: TieredCacheGeneric, LastModified: generic.ModifiedOn}, nil
+	}
+
+	return TieredCache{Type: TieredCacheSmart, LastModified: latestTime(generic.ModifiedOn, smart.ModifiedOn)}, nil
+}
+
+// SetTieredCache changes the tiered cache topology of a zone. Setting it to
+// TieredCacheOff is the same as calling DeleteTieredCache.
+//
+// API reference: https://api.cloudflare.com/#smart-tiered-cache-patch-smart-tiered-cache-setting
+func (api *API) SetTieredCache(ctx context.Context, zoneID string, value TieredCacheType) (TieredCache, error) {
+	switch value {
+	case TieredCacheOff:
+		return api.DeleteTieredCache(ctx, zoneID)
+	case TieredCacheGeneric:
+		generic, err := api.UpdateArgoTieredCaching(ctx, zoneID, "on")
+		if err != nil {
+			return TieredCache{}, err
+		}
+		if _, err := api.smartTieredCache(ctx, http.MethodDelete, zoneID, nil); err != nil && !isNotFoundError(err) {
+			return TieredCache{}, err
+		}
+		return TieredCache{Type: TieredCacheGeneric, LastModified: generic.ModifiedOn}, nil
+	case TieredCacheSmart:
+		generic, err := api.UpdateArgoTieredCaching(ctx, zoneID, "on")
+		if err != nil {
+			return TieredCache{}, err
+		}
+		smart, err := api.smartTieredCache(ctx, http.MethodPatch, zoneID, struct {
+			Value string `json:"value"`
+		}{"on"})
+		if err != nil {
+			return TieredCache{}, err
+		}
+		return TieredCache{Type: TieredCacheSmart, LastModified: latestTime(generic.ModifiedOn, smart.ModifiedOn)}, nil
+	default:
+		return TieredCache{}, errors.Errorf("invalid tiered cache type %d", value)
+	}
+}
+
+// DeleteTieredCache turns tiered caching off for a zone and removes its
+// smart tiered cache topology setting.
+//
+// API reference: https://api.cloudflare.com/#smart-tiered-cache-delete-smart-tiered-cache-setting
+func (api *API) DeleteTieredCache(ctx context.Context, zoneID string) (TieredCache, error) {
+	if _, err := api.smartTieredCache(ctx, http.MethodDelete, zoneID, nil); err != nil && !isNotFoundError(err) {
+		return TieredCache{}, err
+	}
+
+	generic, err := api.UpdateArgoTieredCaching(ctx, zoneID, "off")
+	if err != nil {
+		return TieredCache{}, err
+	}
+
+	return TieredCache{Type: TieredCacheOff, LastModified: generic.ModifiedOn}, nil
+}
+
+// RegionalTieredCache returns the regional tiered cache setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-get-regional-tiered-cache-setting
+func (api *API) RegionalTieredCache(ctx context.Context, zoneID string) (RegionalTieredCache, error) {
+	uri := fmt.Sprintf("/zones/%s/cache/regional_tiered_cache", zoneID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return RegionalTieredCache{}, err
+	}
+
+	var r RegionalTieredCacheResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegionalTieredCache{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateRegionalTieredCache turns regional tiered cache "on" or "off" for a
+// zone.
+//
+// API reference: https://api.cloudflare.com/#zone-cache-settings-change-regional-tiered-cache-setting
+func (api *API) UpdateRegionalTieredCache(ctx context.Context, zoneID, settingValue string) (RegionalTieredCache, error) {
+	if !contains(validSettingValues, settingValue) {
+		return RegionalTieredCache{}, errors.New(fmt.Sprintf("invalid setting value '%s'. must be 'on' or 'off'", settingValue))
+	}
+
+	uri := fmt.Sprintf("/zones/%s/cache/regional_tiered_cache", zoneID)
+	params := struct {
+		Value string `json:"value"`
+	}{settingValue}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, params)
+	if err != nil {
+		return RegionalTieredCache{}, err
+	}
+
+	var r RegionalTieredCacheResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return RegionalTieredCache{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+func (api *API) smartTieredCache(ctx context.Context, method, zoneID string, params interface{}) (ArgoFeatureSetting, error) {
+	uri := fmt.Sprintf("/zones/%s/cache/tiered_cache_smart_topology_enable", zoneID)
+
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return ArgoFeatureSetting{}, err
+	}
+
+	var argoDetailsResponse ArgoDetailsResponse
+	err = json.Unmarshal(res, &argoDetailsResponse)
+	if err != nil {
+		return ArgoFeatureSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return argoDetailsResponse.Result, nil
+}
+
+func isNotFoundError(err error) bool {
+	apiErr, ok := err.(*APIRequestError)
+	return ok && apiErr.StatusCode == http.StatusNotFound
+}
+
+func latestTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
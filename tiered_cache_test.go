@@ -0,0 +1,162 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func tieredCacheSettingHandler(id, value string) func(w http.ResponseWriter, r *http.Request) {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"value": "%s",
+				"editable": true,
+				"modified_on": "2019-02-20T22:37:07.107449Z"
+			}
+		}`, id, value)
+	}
+}
+
+func TestGetTieredCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/argo/tiered_caching", tieredCacheSettingHandler("tiered_caching", "on"))
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/tiered_cache_smart_topology_enable", tieredCacheSettingHandler("tiered_cache_smart_topology_enable", "on"))
+
+	lastModified, _ := time.Parse(time.RFC3339Nano, "2019-02-20T22:37:07.107449Z")
+
+	actual, err := client.GetTieredCache(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TieredCache{Type: TieredCacheSmart, LastModified: lastModified}, actual)
+	}
+}
+
+func TestGetTieredCacheGeneric(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/argo/tiered_caching", tieredCacheSettingHandler("tiered_caching", "on"))
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/tiered_cache_smart_topology_enable", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{
+			"success": false,
+			"errors": [{"code": 1142, "message": "Unable to retrieve tiered_cache_smart_topology_enable setting value. The zone setting does not exist."}],
+			"messages": [],
+			"result": null
+		}`)
+	})
+
+	actual, err := client.GetTieredCache(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TieredCacheGeneric, actual.Type)
+		assert.Equal(t, "generic", actual.Type.String())
+	}
+}
+
+func TestSetTieredCacheSmart(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	mux.HandleFunc("/zones/"+testZoneID+"/argo/tiered_caching", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.Contains(t, string(body), `"value":"on"`)
+		}
+		tieredCacheSettingHandler("tiered_caching", "on")(w, r)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/tiered_cache_smart_topology_enable", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(body))
+		}
+		tieredCacheSettingHandler("tiered_cache_smart_topology_enable", "on")(w, r)
+	})
+
+	actual, err := client.SetTieredCache(context.Background(), testZoneID, TieredCacheSmart)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TieredCacheSmart, actual.Type)
+	}
+	assert.Equal(t, []string{
+		"PATCH /zones/" + testZoneID + "/argo/tiered_caching",
+		"PATCH /zones/" + testZoneID + "/cache/tiered_cache_smart_topology_enable",
+	}, requests)
+
+	_, err = client.SetTieredCache(context.Background(), testZoneID, TieredCacheType(5))
+	assert.EqualError(t, err, "invalid tiered cache type 5")
+}
+
+func TestDeleteTieredCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var requests []string
+	mux.HandleFunc("/zones/"+testZoneID+"/argo/tiered_caching", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		tieredCacheSettingHandler("tiered_caching", "off")(w, r)
+	})
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/tiered_cache_smart_topology_enable", func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		tieredCacheSettingHandler("tiered_cache_smart_topology_enable", "off")(w, r)
+	})
+
+	actual, err := client.SetTieredCache(context.Background(), testZoneID, TieredCacheOff)
+	if assert.NoError(t, err) {
+		assert.Equal(t, TieredCacheOff, actual.Type)
+	}
+	assert.Equal(t, []string{
+		"DELETE /zones/" + testZoneID + "/cache/tiered_cache_smart_topology_enable",
+		"PATCH /zones/" + testZoneID + "/argo/tiered_caching",
+	}, requests)
+}
+
+func TestRegionalTieredCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/regional_tiered_cache", tieredCacheSettingHandler("tc_regional", "on"))
+
+	modifiedOn, _ := time.Parse(time.RFC3339Nano, "2019-02-20T22:37:07.107449Z")
+
+	actual, err := client.RegionalTieredCache(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, RegionalTieredCache{ID: "tc_regional", Value: "on", Editable: true, ModifiedOn: modifiedOn}, actual)
+	}
+}
+
+func TestUpdateRegionalTieredCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	mux.HandleFunc("/zones/"+testZoneID+"/cache/regional_tiered_cache", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(body))
+		}
+		tieredCacheSettingHandler("tc_regional", "off")(w, r)
+	})
+
+	actual, err := client.UpdateRegionalTieredCache(context.Background(), testZoneID, "off")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "off", actual.Value)
+	}
+
+	_, err = client.UpdateRegionalTieredCache(context.Background(), testZoneID, "enabled")
+	assert.EqualError(t, err, "invalid setting value 'enabled'. must be 'on' or 'off'")
+}
@@ -0,0 +1,411 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Tunnel is the struct definition of a Cloudflare Tunnel (cloudflared).
+type Tunnel struct {
+	ID          string             `json:"id,omitempty"`
+	Name        string             `json:"name,omitempty"`
+	Secret      string             `json:"tunnel_secret,omitempty"`
+	ConfigSrc   string             `json:"config_src,omitempty"`
+	CreatedAt   *time.Time         `json:"created_at,omitempty"`
+	DeletedAt   *time.Time         `json:"deleted_at,omitempty"`
+	Connections []TunnelConnection `json:"connections,omitempty"`
+}
+
+// TunnelConnection represents a single connection between a cloudflared
+// instance and the Cloudflare edge.
+type TunnelConnection struct {
+	ColoName           string `json:"colo_name"`
+	ID                 string `json:"id"`
+	IsPendingReconnect bool   `json:"is_pending_reconnect"`
+	ClientID           string `json:"client_id"`
+	ClientVersion      string `json:"client_version"`
+	OpenedAt           string `json:"opened_at"`
+	OriginIP           string `json:"origin_ip"`
+}
+
+// ActiveClient represents a cloudflared instance running a tunnel, along
+// with the connections it holds open.
+type ActiveClient struct {
+	ID          string             `json:"id"`
+	Features    []string           `json:"features"`
+	Version     string             `json:"version"`
+	Arch        string             `json:"arch"`
+	RunAt       *time.Time         `json:"run_at"`
+	Connections []TunnelConnection `json:"conns"`
+}
+
+// TunnelListParams holds the filters used when listing tunnels.
+type TunnelListParams struct {
+	Name      string
+	UUID      string
+	IsDeleted *bool
+	ExistedAt *time.Time
+	PaginationOptions
+}
+
+// TunnelsDetailResponse is used for representing the API response payload for
+// multiple tunnels.
+type TunnelsDetailResponse struct {
+	Result []Tunnel `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// TunnelDetailResponse is used for representing the API response payload for
+// a single tunnel.
+type TunnelDetailResponse struct {
+	Result Tunnel `json:"result"`
+	Response
+}
+
+// TunnelConnectionResponse is used for representing the API response payload
+// for the connections of a tunnel.
+type TunnelConnectionResponse struct {
+	Result []ActiveClient `json:"result"`
+	Response
+}
+
+// TunnelTokenResponse is the API response for a tunnel token.
+type TunnelTokenResponse struct {
+	Result string `json:"result"`
+	Response
+}
+
+// Encode encodes the tunnel list parameters into a query string.
+func (p TunnelListParams) Encode() string {
+	v := url.Values{}
+
+	if p.Name != "" {
+		v.Set("name", p.Name)
+	}
+	if p.UUID != "" {
+		v.Set("uuid", p.UUID)
+	}
+	if p.IsDeleted != nil {
+		v.Set("is_deleted", strconv.FormatBool(*p.IsDeleted))
+	}
+	if p.ExistedAt != nil {
+		v.Set("existed_at", p.ExistedAt.Format(time.RFC3339))
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// Tunnels lists all tunnels matching the given filters.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnels
+func (api *API) Tunnels(ctx context.Context, accountID string, params TunnelListParams) ([]Tunnel, ResultInfo, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel", accountID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []Tunnel{}, ResultInfo{}, err
+	}
+
+	var tunnelsDetailResponse TunnelsDetailResponse
+	err = json.Unmarshal(res, &tunnelsDetailResponse)
+	if err != nil {
+		return []Tunnel{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelsDetailResponse.Result, tunnelsDetailResponse.ResultInfo, nil
+}
+
+// Tunnel returns a single tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-cloudflare-tunnel
+func (api *API) Tunnel(ctx context.Context, accountID, tunnelUUID string) (Tunnel, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, tunnelUUID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return Tunnel{}, err
+	}
+
+	var tunnelDetailResponse TunnelDetailResponse
+	err = json.Unmarshal(res, &tunnelDetailResponse)
+	if err != nil {
+		return Tunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelDetailResponse.Result, nil
+}
+
+// CreateTunnel creates a new tunnel for the account. The secret must be a
+// base64 encoded value of at least 32 bytes. Setting configSrc to
+// "cloudflare" makes the tunnel remotely managed.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-create-cloudflare-tunnel
+func (api *API) CreateTunnel(ctx context.Context, accountID, name, secret, configSrc string) (Tunnel, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel", accountID)
+
+	tunnel := Tunnel{Name: name, Secret: secret, ConfigSrc: configSrc}
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, tunnel)
+	if err != nil {
+		return Tunnel{}, err
+	}
+
+	var tunnelDetailResponse TunnelDetailResponse
+	err = json.Unmarshal(res, &tunnelDetailResponse)
+	if err != nil {
+		return Tunnel{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelDetailResponse.Result, nil
+}
+
+// DeleteTunnel removes a single tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-delete-cloudflare-tunnel
+func (api *API) DeleteTunnel(ctx context.Context, accountID, tunnelUUID string) error {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s", accountID, tunnelUUID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TunnelToken returns the token used by cloudflared to run a remotely
+// managed tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-get-cloudflare-tunnel-token
+func (api *API) TunnelToken(ctx context.Context, accountID, tunnelUUID string) (string, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/token", accountID, tunnelUUID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var tunnelTokenResponse TunnelTokenResponse
+	err = json.Unmarshal(res, &tunnelTokenResponse)
+	if err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelTokenResponse.Result, nil
+}
+
+// TunnelConnections returns the cloudflared instances and their connections
+// for a tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-list-cloudflare-tunnel-connections
+func (api *API) TunnelConnections(ctx context.Context, accountID, tunnelUUID string) ([]ActiveClient, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/connections", accountID, tunnelUUID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []ActiveClient{}, err
+	}
+
+	var tunnelConnectionResponse TunnelConnectionResponse
+	err = json.Unmarshal(res, &tunnelConnectionResponse)
+	if err != nil {
+		return []ActiveClient{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelConnectionResponse.Result, nil
+}
+
+// CleanupTunnelConnections deletes any inactive connections on a tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-clean-up-cloudflare-tunnel-connections
+func (api *API) CleanupTunnelConnections(ctx context.Context, accountID, tunnelUUID string) error {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/connections", accountID, tunnelUUID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// TunnelConfiguration is the configuration of a remotely managed tunnel.
+type TunnelConfiguration struct {
+	Ingress       []UnvalidatedIngressRule `json:"ingress,omitempty"`
+	WarpRouting   *WarpRoutingConfig       `json:"warp-routing,omitempty"`
+	OriginRequest *OriginRequestConfig     `json:"originRequest,omitempty"`
+}
+
+// TunnelConfigurationParams is the request body used to update the
+// configuration of a remotely managed tunnel.
+type TunnelConfigurationParams struct {
+	Config TunnelConfiguration `json:"config,omitempty"`
+}
+
+// TunnelConfigurationResult is the configuration of a tunnel along with the
+// version the edge currently holds.
+type TunnelConfigurationResult struct {
+	TunnelID  string              `json:"tunnel_id,omitempty"`
+	Config    TunnelConfiguration `json:"config,omitempty"`
+	Version   int                 `json:"version,omitempty"`
+	CreatedAt *time.Time          `json:"created_at,omitempty"`
+}
+
+// TunnelConfigurationResponse is the API response for a tunnel
+// configuration.
+type TunnelConfigurationResponse struct {
+	Result TunnelConfigurationResult `json:"result"`
+	Response
+}
+
+// UnvalidatedIngressRule is a single ingress rule. The final rule of a
+// configuration must be a catch-all rule without a Hostname.
+type UnvalidatedIngressRule struct {
+	Hostname      string               `json:"hostname,omitempty"`
+	Path          string               `json:"path,omitempty"`
+	Service       string               `json:"service,omitempty"`
+	OriginRequest *OriginRequestConfig `json:"originRequest,omitempty"`
+}
+
+// WarpRoutingConfig configures whether private network traffic from WARP
+// clients is routed through the tunnel.
+type WarpRoutingConfig struct {
+	Enabled bool `json:"enabled,omitempty"`
+}
+
+// OriginRequestConfig configures how cloudflared proxies requests to the
+// origin. It may be set for the whole tunnel or overridden per ingress rule.
+type OriginRequestConfig struct {
+	// HTTP proxy timeout for establishing a new connection
+	ConnectTimeout *TunnelDuration `json:"connectTimeout,omitempty"`
+	// HTTP proxy timeout for completing a TLS handshake
+	TLSTimeout *TunnelDuration `json:"tlsTimeout,omitempty"`
+	// HTTP proxy TCP keepalive duration
+	TCPKeepAlive *TunnelDuration `json:"tcpKeepAlive,omitempty"`
+	// HTTP proxy should disable "happy eyeballs" for IPv4/v6 fallback
+	NoHappyEyeballs *bool `json:"noHappyEyeballs,omitempty"`
+	// HTTP proxy maximum keepalive connection pool size
+	KeepAliveConnections *int `json:"keepAliveConnections,omitempty"`
+	// HTTP proxy timeout for closing an idle connection
+	KeepAliveTimeout *TunnelDuration `json:"keepAliveTimeout,omitempty"`
+	// Sets the HTTP Host header for the local webserver.
+	HTTPHostHeader *string `json:"httpHostHeader,omitempty"`
+	// Hostname on the origin server certificate.
+	OriginServerName *string `json:"originServerName,omitempty"`
+	// Path to the CA for the certificate of your origin.
+	CAPool *string `json:"caPool,omitempty"`
+	// Disables TLS verification of the certificate presented by your origin.
+	NoTLSVerify *bool `json:"noTLSVerify,omitempty"`
+	// Disables chunked transfer encoding.
+	DisableChunkedEncoding *bool `json:"disableChunkedEncoding,omitempty"`
+	// Runs as jump host
+	BastionMode *bool `json:"bastionMode,omitempty"`
+	// Listen address for the proxy.
+	ProxyAddress *string `json:"proxyAddress,omitempty"`
+	// Listen port for the proxy.
+	ProxyPort *uint `json:"proxyPort,omitempty"`
+	// Valid options are 'socks' or empty.
+	ProxyType *string `json:"proxyType,omitempty"`
+	// IP rules for the proxy service
+	IPRules []IngressIPRule `json:"ipRules,omitempty"`
+	// Attempt to connect to origin with HTTP/2
+	HTTP2Origin *bool `json:"http2Origin,omitempty"`
+	// Access holds all access related configs
+	Access *AccessConfig `json:"access,omitempty"`
+}
+
+// IngressIPRule is an IP rule applied by the cloudflared proxy service.
+type IngressIPRule struct {
+	Prefix *string `json:"prefix,omitempty"`
+	Ports  []int   `json:"ports,omitempty"`
+	Allow  bool    `json:"allow,omitempty"`
+}
+
+// AccessConfig configures cloudflared to validate Access JWTs on requests
+// before proxying them to the origin.
+type AccessConfig struct {
+	Required bool     `json:"required,omitempty"`
+	TeamName string   `json:"teamName,omitempty"`
+	AudTag   []string `json:"audTag,omitempty"`
+}
+
+// TunnelDuration is a time.Duration that is encoded as a whole number of
+// seconds, as expected by the tunnel configuration API.
+type TunnelDuration struct {
+	time.Duration
+}
+
+// MarshalJSON encodes a TunnelDuration as a number of seconds.
+func (s TunnelDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(int64(s.Duration.Seconds()))
+}
+
+// UnmarshalJSON decodes a TunnelDuration from a number of seconds.
+func (s *TunnelDuration) UnmarshalJSON(data []byte) error {
+	var seconds int64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+
+	s.Duration = time.Duration(seconds) * time.Second
+	return nil
+}
+
+// TunnelConfiguration returns the configuration of a remotely managed tunnel.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-get-configuration
+func (api *API) TunnelConfiguration(ctx context.Context, accountID, tunnelUUID string) (TunnelConfigurationResult, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelUUID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return TunnelConfigurationResult{}, err
+	}
+
+	var tunnelConfigurationResponse TunnelConfigurationResponse
+	err = json.Unmarshal(res, &tunnelConfigurationResponse)
+	if err != nil {
+		return TunnelConfigurationResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelConfigurationResponse.Result, nil
+}
+
+// UpdateTunnelConfiguration replaces the configuration of a remotely managed
+// tunnel. Running cloudflared instances pick up the new configuration
+// without a restart.
+//
+// API reference: https://api.cloudflare.com/#cloudflare-tunnel-configuration-put-configuration
+func (api *API) UpdateTunnelConfiguration(ctx context.Context, accountID, tunnelUUID string, config TunnelConfiguration) (TunnelConfigurationResult, error) {
+	uri := fmt.Sprintf("/accounts/%s/cfd_tunnel/%s/configurations", accountID, tunnelUUID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, TunnelConfigurationParams{Config: config})
+	if err != nil {
+		return TunnelConfigurationResult{}, err
+	}
+
+	var tunnelConfigurationResponse TunnelConfigurationResponse
+	err = json.Unmarshal(res, &tunnelConfigurationResponse)
+	if err != nil {
+		return TunnelConfigurationResult{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return tunnelConfigurationResponse.Result, nil
+}
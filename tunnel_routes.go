@@ -0,0 +1,178 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TunnelRoute represents a private network route (CIDR) that is served by a
+// tunnel.
+type TunnelRoute struct {
+	ID               string     `json:"id,omitempty"`
+	Network          string     `json:"network"`
+	TunnelID         string     `json:"tunnel_id"`
+	TunnelName       string     `json:"tunnel_name,omitempty"`
+	Comment          string     `json:"comment,omitempty"`
+	VirtualNetworkID string     `json:"virtual_network_id,omitempty"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TunnelRoutesListParams holds the filters used when listing tunnel routes.
+type TunnelRoutesListParams struct {
+	TunnelID         string
+	Comment          string
+	IsDeleted        *bool
+	NetworkSubset    string
+	NetworkSuperset  string
+	VirtualNetworkID string
+	PaginationOptions
+}
+
+// TunnelRoutesListResponse is the API response for listing tunnel routes.
+type TunnelRoutesListResponse struct {
+	Result []TunnelRoute `json:"result"`
+	Response
+	ResultInfo `json:"result_info"`
+}
+
+// TunnelRouteResponse is the API response for a single tunnel route.
+type TunnelRouteResponse struct {
+	Result TunnelRoute `json:"result"`
+	Response
+}
+
+// Encode encodes the tunnel route list parameters into a query string.
+func (p TunnelRoutesListParams) Encode() string {
+	v := url.Values{}
+
+	if p.TunnelID != "" {
+		v.Set("tunnel_id", p.TunnelID)
+	}
+	if p.Comment != "" {
+		v.Set("comment", p.Comment)
+	}
+	if p.IsDeleted != nil {
+		v.Set("is_deleted", strconv.FormatBool(*p.IsDeleted))
+	}
+	if p.NetworkSubset != "" {
+		v.Set("network_subset", p.NetworkSubset)
+	}
+	if p.NetworkSuperset != "" {
+		v.Set("network_superset", p.NetworkSuperset)
+	}
+	if p.VirtualNetworkID != "" {
+		v.Set("virtual_network_id", p.VirtualNetworkID)
+	}
+	if p.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(p.PerPage))
+	}
+	if p.Page > 0 {
+		v.Set("page", strconv.Itoa(p.Page))
+	}
+
+	return v.Encode()
+}
+
+// ListTunnelRoutes lists all tunnel routes matching the given filters.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-list-tunnel-routes
+func (api *API) ListTunnelRoutes(ctx context.Context, accountID string, params TunnelRoutesListParams) ([]TunnelRoute, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/teamnet/routes", AccountRouteRoot, accountID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []TunnelRoute{}, ResultInfo{}, err
+	}
+
+	var listResponse TunnelRoutesListResponse
+	err = json.Unmarshal(res, &listResponse)
+	if err != nil {
+		return []TunnelRoute{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return listResponse.Result, listResponse.ResultInfo, nil
+}
+
+// TunnelRouteForIP returns the tunnel route that serves the given IP,
+// optionally within a virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-get-tunnel-route-by-ip
+func (api *API) TunnelRouteForIP(ctx context.Context, accountID, ip, virtualNetworkID string) (TunnelRoute, error) {
+	uri := fmt.Sprintf("/%s/%s/teamnet/routes/ip/%s", AccountRouteRoot, accountID, url.PathEscape(ip))
+	if virtualNetworkID != "" {
+		uri = fmt.Sprintf("%s?virtual_network_id=%s", uri, url.QueryEscape(virtualNetworkID))
+	}
+
+	return api.tunnelRouteRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// CreateTunnelRoute routes a private network (CIDR) through a tunnel.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-create-route
+func (api *API) CreateTunnelRoute(ctx context.Context, accountID string, route TunnelRoute) (TunnelRoute, error) {
+	if route.Network == "" {
+		return TunnelRoute{}, errors.Errorf("tunnel route network cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/teamnet/routes/network/%s", AccountRouteRoot, accountID, url.PathEscape(route.Network))
+
+	return api.tunnelRouteRequest(ctx, http.MethodPost, uri, route)
+}
+
+// UpdateTunnelRoute updates the tunnel, comment or virtual network of an
+// existing route.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-update-route
+func (api *API) UpdateTunnelRoute(ctx context.Context, accountID string, route TunnelRoute) (TunnelRoute, error) {
+	if route.Network == "" {
+		return TunnelRoute{}, errors.Errorf("tunnel route network cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/teamnet/routes/network/%s", AccountRouteRoot, accountID, url.PathEscape(route.Network))
+
+	return api.tunnelRouteRequest(ctx, http.MethodPatch, uri, route)
+}
+
+// DeleteTunnelRoute removes the route for a private network, optionally
+// within a virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-route-delete-route
+func (api *API) DeleteTunnelRoute(ctx context.Context, accountID, network, virtualNetworkID string) error {
+	uri := fmt.Sprintf("/%s/%s/teamnet/routes/network/%s", AccountRouteRoot, accountID, url.PathEscape(network))
+	if virtualNetworkID != "" {
+		uri = fmt.Sprintf("%s?virtual_network_id=%s", uri, url.QueryEscape(virtualNetworkID))
+	}
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (api *API) tunnelRouteRequest(ctx context.Context, method, uri string, params interface{}) (TunnelRoute, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return TunnelRoute{}, err
+	}
+
+	var routeResponse TunnelRouteResponse
+	err = json.Unmarshal(res, &routeResponse)
+	if err != nil {
+		return TunnelRoute{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return routeResponse.Result, nil
+}
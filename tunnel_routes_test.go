@@ -0,0 +1,132 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTunnelRoutes(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, testTunnelID, r.URL.Query().Get("tunnel_id"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "e9bb1b6f-9ea4-4b1b-9b1a-0b2d0c2d4e5f",
+					"network": "10.0.0.0/16",
+					"tunnel_id": "%s",
+					"tunnel_name": "blog",
+					"comment": "office network",
+					"virtual_network_id": "9f322de4-5988-4945-b770-f1d6ac200f86",
+					"created_at": "2021-01-25T18:22:34.317854Z",
+					"deleted_at": null
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testTunnelID)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2021-01-25T18:22:34.317854Z")
+
+	want := []TunnelRoute{{
+		ID:               "e9bb1b6f-9ea4-4b1b-9b1a-0b2d0c2d4e5f",
+		Network:          "10.0.0.0/16",
+		TunnelID:         testTunnelID,
+		TunnelName:       "blog",
+		Comment:          "office network",
+		VirtualNetworkID: "9f322de4-5988-4945-b770-f1d6ac200f86",
+		CreatedAt:        &createdAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/routes", handler)
+
+	actual, _, err := client.ListTunnelRoutes(context.Background(), testAccountID, TunnelRoutesListParams{TunnelID: testTunnelID})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateTunnelRoute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "/accounts/"+testAccountID+"/teamnet/routes/network/10.0.0.0%2F16", r.URL.EscapedPath())
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "e9bb1b6f-9ea4-4b1b-9b1a-0b2d0c2d4e5f",
+				"network": "10.0.0.0/16",
+				"tunnel_id": "%s",
+				"comment": "office network"
+			}
+		}
+		`, testTunnelID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/routes/network/", handler)
+
+	route := TunnelRoute{
+		Network:  "10.0.0.0/16",
+		TunnelID: testTunnelID,
+		Comment:  "office network",
+	}
+
+	want := route
+	want.ID = "e9bb1b6f-9ea4-4b1b-9b1a-0b2d0c2d4e5f"
+
+	actual, err := client.CreateTunnelRoute(context.Background(), testAccountID, route)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.CreateTunnelRoute(context.Background(), testAccountID, TunnelRoute{TunnelID: testTunnelID})
+	assert.EqualError(t, err, "tunnel route network cannot be empty")
+}
+
+func TestDeleteTunnelRoute(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, "9f322de4-5988-4945-b770-f1d6ac200f86", r.URL.Query().Get("virtual_network_id"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/routes/network/", handler)
+
+	err := client.DeleteTunnelRoute(context.Background(), testAccountID, "10.0.0.0/16", "9f322de4-5988-4945-b770-f1d6ac200f86")
+	assert.NoError(t, err)
+}
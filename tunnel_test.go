@@ -0,0 +1,342 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testTunnelID = "f174e90a-fafe-4643-bbbc-4a0ed4fc8415"
+
+func TestTunnels(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "blog", r.URL.Query().Get("name"))
+		assert.Equal(t, "false", r.URL.Query().Get("is_deleted"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "%s",
+					"name": "blog",
+					"created_at": "2009-11-10T23:00:00Z",
+					"deleted_at": null,
+					"connections": [
+						{
+							"colo_name": "DFW",
+							"id": "1bedc50d-42b3-473c-b108-ff3d10c0d925",
+							"is_pending_reconnect": false,
+							"client_id": "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+							"client_version": "2022.2.0",
+							"opened_at": "2021-01-25T18:22:34.317854Z",
+							"origin_ip": "198.51.100.1"
+						}
+					]
+				}
+			],
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testTunnelID)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2009-11-10T23:00:00Z")
+	isDeleted := false
+
+	want := []Tunnel{{
+		ID:        testTunnelID,
+		Name:      "blog",
+		CreatedAt: &createdAt,
+		Connections: []TunnelConnection{{
+			ColoName:           "DFW",
+			ID:                 "1bedc50d-42b3-473c-b108-ff3d10c0d925",
+			IsPendingReconnect: false,
+			ClientID:           "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+			ClientVersion:      "2022.2.0",
+			OpenedAt:           "2021-01-25T18:22:34.317854Z",
+			OriginIP:           "198.51.100.1",
+		}},
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel", handler)
+
+	actual, _, err := client.Tunnels(context.Background(), testAccountID, TunnelListParams{Name: "blog", IsDeleted: &isDeleted})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateTunnel(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "%s",
+				"name": "blog",
+				"created_at": "2009-11-10T23:00:00Z",
+				"deleted_at": null,
+				"connections": []
+			}
+		}
+		`, testTunnelID)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2009-11-10T23:00:00Z")
+	want := Tunnel{
+		ID:          testTunnelID,
+		Name:        "blog",
+		CreatedAt:   &createdAt,
+		Connections: []TunnelConnection{},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel", handler)
+
+	actual, err := client.CreateTunnel(context.Background(), testAccountID, "blog", "notarealsecret", "cloudflare")
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestTunnelToken(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": "ZHNraGdhc2RraGFza2hqZGFza2poZGFza2poYXNrZGpoYWtzamRoa2FzZGpoa2FzamRoa2Rhc2po"
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID+"/token", handler)
+
+	actual, err := client.TunnelToken(context.Background(), testAccountID, testTunnelID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, "ZHNraGdhc2RraGFza2hqZGFza2poZGFza2poYXNrZGpoYWtzamRoa2FzZGpoa2FzamRoa2Rhc2po", actual)
+	}
+}
+
+func TestTunnelConnections(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+					"features": ["ha-origin"],
+					"version": "2022.2.0",
+					"arch": "linux_amd64",
+					"run_at": "2009-11-10T23:00:00Z",
+					"conns": [
+						{
+							"colo_name": "DFW",
+							"id": "1bedc50d-42b3-473c-b108-ff3d10c0d925",
+							"is_pending_reconnect": false,
+							"client_id": "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+							"client_version": "2022.2.0",
+							"opened_at": "2021-01-25T18:22:34.317854Z",
+							"origin_ip": "198.51.100.1"
+						}
+					]
+				}
+			]
+		}
+		`)
+	}
+
+	runAt, _ := time.Parse(time.RFC3339, "2009-11-10T23:00:00Z")
+	want := []ActiveClient{{
+		ID:       "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+		Features: []string{"ha-origin"},
+		Version:  "2022.2.0",
+		Arch:     "linux_amd64",
+		RunAt:    &runAt,
+		Connections: []TunnelConnection{{
+			ColoName:      "DFW",
+			ID:            "1bedc50d-42b3-473c-b108-ff3d10c0d925",
+			ClientID:      "dc6472cc-f1ae-44a0-b795-6b8a0ce29f90",
+			ClientVersion: "2022.2.0",
+			OpenedAt:      "2021-01-25T18:22:34.317854Z",
+			OriginIP:      "198.51.100.1",
+		}},
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID+"/connections", handler)
+
+	actual, err := client.TunnelConnections(context.Background(), testAccountID, testTunnelID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteTunnelAndCleanupConnections(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID, handler)
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID+"/connections", handler)
+
+	err := client.CleanupTunnelConnections(context.Background(), testAccountID, testTunnelID)
+	assert.NoError(t, err)
+
+	err = client.DeleteTunnel(context.Background(), testAccountID, testTunnelID)
+	assert.NoError(t, err)
+}
+
+func TestUpdateTunnelConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, _ := ioutil.ReadAll(r.Body)
+		assert.JSONEq(t, `{
+			"config": {
+				"ingress": [
+					{"hostname": "test.example.com", "service": "https://localhost:8000", "originRequest": {"noTLSVerify": true}},
+					{"service": "http_status:404"}
+				],
+				"warp-routing": {"enabled": true},
+				"originRequest": {"connectTimeout": 10}
+			}
+		}`, string(body))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"tunnel_id": "%s",
+				"version": 5,
+				"config": {
+					"ingress": [
+						{"hostname": "test.example.com", "service": "https://localhost:8000", "originRequest": {"noTLSVerify": true}},
+						{"service": "http_status:404"}
+					],
+					"warp-routing": {"enabled": true},
+					"originRequest": {"connectTimeout": 10}
+				}
+			}
+		}
+		`, testTunnelID)
+	}
+
+	noTLSVerify := true
+	config := TunnelConfiguration{
+		Ingress: []UnvalidatedIngressRule{
+			{
+				Hostname:      "test.example.com",
+				Service:       "https://localhost:8000",
+				OriginRequest: &OriginRequestConfig{NoTLSVerify: &noTLSVerify},
+			},
+			{
+				Service: "http_status:404",
+			},
+		},
+		WarpRouting: &WarpRoutingConfig{Enabled: true},
+		OriginRequest: &OriginRequestConfig{
+			ConnectTimeout: &TunnelDuration{10 * time.Second},
+		},
+	}
+
+	want := TunnelConfigurationResult{
+		TunnelID: testTunnelID,
+		Version:  5,
+		Config:   config,
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID+"/configurations", handler)
+
+	actual, err := client.UpdateTunnelConfiguration(context.Background(), testAccountID, testTunnelID, config)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestTunnelConfiguration(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"tunnel_id": "%s",
+				"version": 1,
+				"config": {
+					"ingress": [{"service": "http_status:404"}]
+				}
+			}
+		}
+		`, testTunnelID)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/cfd_tunnel/"+testTunnelID+"/configurations", handler)
+
+	actual, err := client.TunnelConfiguration(context.Background(), testAccountID, testTunnelID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, TunnelConfigurationResult{
+			TunnelID: testTunnelID,
+			Version:  1,
+			Config: TunnelConfiguration{
+				Ingress: []UnvalidatedIngressRule{{Service: "http_status:404"}},
+			},
+		}, actual)
+	}
+}
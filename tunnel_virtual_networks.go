@@ -0,0 +1,149 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TunnelVirtualNetwork segregates tunnel routes so that overlapping private
+// IP ranges can be routed through different tunnels.
+type TunnelVirtualNetwork struct {
+	ID               string     `json:"id,omitempty"`
+	Name             string     `json:"name"`
+	IsDefaultNetwork bool       `json:"is_default_network"`
+	Comment          string     `json:"comment"`
+	CreatedAt        *time.Time `json:"created_at,omitempty"`
+	DeletedAt        *time.Time `json:"deleted_at,omitempty"`
+}
+
+// TunnelVirtualNetworksListParams holds the filters used when listing
+// virtual networks.
+type TunnelVirtualNetworksListParams struct {
+	ID        string
+	Name      string
+	IsDefault *bool
+	IsDeleted *bool
+}
+
+// TunnelVirtualNetworksListResponse is the API response for listing virtual
+// networks.
+type TunnelVirtualNetworksListResponse struct {
+	Result []TunnelVirtualNetwork `json:"result"`
+	Response
+}
+
+// TunnelVirtualNetworkResponse is the API response for a single virtual
+// network.
+type TunnelVirtualNetworkResponse struct {
+	Result TunnelVirtualNetwork `json:"result"`
+	Response
+}
+
+// Encode encodes the virtual network list parameters into a query string.
+func (p TunnelVirtualNetworksListParams) Encode() string {
+	v := url.Values{}
+
+	if p.ID != "" {
+		v.Set("id", p.ID)
+	}
+	if p.Name != "" {
+		v.Set("name", p.Name)
+	}
+	if p.IsDefault != nil {
+		v.Set("is_default", strconv.FormatBool(*p.IsDefault))
+	}
+	if p.IsDeleted != nil {
+		v.Set("is_deleted", strconv.FormatBool(*p.IsDeleted))
+	}
+
+	return v.Encode()
+}
+
+// ListTunnelVirtualNetworks lists all virtual networks matching the given
+// filters.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-list-virtual-networks
+func (api *API) ListTunnelVirtualNetworks(ctx context.Context, accountID string, params TunnelVirtualNetworksListParams) ([]TunnelVirtualNetwork, error) {
+	uri := fmt.Sprintf("/%s/%s/teamnet/virtual_networks", AccountRouteRoot, accountID)
+	if q := params.Encode(); q != "" {
+		uri = fmt.Sprintf("%s?%s", uri, q)
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []TunnelVirtualNetwork{}, err
+	}
+
+	var listResponse TunnelVirtualNetworksListResponse
+	err = json.Unmarshal(res, &listResponse)
+	if err != nil {
+		return []TunnelVirtualNetwork{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return listResponse.Result, nil
+}
+
+// CreateTunnelVirtualNetwork creates a new virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-create-virtual-network
+func (api *API) CreateTunnelVirtualNetwork(ctx context.Context, accountID string, network TunnelVirtualNetwork) (TunnelVirtualNetwork, error) {
+	uri := fmt.Sprintf("/%s/%s/teamnet/virtual_networks", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, network)
+	if err != nil {
+		return TunnelVirtualNetwork{}, err
+	}
+
+	var networkResponse TunnelVirtualNetworkResponse
+	err = json.Unmarshal(res, &networkResponse)
+	if err != nil {
+		return TunnelVirtualNetwork{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return networkResponse.Result, nil
+}
+
+// UpdateTunnelVirtualNetwork updates an existing virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-update-virtual-network
+func (api *API) UpdateTunnelVirtualNetwork(ctx context.Context, accountID string, network TunnelVirtualNetwork) (TunnelVirtualNetwork, error) {
+	if network.ID == "" {
+		return TunnelVirtualNetwork{}, errors.Errorf("tunnel virtual network ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/teamnet/virtual_networks/%s", AccountRouteRoot, accountID, network.ID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, network)
+	if err != nil {
+		return TunnelVirtualNetwork{}, err
+	}
+
+	var networkResponse TunnelVirtualNetworkResponse
+	err = json.Unmarshal(res, &networkResponse)
+	if err != nil {
+		return TunnelVirtualNetwork{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return networkResponse.Result, nil
+}
+
+// DeleteTunnelVirtualNetwork deletes a virtual network.
+//
+// API reference: https://api.cloudflare.com/#tunnel-virtual-network-delete-virtual-network
+func (api *API) DeleteTunnelVirtualNetwork(ctx context.Context, accountID, networkID string) error {
+	uri := fmt.Sprintf("/%s/%s/teamnet/virtual_networks/%s", AccountRouteRoot, accountID, networkID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListTunnelVirtualNetworks(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("is_default"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "9f322de4-5988-4945-b770-f1d6ac200f86",
+					"name": "us-east-1-vpc",
+					"is_default_network": true,
+					"comment": "Staging VPC for data science",
+					"created_at": "2021-01-25T18:22:34.317854Z",
+					"deleted_at": null
+				}
+			]
+		}
+		`)
+	}
+
+	createdAt, _ := time.Parse(time.RFC3339, "2021-01-25T18:22:34.317854Z")
+	isDefault := true
+
+	want := []TunnelVirtualNetwork{{
+		ID:               "9f322de4-5988-4945-b770-f1d6ac200f86",
+		Name:             "us-east-1-vpc",
+		IsDefaultNetwork: true,
+		Comment:          "Staging VPC for data science",
+		CreatedAt:        &createdAt,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/virtual_networks", handler)
+
+	actual, err := client.ListTunnelVirtualNetworks(context.Background(), testAccountID, TunnelVirtualNetworksListParams{IsDefault: &isDefault})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateTunnelVirtualNetwork(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "9f322de4-5988-4945-b770-f1d6ac200f86",
+				"name": "us-east-1-vpc",
+				"is_default_network": false,
+				"comment": "Staging VPC"
+			}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/virtual_networks", handler)
+
+	network := TunnelVirtualNetwork{Name: "us-east-1-vpc", Comment: "Staging VPC"}
+	want := network
+	want.ID = "9f322de4-5988-4945-b770-f1d6ac200f86"
+
+	actual, err := client.CreateTunnelVirtualNetwork(context.Background(), testAccountID, network)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestDeleteTunnelVirtualNetwork(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {}
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/teamnet/virtual_networks/9f322de4-5988-4945-b770-f1d6ac200f86", handler)
+
+	err := client.DeleteTunnelVirtualNetwork(context.Background(), testAccountID, "9f322de4-5988-4945-b770-f1d6ac200f86")
+	assert.NoError(t, err)
+}
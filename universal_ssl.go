@@ -41,6 +41,17 @@ type universalSSLVerificationResponse struct {
 	Result []UniversalSSLVerificationDetails `json:"result"`
 }
 
+// UniversalSSLCertificatePackValidationMethodSetting represents the
+// validation method used to verify a certificate pack.
+type UniversalSSLCertificatePackValidationMethodSetting struct {
+	ValidationMethod string `json:"validation_method"`
+}
+
+type universalSSLCertificatePackValidationMethodSettingResponse struct {
+	Response
+	Result UniversalSSLCertificatePackValidationMethodSetting `json:"result"`
+}
+
 // UniversalSSLSettingDetails returns the details for a universal ssl setting
 //
 // API reference: https://api.cloudflare.com/#universal-ssl-settings-for-a-zone-universal-ssl-settings-details
@@ -89,3 +100,37 @@ func (api *API) UniversalSSLVerificationDetails(ctx context.Context, zoneID stri
 	}
 	return r.Result, nil
 }
+
+// RetryUniversalSSLVerification immediately retries verification of every
+// pending certificate pack and returns the updated verification details.
+//
+// API reference: https://api.cloudflare.com/#ssl-verification-ssl-verification-details
+func (api *API) RetryUniversalSSLVerification(ctx context.Context, zoneID string) ([]UniversalSSLVerificationDetails, error) {
+	uri := fmt.Sprintf("/zones/%s/ssl/verification?retry=true", zoneID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []UniversalSSLVerificationDetails{}, err
+	}
+	var r universalSSLVerificationResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return []UniversalSSLVerificationDetails{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UpdateUniversalSSLCertificatePackValidationMethod changes the validation
+// method of a certificate pack and retries its verification.
+//
+// API reference: https://api.cloudflare.com/#ssl-verification-edit-ssl-certificate-pack-validation-method
+func (api *API) UpdateUniversalSSLCertificatePackValidationMethod(ctx context.Context, zoneID string, certPackUUID string, setting UniversalSSLCertificatePackValidationMethodSetting) (UniversalSSLCertificatePackValidationMethodSetting, error) {
+	uri := fmt.Sprintf("/zones/%s/ssl/verification/%s", zoneID, certPackUUID)
+	res, err := api.makeRequestContext(ctx, http.MethodPatch, uri, setting)
+	if err != nil {
+		return UniversalSSLCertificatePackValidationMethodSetting{}, err
+	}
+	var r universalSSLCertificatePackValidationMethodSettingResponse
+	if err := json.Unmarshal(res, &r); err != nil {
+		return UniversalSSLCertificatePackValidationMethodSetting{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
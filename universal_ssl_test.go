@@ -121,3 +121,82 @@ func TestUniversalSSLVerificationDetails(t *testing.T) {
 		assert.Equal(t, want, got)
 	}
 }
+
+func TestRetryUniversalSSLVerification(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "true", r.URL.Query().Get("retry"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+			  {
+				"certificate_status": "pending_validation",
+				"verification_type": "cname",
+				"validation_method": "txt",
+				"cert_pack_uuid": "a77f8bd7-3b47-46b4-a6f1-75cf98109948",
+				"verification_status": false,
+				"brand_check": false
+			  }
+			]
+		  }`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ssl/verification", handler)
+
+	want := []UniversalSSLVerificationDetails{
+		{
+			CertificateStatus: "pending_validation",
+			VerificationType:  "cname",
+			ValidationMethod:  "txt",
+			CertPackUUID:      "a77f8bd7-3b47-46b4-a6f1-75cf98109948",
+		},
+	}
+
+	got, err := client.RetryUniversalSSLVerification(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, got)
+	}
+}
+
+func TestUpdateUniversalSSLCertificatePackValidationMethod(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			panic(err)
+		}
+		defer r.Body.Close()
+
+		assert.Equal(t, `{"validation_method":"txt"}`, string(body))
+
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+			  "validation_method": "txt"
+			}
+		  }`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/ssl/verification/a77f8bd7-3b47-46b4-a6f1-75cf98109948", handler)
+
+	want := UniversalSSLCertificatePackValidationMethodSetting{
+		ValidationMethod: "txt",
+	}
+
+	got, err := client.UpdateUniversalSSLCertificatePackValidationMethod(context.Background(), testZoneID, "a77f8bd7-3b47-46b4-a6f1-75cf98109948", want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, got)
+	}
+}
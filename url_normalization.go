@@ -0,0 +1,71 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// URL normalization types and scopes.
+const (
+	URLNormalizationTypeCloudflare = "cloudflare"
+	URLNormalizationTypeRFC3986    = "rfc3986"
+
+	URLNormalizationScopeIncoming = "incoming"
+	URLNormalizationScopeBoth     = "both"
+)
+
+// URLNormalizationSettings describes how the URLs of requests to a zone are
+// normalized, and whether the normalized URL is also sent to the origin
+// ("both") or only used by Cloudflare ("incoming").
+type URLNormalizationSettings struct {
+	Type  string `json:"type"`
+	Scope string `json:"scope"`
+}
+
+// URLNormalizationSettingsResponse is the API response for the URL
+// normalization settings of a zone.
+type URLNormalizationSettingsResponse struct {
+	Response
+	Result URLNormalizationSettings `json:"result"`
+}
+
+// URLNormalizationSettings returns the URL normalization settings of a zone.
+//
+// API reference: https://developers.cloudflare.com/rules/normalization/
+func (api *API) URLNormalizationSettings(ctx context.Context, zoneID string) (URLNormalizationSettings, error) {
+	return api.urlNormalizationRequest(ctx, http.MethodGet, zoneID, nil)
+}
+
+// UpdateURLNormalizationSettings updates the URL normalization settings of a
+// zone.
+//
+// API reference: https://developers.cloudflare.com/rules/normalization/
+func (api *API) UpdateURLNormalizationSettings(ctx context.Context, zoneID string, settings URLNormalizationSettings) (URLNormalizationSettings, error) {
+	if !contains([]string{URLNormalizationTypeCloudflare, URLNormalizationTypeRFC3986}, settings.Type) {
+		return URLNormalizationSettings{}, errors.Errorf("invalid URL normalization type %q", settings.Type)
+	}
+	if !contains([]string{URLNormalizationScopeIncoming, URLNormalizationScopeBoth}, settings.Scope) {
+		return URLNormalizationSettings{}, errors.Errorf("invalid URL normalization scope %q", settings.Scope)
+	}
+	return api.urlNormalizationRequest(ctx, http.MethodPut, zoneID, settings)
+}
+
+func (api *API) urlNormalizationRequest(ctx context.Context, method, zoneID string, params interface{}) (URLNormalizationSettings, error) {
+	uri := fmt.Sprintf("/zones/%s/url_normalization", zoneID)
+
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return URLNormalizationSettings{}, err
+	}
+
+	var r URLNormalizationSettingsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return URLNormalizationSettings{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
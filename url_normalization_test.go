@@ -0,0 +1,68 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestURLNormalizationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"type": "cloudflare", "scope": "incoming"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/url_normalization", handler)
+
+	actual, err := client.URLNormalizationSettings(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, URLNormalizationSettings{Type: URLNormalizationTypeCloudflare, Scope: URLNormalizationScopeIncoming}, actual)
+	}
+}
+
+func TestUpdateURLNormalizationSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"type": "rfc3986", "scope": "both"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"type": "rfc3986", "scope": "both"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/url_normalization", handler)
+
+	want := URLNormalizationSettings{Type: URLNormalizationTypeRFC3986, Scope: URLNormalizationScopeBoth}
+	actual, err := client.UpdateURLNormalizationSettings(context.Background(), testZoneID, want)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+
+	_, err = client.UpdateURLNormalizationSettings(context.Background(), testZoneID, URLNormalizationSettings{Type: "strict", Scope: URLNormalizationScopeBoth})
+	assert.EqualError(t, err, `invalid URL normalization type "strict"`)
+
+	_, err = client.UpdateURLNormalizationSettings(context.Background(), testZoneID, URLNormalizationSettings{Type: URLNormalizationTypeCloudflare, Scope: "origin"})
+	assert.EqualError(t, err, `invalid URL normalization scope "origin"`)
+}
@@ -40,15 +40,21 @@ type UserAgentRuleListResponse struct {
 	ResultInfo `json:"result_info"`
 }
 
+func validateUserAgentRuleMode(mode string) error {
+	switch mode {
+	case "block", "challenge", "js_challenge", "whitelist":
+		return nil
+	default:
+		return errors.New(`the User-Agent Block rule mode must be one of "block", "challenge", "js_challenge", "whitelist"`)
+	}
+}
+
 // CreateUserAgentRule creates a User-Agent Block rule for the given zone ID.
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-create-a-useragent-rule
 func (api *API) CreateUserAgentRule(ctx context.Context, zoneID string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
-	switch ld.Mode {
-	case "block", "challenge", "js_challenge", "whitelist":
-		break
-	default:
-		return nil, errors.New(`the User-Agent Block rule mode must be one of "block", "challenge", "js_challenge", "whitelist"`)
+	if err := validateUserAgentRuleMode(ld.Mode); err != nil {
+		return nil, err
 	}
 
 	uri := fmt.Sprintf("/zones/%s/firewall/ua_rules", zoneID)
@@ -70,6 +76,10 @@ func (api *API) CreateUserAgentRule(ctx context.Context, zoneID string, ld UserA
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-update-useragent-rule
 func (api *API) UpdateUserAgentRule(ctx context.Context, zoneID string, id string, ld UserAgentRule) (*UserAgentRuleResponse, error) {
+	if err := validateUserAgentRuleMode(ld.Mode); err != nil {
+		return nil, err
+	}
+
 	uri := fmt.Sprintf("/zones/%s/firewall/ua_rules/%s", zoneID, id)
 	res, err := api.makeRequestContext(ctx, http.MethodPut, uri, ld)
 	if err != nil {
@@ -123,10 +133,41 @@ func (api *API) UserAgentRule(ctx context.Context, zoneID string, id string) (*U
 	return response, nil
 }
 
+// UserAgentRuleListParams holds the filters used when listing User-Agent
+// Block rules.
+type UserAgentRuleListParams struct {
+	Description       string
+	DescriptionSearch string
+	UASearch          string
+}
+
 // ListUserAgentRules retrieves a list of User-Agent Block rules for a given zone ID by page number.
 //
 // API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-useragent-rules
 func (api *API) ListUserAgentRules(ctx context.Context, zoneID string, page int) (*UserAgentRuleListResponse, error) {
+	return api.listUserAgentRules(ctx, zoneID, UserAgentRuleListParams{}, page)
+}
+
+// ListAllUserAgentRules retrieves all User-Agent Block rules for a given zone
+// ID matching params, fetching every page of results.
+//
+// API reference: https://api.cloudflare.com/#user-agent-blocking-rules-list-useragent-rules
+func (api *API) ListAllUserAgentRules(ctx context.Context, zoneID string, params UserAgentRuleListParams) ([]UserAgentRule, error) {
+	var rules []UserAgentRule
+	for page := 1; ; page++ {
+		r, err := api.listUserAgentRules(ctx, zoneID, params, page)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, r.Result...)
+		if len(r.Result) == 0 || r.ResultInfo.Page >= r.ResultInfo.TotalPages {
+			break
+		}
+	}
+	return rules, nil
+}
+
+func (api *API) listUserAgentRules(ctx context.Context, zoneID string, params UserAgentRuleListParams, page int) (*UserAgentRuleListResponse, error) {
 	v := url.Values{}
 	if page <= 0 {
 		page = 1
@@ -135,6 +176,16 @@ func (api *API) ListUserAgentRules(ctx context.Context, zoneID string, page int)
 	v.Set("page", strconv.Itoa(page))
 	v.Set("per_page", strconv.Itoa(100))
 
+	if params.Description != "" {
+		v.Set("description", params.Description)
+	}
+	if params.DescriptionSearch != "" {
+		v.Set("description_search", params.DescriptionSearch)
+	}
+	if params.UASearch != "" {
+		v.Set("ua_search", params.UASearch)
+	}
+
 	uri := fmt.Sprintf("/zones/%s/firewall/ua_rules?%s", zoneID, v.Encode())
 	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
 	if err != nil {
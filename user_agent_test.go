@@ -0,0 +1,57 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestListAllUserAgentRules(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "curl", r.URL.Query().Get("ua_search"))
+		page := r.URL.Query().Get("page")
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{
+					"id": "ua-%[1]s",
+					"description": "block curl",
+					"mode": "block",
+					"paused": false,
+					"configuration": {"target": "ua", "value": "curl/7.%[1]s"}
+				}
+			],
+			"result_info": {"page": %[1]s, "per_page": 1, "count": 1, "total_count": 2, "total_pages": 2}
+		}`, page)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/firewall/ua_rules", handler)
+
+	want := []UserAgentRule{
+		{ID: "ua-1", Description: "block curl", Mode: "block", Configuration: UserAgentRuleConfig{Target: "ua", Value: "curl/7.1"}},
+		{ID: "ua-2", Description: "block curl", Mode: "block", Configuration: UserAgentRuleConfig{Target: "ua", Value: "curl/7.2"}},
+	}
+
+	actual, err := client.ListAllUserAgentRules(context.Background(), testZoneID, UserAgentRuleListParams{UASearch: "curl"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUpdateUserAgentRuleInvalidMode(t *testing.T) {
+	setup()
+	defer teardown()
+
+	_, err := client.UpdateUserAgentRule(context.Background(), testZoneID, "ua-1", UserAgentRule{Mode: "allow"})
+	assert.EqualError(t, err, `the User-Agent Block rule mode must be one of "block", "challenge", "js_challenge", "whitelist"`)
+}
@@ -0,0 +1,109 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// User invitation statuses.
+const (
+	UserInviteStatusPending  = "pending"
+	UserInviteStatusAccepted = "accepted"
+	UserInviteStatusRejected = "rejected"
+	UserInviteStatusExpired  = "expired"
+)
+
+// UserInvite is an invitation for the logged-in user to join an
+// organization (account).
+type UserInvite struct {
+	ID                 string     `json:"id"`
+	InvitedMemberID    string     `json:"invited_member_id,omitempty"`
+	InvitedMemberEmail string     `json:"invited_member_email,omitempty"`
+	OrganizationID     string     `json:"organization_id,omitempty"`
+	OrganizationName   string     `json:"organization_name,omitempty"`
+	Roles              []string   `json:"roles,omitempty"`
+	InvitedBy          string     `json:"invited_by,omitempty"`
+	InvitedOn          *time.Time `json:"invited_on,omitempty"`
+	ExpiresOn          *time.Time `json:"expires_on,omitempty"`
+	Status             string     `json:"status"`
+}
+
+// UserInviteResponse is the API response containing a single invitation.
+type UserInviteResponse struct {
+	Response
+	Result UserInvite `json:"result"`
+}
+
+// UserInvitesResponse is the API response containing the invitations of
+// the logged-in user.
+type UserInvitesResponse struct {
+	Response
+	Result []UserInvite `json:"result"`
+}
+
+// UserInvites returns the invitations of the logged-in user.
+//
+// API reference: https://api.cloudflare.com/#user's-invites-list-invitations
+func (api *API) UserInvites(ctx context.Context) ([]UserInvite, error) {
+	res, err := api.makeRequestContext(ctx, http.MethodGet, "/user/invites", nil)
+	if err != nil {
+		return []UserInvite{}, err
+	}
+
+	var r UserInvitesResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []UserInvite{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// UserInvite returns a single invitation of the logged-in user.
+//
+// API reference: https://api.cloudflare.com/#user's-invites-invitation-details
+func (api *API) UserInvite(ctx context.Context, inviteID string) (UserInvite, error) {
+	if inviteID == "" {
+		return UserInvite{}, errors.New("invite ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/user/invites/%s", inviteID)
+	return api.userInviteRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// RespondToUserInvite accepts or rejects an invitation of the logged-in
+// user. status is UserInviteStatusAccepted or UserInviteStatusRejected.
+//
+// API reference: https://api.cloudflare.com/#user's-invites-respond-to-invitation
+func (api *API) RespondToUserInvite(ctx context.Context, inviteID, status string) (UserInvite, error) {
+	if inviteID == "" {
+		return UserInvite{}, errors.New("invite ID cannot be empty")
+	}
+	if status != UserInviteStatusAccepted && status != UserInviteStatusRejected {
+		return UserInvite{}, errors.Errorf("invite status must be %q or %q", UserInviteStatusAccepted, UserInviteStatusRejected)
+	}
+
+	uri := fmt.Sprintf("/user/invites/%s", inviteID)
+	params := struct {
+		Status string `json:"status"`
+	}{status}
+	return api.userInviteRequest(ctx, http.MethodPatch, uri, params)
+}
+
+func (api *API) userInviteRequest(ctx context.Context, method, uri string, params interface{}) (UserInvite, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return UserInvite{}, err
+	}
+
+	var r UserInviteResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserInvite{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,114 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserInvites(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "4f5f0c14a2a41d5063dd301b2f829f04",
+				"invited_member_id": "5a7805061c76ada191ed06f989cc3dac",
+				"invited_member_email": "user@example.com",
+				"organization_id": "5a7805061c76ada191ed06f989cc3dac",
+				"organization_name": "Cloudflare, Inc.",
+				"roles": ["Account Administrator"],
+				"invited_by": "admin@example.com",
+				"invited_on": "2014-01-01T05:20:00Z",
+				"expires_on": "2014-01-08T05:20:00Z",
+				"status": "pending"
+			}]
+		}`)
+	}
+
+	mux.HandleFunc("/user/invites", handler)
+
+	invitedOn, _ := time.Parse(time.RFC3339, "2014-01-01T05:20:00Z")
+	expiresOn, _ := time.Parse(time.RFC3339, "2014-01-08T05:20:00Z")
+	want := []UserInvite{{
+		ID:                 "4f5f0c14a2a41d5063dd301b2f829f04",
+		InvitedMemberID:    "5a7805061c76ada191ed06f989cc3dac",
+		InvitedMemberEmail: "user@example.com",
+		OrganizationID:     "5a7805061c76ada191ed06f989cc3dac",
+		OrganizationName:   "Cloudflare, Inc.",
+		Roles:              []string{"Account Administrator"},
+		InvitedBy:          "admin@example.com",
+		InvitedOn:          &invitedOn,
+		ExpiresOn:          &expiresOn,
+		Status:             UserInviteStatusPending,
+	}}
+
+	actual, err := client.UserInvites(context.Background())
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestUserInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "4f5f0c14a2a41d5063dd301b2f829f04", "organization_name": "Cloudflare, Inc.", "status": "pending"}
+		}`)
+	}
+
+	mux.HandleFunc("/user/invites/4f5f0c14a2a41d5063dd301b2f829f04", handler)
+
+	actual, err := client.UserInvite(context.Background(), "4f5f0c14a2a41d5063dd301b2f829f04")
+	if assert.NoError(t, err) {
+		assert.Equal(t, UserInvite{ID: "4f5f0c14a2a41d5063dd301b2f829f04", OrganizationName: "Cloudflare, Inc.", Status: UserInviteStatusPending}, actual)
+	}
+}
+
+func TestRespondToUserInvite(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"status": "accepted"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "4f5f0c14a2a41d5063dd301b2f829f04", "status": "accepted"}
+		}`)
+	}
+
+	mux.HandleFunc("/user/invites/4f5f0c14a2a41d5063dd301b2f829f04", handler)
+
+	actual, err := client.RespondToUserInvite(context.Background(), "4f5f0c14a2a41d5063dd301b2f829f04", UserInviteStatusAccepted)
+	if assert.NoError(t, err) {
+		assert.Equal(t, UserInviteStatusAccepted, actual.Status)
+	}
+
+	_, err = client.RespondToUserInvite(context.Background(), "4f5f0c14a2a41d5063dd301b2f829f04", UserInviteStatusExpired)
+	assert.EqualError(t, err, `invite status must be "accepted" or "rejected"`)
+}
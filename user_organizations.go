@@ -0,0 +1,105 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/pkg/errors"
+)
+
+// UserOrganization is an organization (account) the logged-in user is a
+// member of.
+type UserOrganization struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Status      string   `json:"status,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	Roles       []string `json:"roles,omitempty"`
+}
+
+// UserOrganizationResponse is the API response containing a single
+// organization.
+type UserOrganizationResponse struct {
+	Response
+	Result UserOrganization `json:"result"`
+}
+
+// UserOrganizationsResponse is the API response containing the
+// organizations of the logged-in user.
+type UserOrganizationsResponse struct {
+	Response
+	Result     []UserOrganization `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// UserOrganizations returns the organizations the logged-in user is a
+// member of.
+//
+// API reference: https://api.cloudflare.com/#user's-organizations-list-organizations
+func (api *API) UserOrganizations(ctx context.Context, pageOpts PaginationOptions) ([]UserOrganization, ResultInfo, error) {
+	v := url.Values{}
+	if pageOpts.PerPage > 0 {
+		v.Set("per_page", strconv.Itoa(pageOpts.PerPage))
+	}
+	if pageOpts.Page > 0 {
+		v.Set("page", strconv.Itoa(pageOpts.Page))
+	}
+
+	uri := "/user/organizations"
+	if len(v) > 0 {
+		uri = fmt.Sprintf("%s?%s", uri, v.Encode())
+	}
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []UserOrganization{}, ResultInfo{}, err
+	}
+
+	var r UserOrganizationsResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return []UserOrganization{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, r.ResultInfo, nil
+}
+
+// UserOrganization returns a single organization the logged-in user is a
+// member of.
+//
+// API reference: https://api.cloudflare.com/#user's-organizations-organization-details
+func (api *API) UserOrganization(ctx context.Context, organizationID string) (UserOrganization, error) {
+	if organizationID == "" {
+		return UserOrganization{}, errors.New("organization ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/user/organizations/%s", organizationID)
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return UserOrganization{}, err
+	}
+
+	var r UserOrganizationResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return UserOrganization{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
+
+// LeaveUserOrganization removes the logged-in user's membership of an
+// organization.
+//
+// API reference: https://api.cloudflare.com/#user's-organizations-leave-organization
+func (api *API) LeaveUserOrganization(ctx context.Context, organizationID string) error {
+	if organizationID == "" {
+		return errors.New("organization ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/user/organizations/%s", organizationID)
+	_, err := api.makeRequestContext(ctx, http.MethodDelete, uri, nil)
+	return err
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserOrganizations(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		assert.Equal(t, "50", r.URL.Query().Get("per_page"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [{
+				"id": "01a7362d577a6c3019a474fd6f485823",
+				"name": "Cloudflare, Inc.",
+				"status": "member",
+				"permissions": ["#zones:read"],
+				"roles": ["All Privileges - Super Administrator"]
+			}],
+			"result_info": {"page": 1, "per_page": 50, "count": 1, "total_count": 1}
+		}`)
+	}
+
+	mux.HandleFunc("/user/organizations", handler)
+
+	actual, resultInfo, err := client.UserOrganizations(context.Background(), PaginationOptions{PerPage: 50})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []UserOrganization{{
+			ID:          "01a7362d577a6c3019a474fd6f485823",
+			Name:        "Cloudflare, Inc.",
+			Status:      "member",
+			Permissions: []string{"#zones:read"},
+			Roles:       []string{"All Privileges - Super Administrator"},
+		}}, actual)
+		assert.Equal(t, 1, resultInfo.Total)
+	}
+}
+
+func TestUserOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "01a7362d577a6c3019a474fd6f485823", "name": "Cloudflare, Inc."}
+		}`)
+	}
+
+	mux.HandleFunc("/user/organizations/01a7362d577a6c3019a474fd6f485823", handler)
+
+	actual, err := client.UserOrganization(context.Background(), "01a7362d577a6c3019a474fd6f485823")
+	if assert.NoError(t, err) {
+		assert.Equal(t, UserOrganization{ID: "01a7362d577a6c3019a474fd6f485823", Name: "Cloudflare, Inc."}, actual)
+	}
+}
+
+func TestLeaveUserOrganization(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{"id": "01a7362d577a6c3019a474fd6f485823"}`)
+	}
+
+	mux.HandleFunc("/user/organizations/01a7362d577a6c3019a474fd6f485823", handler)
+
+	err := client.LeaveUserOrganization(context.Background(), "01a7362d577a6c3019a474fd6f485823")
+	assert.NoError(t, err)
+
+	err = client.LeaveUserOrganization(context.Background(), "")
+	assert.EqualError(t, err, "organization ID cannot be empty")
+}
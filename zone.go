@@ -73,10 +73,15 @@ type ZonePlan struct {
 	ExternallyManaged bool   `json:"externally_managed"`
 }
 
-// ZoneRatePlan contains the plan information for a zone.
+// ZoneRatePlan contains the plan information for a zone. It is also the
+// rate plan of a zone or account subscription.
 type ZoneRatePlan struct {
 	ZonePlanCommon
-	Components []zoneRatePlanComponents `json:"components,omitempty"`
+	Components        []zoneRatePlanComponents `json:"components,omitempty"`
+	PublicName        string                   `json:"public_name,omitempty"`
+	Scope             string                   `json:"scope,omitempty"`
+	IsContract        bool                     `json:"is_contract,omitempty"`
+	ExternallyManaged bool                     `json:"externally_managed,omitempty"`
 }
 
 // ZonePlanCommon contains fields used by various Plan endpoints
@@ -248,16 +253,111 @@ type ZoneAnalyticsOptions struct {
 	Continuous *bool
 }
 
+// purgeCacheMaxItems is the number of files, tags, hosts or prefixes the
+// purge endpoint accepts in a single request.
+const purgeCacheMaxItems = 30
+
 // PurgeCacheRequest represents the request format made to the purge endpoint.
+// Build it with field names: fields are added as the endpoint gains purge
+// types, so positional literals do not compile across versions.
 type PurgeCacheRequest struct {
 	Everything bool `json:"purge_everything,omitempty"`
-	// Purge by filepath (exact match). Limit of 30
+	// Purge by filepath (exact match)
 	Files []string `json:"files,omitempty"`
+	// Purge by filepath, for files cached with a cache key that includes
+	// request headers. Sent alongside Files.
+	FilesWithHeaders []PurgeCacheFile `json:"-"`
 	// Purge by Tag (Enterprise only):
 	// https://support.cloudflare.com/hc/en-us/articles/206596608-How-to-Purge-Cache-Using-Cache-Tags-Enterprise-only-
 	Tags []string `json:"tags,omitempty"`
 	// Purge by hostname - e.g. "assets.example.com"
 	Hosts []string `json:"hosts,omitempty"`
+	// Purge by URL prefix (Enterprise only) - e.g. "www.example.com/assets"
+	Prefixes []string `json:"prefixes,omitempty"`
+}
+
+// PurgeCacheFile is a file to purge along with the request headers, such as
+// "Origin" or "CF-IPCountry", that are part of its cache key.
+type PurgeCacheFile struct {
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+}
+
+// MarshalJSON sends Files and FilesWithHeaders as a single list of files.
+func (pcr PurgeCacheRequest) MarshalJSON() ([]byte, error) {
+	files := make([]interface{}, 0, len(pcr.Files)+len(pcr.FilesWithHeaders))
+	for _, f := range pcr.Files {
+		files = append(files, f)
+	}
+	for _, f := range pcr.FilesWithHeaders {
+		files = append(files, f)
+	}
+
+	type request PurgeCacheRequest
+	return json.Marshal(struct {
+		request
+		Files []interface{} `json:"files,omitempty"`
+	}{request(pcr), files})
+}
+
+// batches splits the request into requests within the per-request item
+// limit. Requests that are already within the limit are returned unchanged.
+func (pcr PurgeCacheRequest) batches() []PurgeCacheRequest {
+	if len(pcr.Files)+len(pcr.FilesWithHeaders) <= purgeCacheMaxItems && len(pcr.Tags) <= purgeCacheMaxItems &&
+		len(pcr.Hosts) <= purgeCacheMaxItems && len(pcr.Prefixes) <= purgeCacheMaxItems {
+		return []PurgeCacheRequest{pcr}
+	}
+
+	var batches []PurgeCacheRequest
+	for i := 0; i < len(pcr.Files); i += purgeCacheMaxItems {
+		batches = append(batches, PurgeCacheRequest{Files: pcr.Files[i:purgeCacheBatchEnd(i, len(pcr.Files))]})
+	}
+	for i := 0; i < len(pcr.FilesWithHeaders); i += purgeCacheMaxItems {
+		batches = append(batches, PurgeCacheRequest{FilesWithHeaders: pcr.FilesWithHeaders[i:purgeCacheBatchEnd(i, len(pcr.FilesWithHeaders))]})
+	}
+	for i := 0; i < len(pcr.Tags); i += purgeCacheMaxItems {
+		batches = append(batches, PurgeCacheRequest{Tags: pcr.Tags[i:purgeCacheBatchEnd(i, len(pcr.Tags))]})
+	}
+	for i := 0; i < len(pcr.Hosts); i += purgeCacheMaxItems {
+		batches = append(batches, PurgeCacheRequest{Hosts: pcr.Hosts[i:purgeCacheBatchEnd(i, len(pcr.Hosts))]})
+	}
+	for i := 0; i < len(pcr.Prefixes); i += purgeCacheMaxItems {
+		batches = append(batches, PurgeCacheRequest{Prefixes: pcr.Prefixes[i:purgeCacheBatchEnd(i, len(pcr.Prefixes))]})
+	}
+	return batches
+}
+
+func purgeCacheBatchEnd(start, n int) int {
+	if start+purgeCacheMaxItems < n {
+		return start + purgeCacheMaxItems
+	}
+	return n
+}
+
+// PurgeCacheBatchError is returned by PurgeCache when a request that was
+// split into several purge requests fails part way through. The batches
+// in Purged have been purged; Failed and Remaining have not, and can be
+// retried.
+type PurgeCacheBatchError struct {
+	Purged    []PurgeCacheRequest
+	Failed    PurgeCacheRequest
+	Remaining []PurgeCacheRequest
+	Err       error
+}
+
+func (e *PurgeCacheBatchError) Error() string {
+	total := len(e.Purged) + 1 + len(e.Remaining)
+	return fmt.Sprintf("purge request %d of %d failed: %s", len(e.Purged)+1, total, e.Err)
+}
+
+// Cause returns the error of the failed purge request.
+func (e *PurgeCacheBatchError) Cause() error {
+	return e.Err
+}
+
+// Unwrap returns the error of the failed purge request.
+func (e *PurgeCacheBatchError) Unwrap() error {
+	return e.Err
 }
 
 // PurgeCacheResponse represents the response from the purge endpoint.
@@ -290,14 +390,6 @@ type FallbackOriginResponse struct {
 	Result FallbackOrigin `json:"result"`
 }
 
-// zoneSubscriptionRatePlanPayload is used to build the JSON payload for
-// setting a particular rate plan on an existing zone.
-type zoneSubscriptionRatePlanPayload struct {
-	RatePlan struct {
-		ID string `json:"id"`
-	} `json:"rate_plan"`
-}
-
 // CreateZone creates a zone on an account.
 //
 // Setting jumpstart to true will attempt to automatically scan for existing
@@ -544,19 +636,12 @@ func (api *API) ZoneSetVanityNS(ctx context.Context, zoneID string, ns []string)
 // Valid values for `planType` are "CF_FREE", "CF_PRO", "CF_BIZ" and
 // "CF_ENT".
 //
+// Deprecated: Use CreateZoneSubscription.
+//
 // API reference: https://api.cloudflare.com/#zone-subscription-create-zone-subscription
 func (api *API) ZoneSetPlan(ctx context.Context, zoneID string, planType string) error {
-	zonePayload := zoneSubscriptionRatePlanPayload{}
-	zonePayload.RatePlan.ID = planType
-
-	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
-
-	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, zonePayload)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_, err := api.CreateZoneSubscription(ctx, zoneID, ZoneSubscriptionParams{RatePlanID: planType})
+	return err
 }
 
 // ZoneUpdatePlan updates the rate plan of an existing zone.
@@ -564,19 +649,12 @@ func (api *API) ZoneSetPlan(ctx context.Context, zoneID string, planType string)
 // Valid values for `planType` are "CF_FREE", "CF_PRO", "CF_BIZ" and
 // "CF_ENT".
 //
+// Deprecated: Use UpdateZoneSubscription.
+//
 // API reference: https://api.cloudflare.com/#zone-subscription-update-zone-subscription
 func (api *API) ZoneUpdatePlan(ctx context.Context, zoneID string, planType string) error {
-	zonePayload := zoneSubscriptionRatePlanPayload{}
-	zonePayload.RatePlan.ID = planType
-
-	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
-
-	_, err := api.makeRequestContext(ctx, http.MethodPut, uri, zonePayload)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	_, err := api.UpdateZoneSubscription(ctx, zoneID, ZoneSubscriptionParams{RatePlanID: planType})
+	return err
 }
 
 // EditZone edits the given zone.
@@ -606,7 +684,7 @@ func (api *API) EditZone(ctx context.Context, zoneID string, zoneOpts ZoneOption
 // API reference: https://api.cloudflare.com/#zone-purge-all-files
 func (api *API) PurgeEverything(ctx context.Context, zoneID string) (PurgeCacheResponse, error) {
 	uri := fmt.Sprintf("/zones/%s/purge_cache", zoneID)
-	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, PurgeCacheRequest{true, nil, nil, nil})
+	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, PurgeCacheRequest{Everything: true})
 	if err != nil {
 		return PurgeCacheResponse{}, err
 	}
@@ -618,17 +696,63 @@ func (api *API) PurgeEverything(ctx context.Context, zoneID string) (PurgeCacheR
 	return r, nil
 }
 
-// PurgeCache purges the cache using the given PurgeCacheRequest (zone/url/tag).
+// PurgeCache purges the cache using the given PurgeCacheRequest
+// (everything/url/tag/host/prefix).
+//
+// A request that purges nothing, or that combines Everything with other
+// items, is rejected without calling the API.
+//
+// Requests with more than 30 files, tags, hosts or prefixes are split into
+// several purge requests, made one after another, and their responses are
+// combined into one. This is not atomic: purging stops at the first failed
+// request and a *PurgeCacheBatchError is returned, recording which batches
+// were purged and which were not.
 //
 // API reference: https://api.cloudflare.com/#zone-purge-individual-files-by-url-and-cache-tags
 func (api *API) PurgeCache(ctx context.Context, zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
 	return api.PurgeCacheContext(ctx, zoneID, pcr)
 }
 
-// PurgeCacheContext purges the cache using the given PurgeCacheRequest (zone/url/tag).
+// PurgeCacheContext purges the cache using the given PurgeCacheRequest
+// (everything/url/tag/host/prefix). It validates and splits up the request,
+// and reports partial failures, as PurgeCache does.
 //
 // API reference: https://api.cloudflare.com/#zone-purge-individual-files-by-url-and-cache-tags
 func (api *API) PurgeCacheContext(ctx context.Context, zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
+	hasItems := len(pcr.Files) > 0 || len(pcr.FilesWithHeaders) > 0 || len(pcr.Tags) > 0 || len(pcr.Hosts) > 0 || len(pcr.Prefixes) > 0
+	if pcr.Everything && hasItems {
+		return PurgeCacheResponse{}, errors.New("purging everything cannot be combined with files, tags, hosts or prefixes")
+	}
+	if !pcr.Everything && !hasItems {
+		return PurgeCacheResponse{}, errors.New("nothing to purge")
+	}
+
+	batches := pcr.batches()
+	if len(batches) == 1 {
+		return api.purgeCache(ctx, zoneID, pcr)
+	}
+
+	result := PurgeCacheResponse{}
+	result.Success = true
+	for i, batch := range batches {
+		r, err := api.purgeCache(ctx, zoneID, batch)
+		if err != nil {
+			return result, &PurgeCacheBatchError{
+				Purged:    batches[:i],
+				Failed:    batch,
+				Remaining: batches[i+1:],
+				Err:       err,
+			}
+		}
+		result.Success = result.Success && r.Success
+		result.Errors = append(result.Errors, r.Errors...)
+		result.Messages = append(result.Messages, r.Messages...)
+		result.Result.ID = r.Result.ID
+	}
+	return result, nil
+}
+
+func (api *API) purgeCache(ctx context.Context, zoneID string, pcr PurgeCacheRequest) (PurgeCacheResponse, error) {
 	uri := fmt.Sprintf("/zones/%s/purge_cache", zoneID)
 	res, err := api.makeRequestContext(ctx, http.MethodPost, uri, pcr)
 	if err != nil {
@@ -0,0 +1,84 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneHold is the hold of a zone, which prevents the zone's hostname, and
+// optionally its subdomains, from being added as a zone by another account.
+type ZoneHold struct {
+	Hold              *bool      `json:"hold,omitempty"`
+	IncludeSubdomains *bool      `json:"include_subdomains,omitempty"`
+	HoldAfter         *time.Time `json:"hold_after,omitempty"`
+}
+
+// ZoneHoldResponse is the API response containing the hold of a zone.
+type ZoneHoldResponse struct {
+	Result ZoneHold `json:"result"`
+	Response
+}
+
+// CreateZoneHoldParams holds the options of a new zone hold.
+type CreateZoneHoldParams struct {
+	IncludeSubdomains *bool
+}
+
+// DeleteZoneHoldParams holds the options used when removing a zone hold.
+type DeleteZoneHoldParams struct {
+	// HoldAfter, when set, removes the hold only until this time, after
+	// which it is automatically re-enabled.
+	HoldAfter *time.Time
+}
+
+// ZoneHold returns the hold of a zone.
+//
+// API reference: https://developers.cloudflare.com/fundamentals/setup/account/account-security/zone-holds/
+func (api *API) ZoneHold(ctx context.Context, zoneID string) (ZoneHold, error) {
+	uri := fmt.Sprintf("/zones/%s/hold", zoneID)
+	return api.zoneHoldRequest(ctx, http.MethodGet, uri)
+}
+
+// CreateZoneHold enforces a hold on a zone.
+//
+// API reference: https://developers.cloudflare.com/fundamentals/setup/account/account-security/zone-holds/
+func (api *API) CreateZoneHold(ctx context.Context, zoneID string, params CreateZoneHoldParams) (ZoneHold, error) {
+	uri := fmt.Sprintf("/zones/%s/hold", zoneID)
+	if params.IncludeSubdomains != nil {
+		uri += "?" + url.Values{"include_subdomains": {strconv.FormatBool(*params.IncludeSubdomains)}}.Encode()
+	}
+	return api.zoneHoldRequest(ctx, http.MethodPost, uri)
+}
+
+// DeleteZoneHold removes the hold of a zone, either permanently or until
+// params.HoldAfter.
+//
+// API reference: https://developers.cloudflare.com/fundamentals/setup/account/account-security/zone-holds/
+func (api *API) DeleteZoneHold(ctx context.Context, zoneID string, params DeleteZoneHoldParams) (ZoneHold, error) {
+	uri := fmt.Sprintf("/zones/%s/hold", zoneID)
+	if params.HoldAfter != nil {
+		uri += "?" + url.Values{"hold_after": {params.HoldAfter.UTC().Format(time.RFC3339)}}.Encode()
+	}
+	return api.zoneHoldRequest(ctx, http.MethodDelete, uri)
+}
+
+func (api *API) zoneHoldRequest(ctx context.Context, method, uri string) (ZoneHold, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, nil)
+	if err != nil {
+		return ZoneHold{}, err
+	}
+
+	var r ZoneHoldResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneHold{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
@@ -0,0 +1,90 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"hold": true, "include_subdomains": false, "hold_after": "2023-01-31T15:56:36Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hold", handler)
+
+	hold, includeSubdomains := true, false
+	holdAfter, _ := time.Parse(time.RFC3339, "2023-01-31T15:56:36Z")
+	want := ZoneHold{Hold: &hold, IncludeSubdomains: &includeSubdomains, HoldAfter: &holdAfter}
+
+	actual, err := client.ZoneHold(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateZoneHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		assert.Equal(t, "include_subdomains=true", r.URL.RawQuery)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"hold": true, "include_subdomains": true}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hold", handler)
+
+	enabled := true
+	actual, err := client.CreateZoneHold(context.Background(), testZoneID, CreateZoneHoldParams{IncludeSubdomains: &enabled})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneHold{Hold: &enabled, IncludeSubdomains: &enabled}, actual)
+	}
+}
+
+func TestDeleteZoneHold(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodDelete, r.Method, "Expected method 'DELETE', got %s", r.Method)
+		assert.Equal(t, "2023-01-31T15:56:36Z", r.URL.Query().Get("hold_after"))
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"hold": false, "hold_after": "2023-01-31T15:56:36Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/hold", handler)
+
+	holdAfter, _ := time.Parse(time.RFC3339, "2023-01-31T15:56:36Z")
+	hold := false
+
+	actual, err := client.DeleteZoneHold(context.Background(), testZoneID, DeleteZoneHoldParams{HoldAfter: &holdAfter})
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneHold{Hold: &hold, HoldAfter: &holdAfter}, actual)
+	}
+}
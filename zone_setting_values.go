@@ -0,0 +1,119 @@
+package cloudflare
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneSettingMinify is the value of the "minify" zone setting. Each field is
+// "on" or "off".
+type ZoneSettingMinify struct {
+	CSS  string `json:"css"`
+	HTML string `json:"html"`
+	JS   string `json:"js"`
+}
+
+// ZoneSettingHSTS contains the HTTP Strict Transport Security configuration
+// of a zone.
+type ZoneSettingHSTS struct {
+	Enabled bool `json:"enabled"`
+	// MaxAge is in seconds.
+	MaxAge            int  `json:"max_age"`
+	IncludeSubdomains bool `json:"include_subdomains"`
+	Preload           bool `json:"preload"`
+	// NoSniff sends "X-Content-Type-Options: nosniff".
+	NoSniff bool `json:"nosniff"`
+}
+
+// ZoneSettingSecurityHeader is the value of the "security_header" zone
+// setting.
+type ZoneSettingSecurityHeader struct {
+	StrictTransportSecurity ZoneSettingHSTS `json:"strict_transport_security"`
+}
+
+// ZoneSettingMobileRedirect is the value of the "mobile_redirect" zone
+// setting.
+type ZoneSettingMobileRedirect struct {
+	// Status is "on" or "off".
+	Status          string `json:"status"`
+	MobileSubdomain string `json:"mobile_subdomain"`
+	StripURI        bool   `json:"strip_uri"`
+}
+
+// Setting returns the setting with the given ID from a response containing
+// all settings of a zone.
+func (r ZoneSettingResponse) Setting(id string) (ZoneSetting, bool) {
+	for _, s := range r.Result {
+		if s.ID == id {
+			return s, true
+		}
+	}
+	return ZoneSetting{}, false
+}
+
+// StringValue returns the value of a setting whose value is a string, such
+// as "on", "off" or "strict".
+func (s ZoneSetting) StringValue() (string, error) {
+	v, ok := s.Value.(string)
+	if !ok {
+		return "", errors.Errorf("zone setting %s does not have a string value", s.ID)
+	}
+	return v, nil
+}
+
+// Minify returns the value of the "minify" setting.
+func (s ZoneSetting) Minify() (ZoneSettingMinify, error) {
+	var v ZoneSettingMinify
+	err := s.decodeValue("minify", &v)
+	return v, err
+}
+
+// SecurityHeader returns the value of the "security_header" setting.
+func (s ZoneSetting) SecurityHeader() (ZoneSettingSecurityHeader, error) {
+	var v ZoneSettingSecurityHeader
+	err := s.decodeValue("security_header", &v)
+	return v, err
+}
+
+// MobileRedirect returns the value of the "mobile_redirect" setting.
+func (s ZoneSetting) MobileRedirect() (ZoneSettingMobileRedirect, error) {
+	var v ZoneSettingMobileRedirect
+	err := s.decodeValue("mobile_redirect", &v)
+	return v, err
+}
+
+// decodeValue decodes the value of a setting, which is a map once read from
+// the API, into v.
+func (s ZoneSetting) decodeValue(id string, v interface{}) error {
+	if s.ID != id {
+		return errors.Errorf("zone setting %s is not %s", s.ID, id)
+	}
+
+	b, err := json.Marshal(s.Value)
+	if err != nil {
+		return errors.Wrapf(err, "could not decode zone setting %s", id)
+	}
+	if err := json.Unmarshal(b, v); err != nil {
+		return errors.Wrapf(err, "could not decode zone setting %s", id)
+	}
+	return nil
+}
+
+// NewZoneSettingMinify returns a "minify" setting, for use with
+// UpdateZoneSettings.
+func NewZoneSettingMinify(v ZoneSettingMinify) ZoneSetting {
+	return ZoneSetting{ID: "minify", Value: v}
+}
+
+// NewZoneSettingSecurityHeader returns a "security_header" setting, for use
+// with UpdateZoneSettings.
+func NewZoneSettingSecurityHeader(v ZoneSettingSecurityHeader) ZoneSetting {
+	return ZoneSetting{ID: "security_header", Value: v}
+}
+
+// NewZoneSettingMobileRedirect returns a "mobile_redirect" setting, for use
+// with UpdateZoneSettings.
+func NewZoneSettingMobileRedirect(v ZoneSettingMobileRedirect) ZoneSetting {
+	return ZoneSetting{ID: "mobile_redirect", Value: v}
+}
@@ -0,0 +1,122 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneSettingsTypedValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "ssl", "value": "strict", "editable": true},
+				{"id": "minify", "value": {"css": "on", "html": "off", "js": "on"}, "editable": true},
+				{
+					"id": "security_header",
+					"value": {
+						"strict_transport_security": {"enabled": true, "max_age": 86400, "include_subdomains": true, "preload": false, "nosniff": true}
+					},
+					"editable": true
+				},
+				{"id": "mobile_redirect", "value": {"status": "on", "mobile_subdomain": "m", "strip_uri": false}, "editable": true}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", handler)
+
+	settings, err := client.ZoneSettings(context.Background(), testZoneID)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ssl, ok := settings.Setting("ssl")
+	if assert.True(t, ok) {
+		value, err := ssl.StringValue()
+		if assert.NoError(t, err) {
+			assert.Equal(t, "strict", value)
+		}
+	}
+
+	minify, _ := settings.Setting("minify")
+	minifyValue, err := minify.Minify()
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSettingMinify{CSS: "on", HTML: "off", JS: "on"}, minifyValue)
+	}
+
+	securityHeader, _ := settings.Setting("security_header")
+	securityHeaderValue, err := securityHeader.SecurityHeader()
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSettingHSTS{Enabled: true, MaxAge: 86400, IncludeSubdomains: true, NoSniff: true}, securityHeaderValue.StrictTransportSecurity)
+	}
+
+	mobileRedirect, _ := settings.Setting("mobile_redirect")
+	mobileRedirectValue, err := mobileRedirect.MobileRedirect()
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSettingMobileRedirect{Status: "on", MobileSubdomain: "m"}, mobileRedirectValue)
+	}
+
+	_, err = ssl.Minify()
+	assert.EqualError(t, err, "zone setting ssl is not minify")
+
+	_, err = minify.StringValue()
+	assert.EqualError(t, err, "zone setting minify does not have a string value")
+
+	_, ok = settings.Setting("waf")
+	assert.False(t, ok)
+}
+
+func TestUpdateZoneSettingsTypedValues(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"items": [
+				{"id": "minify", "editable": false, "time_remaining": 0, "value": {"css": "on", "html": "on", "js": "off"}},
+				{"id": "mobile_redirect", "editable": false, "time_remaining": 0, "value": {"status": "off", "mobile_subdomain": "", "strip_uri": false}}
+			]}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": [
+				{"id": "minify", "value": {"css": "on", "html": "on", "js": "off"}, "editable": true},
+				{"id": "mobile_redirect", "value": {"status": "off", "mobile_subdomain": null, "strip_uri": false}, "editable": true}
+			]
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings", handler)
+
+	settings, err := client.UpdateZoneSettings(context.Background(), testZoneID, []ZoneSetting{
+		NewZoneSettingMinify(ZoneSettingMinify{CSS: "on", HTML: "on", JS: "off"}),
+		NewZoneSettingMobileRedirect(ZoneSettingMobileRedirect{Status: "off"}),
+	})
+	if assert.NoError(t, err) {
+		minify, ok := settings.Setting("minify")
+		if assert.True(t, ok) {
+			value, err := minify.Minify()
+			if assert.NoError(t, err) {
+				assert.Equal(t, "off", value.JS)
+			}
+		}
+	}
+}
@@ -0,0 +1,215 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+// ZoneEarlyHints returns whether Early Hints (103 responses) are enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-early-hints-setting
+func (api *API) ZoneEarlyHints(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "early_hints", nil)
+}
+
+// UpdateZoneEarlyHints enables or disables Early Hints for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-early-hints-setting
+func (api *API) UpdateZoneEarlyHints(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "early_hints", &enabled)
+}
+
+// ZoneHTTP3 returns whether HTTP/3 is enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-http3-setting
+func (api *API) ZoneHTTP3(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "http3", nil)
+}
+
+// UpdateZoneHTTP3 enables or disables HTTP/3 for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-http3-setting
+func (api *API) UpdateZoneHTTP3(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "http3", &enabled)
+}
+
+// ZoneZeroRTT returns whether 0-RTT connection resumption is enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-0-rtt-session-resumption-setting
+func (api *API) ZoneZeroRTT(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "0rtt", nil)
+}
+
+// UpdateZoneZeroRTT enables or disables 0-RTT connection resumption for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-0-rtt-session-resumption-setting
+func (api *API) UpdateZoneZeroRTT(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "0rtt", &enabled)
+}
+
+// ZoneBrotli returns whether Brotli compression is enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-brotli-setting
+func (api *API) ZoneBrotli(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "brotli", nil)
+}
+
+// UpdateZoneBrotli enables or disables Brotli compression for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-brotli-setting
+func (api *API) UpdateZoneBrotli(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "brotli", &enabled)
+}
+
+// ZoneFonts returns whether Cloudflare Fonts, which serves Google Fonts from
+// the zone's own domain, is enabled for a zone.
+//
+// API reference: https://developers.cloudflare.com/speed/optimization/content/fonts/
+func (api *API) ZoneFonts(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "fonts", nil)
+}
+
+// UpdateZoneFonts enables or disables Cloudflare Fonts for a zone.
+//
+// API reference: https://developers.cloudflare.com/speed/optimization/content/fonts/
+func (api *API) UpdateZoneFonts(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "fonts", &enabled)
+}
+
+// ZonePrefetchPreload returns whether Cloudflare prefetches the URLs listed
+// in the origin's prefetch headers for a zone (Enterprise only).
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-prefetch-preload-setting
+func (api *API) ZonePrefetchPreload(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "prefetch_preload", nil)
+}
+
+// UpdateZonePrefetchPreload enables or disables prefetching for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-prefetch-preload-setting
+func (api *API) UpdateZonePrefetchPreload(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "prefetch_preload", &enabled)
+}
+
+// Image resizing setting values
+const (
+	// ImageResizingOn resizes images hosted on the zone
+	ImageResizingOn = "on"
+
+	// ImageResizingOff disables image resizing
+	ImageResizingOff = "off"
+
+	// ImageResizingOpen also resizes images hosted on other domains
+	ImageResizingOpen = "open"
+)
+
+// ZoneImageResizing returns the image resizing setting of a zone, one of
+// ImageResizingOn, ImageResizingOff or ImageResizingOpen.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-image-resizing-setting
+func (api *API) ZoneImageResizing(ctx context.Context, zoneID string) (string, error) {
+	return api.zoneStringSetting(ctx, http.MethodGet, zoneID, "image_resizing", nil)
+}
+
+// UpdateZoneImageResizing changes the image resizing setting of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-image-resizing-setting
+func (api *API) UpdateZoneImageResizing(ctx context.Context, zoneID, value string) (string, error) {
+	switch value {
+	case ImageResizingOn, ImageResizingOff, ImageResizingOpen:
+	default:
+		return "", errors.Errorf("invalid image resizing value %q. must be 'on', 'off' or 'open'", value)
+	}
+	return api.zoneStringSetting(ctx, http.MethodPatch, zoneID, "image_resizing", &value)
+}
+
+// ZoneCrawlerHints returns whether Crawler Hints, which tells search engines
+// when cached content changes, is enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-crawler-hints-setting
+func (api *API) ZoneCrawlerHints(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "crawlhints", nil)
+}
+
+// UpdateZoneCrawlerHints enables or disables Crawler Hints for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-crawler-hints-setting
+func (api *API) UpdateZoneCrawlerHints(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "crawlhints", &enabled)
+}
+
+// ZoneSignedExchanges returns whether Automatic Signed Exchanges (SXG) are
+// enabled for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-get-automatic-signed-exchanges-setting
+func (api *API) ZoneSignedExchanges(ctx context.Context, zoneID string) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodGet, zoneID, "sxg", nil)
+}
+
+// UpdateZoneSignedExchanges enables or disables Automatic Signed Exchanges
+// for a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-settings-change-automatic-signed-exchanges-setting
+func (api *API) UpdateZoneSignedExchanges(ctx context.Context, zoneID string, enabled bool) (bool, error) {
+	return api.zoneToggleSetting(ctx, http.MethodPatch, zoneID, "sxg", &enabled)
+}
+
+// zoneToggleSetting reads, or updates when enabled is set, a zone setting
+// whose value is "on" or "off".
+func (api *API) zoneToggleSetting(ctx context.Context, method, zoneID, settingName string, enabled *bool) (bool, error) {
+	var value *string
+	if enabled != nil {
+		v := "off"
+		if *enabled {
+			v = "on"
+		}
+		value = &v
+	}
+
+	result, err := api.zoneStringSetting(ctx, method, zoneID, settingName, value)
+	if err != nil {
+		return false, err
+	}
+
+	switch result {
+	case "on":
+		return true, nil
+	case "off":
+		return false, nil
+	default:
+		return false, errors.Errorf("unexpected value %s for zone setting %s", result, settingName)
+	}
+}
+
+// zoneStringSetting reads, or updates when value is set, a zone setting
+// whose value is a string.
+func (api *API) zoneStringSetting(ctx context.Context, method, zoneID, settingName string, value *string) (string, error) {
+	var params interface{}
+	if value != nil {
+		params = struct {
+			Value string `json:"value"`
+		}{*value}
+	}
+
+	uri := fmt.Sprintf("/zones/%s/settings/%s", zoneID, settingName)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return "", err
+	}
+
+	var r ZoneSettingSingleResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return "", errors.Wrap(err, errUnmarshalError)
+	}
+
+	result, ok := r.Result.Value.(string)
+	if !ok {
+		return "", errors.Errorf("unexpected value %v for zone setting %s", r.Result.Value, settingName)
+	}
+	return result, nil
+}
@@ -0,0 +1,223 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneToggleSettings(t *testing.T) {
+	setup()
+	defer teardown()
+
+	tests := []struct {
+		setting string
+		get     func(context.Context, string) (bool, error)
+	}{
+		{"early_hints", client.ZoneEarlyHints},
+		{"http3", client.ZoneHTTP3},
+		{"0rtt", client.ZoneZeroRTT},
+		{"brotli", client.ZoneBrotli},
+		{"fonts", client.ZoneFonts},
+		{"prefetch_preload", client.ZonePrefetchPreload},
+		{"crawlhints", client.ZoneCrawlerHints},
+		{"sxg", client.ZoneSignedExchanges},
+	}
+
+	for _, tt := range tests {
+		setting := tt.setting
+		mux.HandleFunc("/zones/"+testZoneID+"/settings/"+setting, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+			w.Header().Set("content-type", "application/json")
+			fmt.Fprintf(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {"id": "%s", "value": "on", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+			}`, setting)
+		})
+
+		actual, err := tt.get(context.Background(), testZoneID)
+		if assert.NoError(t, err, tt.setting) {
+			assert.True(t, actual, tt.setting)
+		}
+	}
+}
+
+func TestUpdateZoneHTTP3(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "http3", "value": "off", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/http3", handler)
+
+	actual, err := client.UpdateZoneHTTP3(context.Background(), testZoneID, false)
+	if assert.NoError(t, err) {
+		assert.False(t, actual)
+	}
+}
+
+func TestZoneBrotliUnexpectedValue(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "brotli", "value": "maybe", "editable": true}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/brotli", handler)
+
+	_, err := client.ZoneBrotli(context.Background(), testZoneID)
+	assert.EqualError(t, err, "unexpected value maybe for zone setting brotli")
+}
+
+func TestUpdateZoneFonts(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "fonts", "value": "on", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/fonts", handler)
+
+	actual, err := client.UpdateZoneFonts(context.Background(), testZoneID, true)
+	if assert.NoError(t, err) {
+		assert.True(t, actual)
+	}
+}
+
+func TestZoneImageResizing(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("content-type", "application/json")
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {"id": "image_resizing", "value": "on", "editable": true}
+			}`)
+		case http.MethodPatch:
+			body, err := ioutil.ReadAll(r.Body)
+			if assert.NoError(t, err) {
+				assert.JSONEq(t, `{"value": "open"}`, string(body))
+			}
+			fmt.Fprint(w, `{
+				"success": true,
+				"errors": [],
+				"messages": [],
+				"result": {"id": "image_resizing", "value": "open", "editable": true}
+			}`)
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/image_resizing", handler)
+
+	actual, err := client.ZoneImageResizing(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ImageResizingOn, actual)
+	}
+
+	actual, err = client.UpdateZoneImageResizing(context.Background(), testZoneID, ImageResizingOpen)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ImageResizingOpen, actual)
+	}
+
+	_, err = client.UpdateZoneImageResizing(context.Background(), testZoneID, "closed")
+	assert.EqualError(t, err, `invalid image resizing value "closed". must be 'on', 'off' or 'open'`)
+}
+
+func TestUpdateZoneSignedExchanges(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "on"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "sxg", "value": "on", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/sxg", handler)
+
+	actual, err := client.UpdateZoneSignedExchanges(context.Background(), testZoneID, true)
+	if assert.NoError(t, err) {
+		assert.True(t, actual)
+	}
+}
+
+func TestUpdateZoneCrawlerHints(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPatch, r.Method, "Expected method 'PATCH', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"value": "off"}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"id": "crawlhints", "value": "off", "editable": true, "modified_on": "2014-01-01T05:20:00.12345Z"}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/settings/crawlhints", handler)
+
+	actual, err := client.UpdateZoneCrawlerHints(context.Background(), testZoneID, false)
+	if assert.NoError(t, err) {
+		assert.False(t, actual)
+	}
+}
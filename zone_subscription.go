@@ -0,0 +1,107 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Zone rate plan IDs
+const (
+	ZoneRatePlanFree       = "CF_FREE"
+	ZoneRatePlanPro        = "CF_PRO"
+	ZoneRatePlanBusiness   = "CF_BIZ"
+	ZoneRatePlanEnterprise = "CF_ENT"
+)
+
+// ZoneSubscriptionComponent is an add-on of a zone subscription, such as
+// "page_rules" or "dedicated_certificates", and the quantity subscribed to.
+type ZoneSubscriptionComponent struct {
+	Name    string  `json:"name"`
+	Value   int     `json:"value"`
+	Default int     `json:"default,omitempty"`
+	Price   float64 `json:"price,omitempty"`
+}
+
+// ZoneSubscription is the subscription of a zone to a rate plan and add-ons.
+type ZoneSubscription struct {
+	ID       string       `json:"id,omitempty"`
+	RatePlan ZoneRatePlan `json:"rate_plan"`
+	// Frequency is "weekly", "monthly", "quarterly" or "yearly".
+	Frequency          string                      `json:"frequency,omitempty"`
+	ComponentValues    []ZoneSubscriptionComponent `json:"component_values,omitempty"`
+	State              string                      `json:"state,omitempty"`
+	Price              float64                     `json:"price,omitempty"`
+	Currency           string                      `json:"currency,omitempty"`
+	CurrentPeriodStart *time.Time                  `json:"current_period_start,omitempty"`
+	CurrentPeriodEnd   *time.Time                  `json:"current_period_end,omitempty"`
+}
+
+// ZoneSubscriptionParams describes the rate plan, billing frequency and
+// add-ons to subscribe a zone to.
+type ZoneSubscriptionParams struct {
+	RatePlanID      string
+	Frequency       string
+	ComponentValues []ZoneSubscriptionComponent
+}
+
+// ZoneSubscriptionResponse is the API response containing the subscription
+// of a zone.
+type ZoneSubscriptionResponse struct {
+	Response
+	Result ZoneSubscription `json:"result"`
+}
+
+// ZoneSubscription returns the subscription of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-zone-subscription-details
+func (api *API) ZoneSubscription(ctx context.Context, zoneID string) (ZoneSubscription, error) {
+	return api.zoneSubscriptionRequest(ctx, http.MethodGet, zoneID, nil)
+}
+
+// CreateZoneSubscription subscribes a zone to a rate plan and add-ons.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-create-zone-subscription
+func (api *API) CreateZoneSubscription(ctx context.Context, zoneID string, params ZoneSubscriptionParams) (ZoneSubscription, error) {
+	if params.RatePlanID == "" {
+		return ZoneSubscription{}, errors.Errorf("rate plan ID cannot be empty")
+	}
+	return api.zoneSubscriptionRequest(ctx, http.MethodPost, zoneID, params.subscription())
+}
+
+// UpdateZoneSubscription changes the rate plan or add-ons of a zone.
+//
+// API reference: https://api.cloudflare.com/#zone-subscription-update-zone-subscription
+func (api *API) UpdateZoneSubscription(ctx context.Context, zoneID string, params ZoneSubscriptionParams) (ZoneSubscription, error) {
+	if params.RatePlanID == "" {
+		return ZoneSubscription{}, errors.Errorf("rate plan ID cannot be empty")
+	}
+	return api.zoneSubscriptionRequest(ctx, http.MethodPut, zoneID, params.subscription())
+}
+
+func (p ZoneSubscriptionParams) subscription() ZoneSubscription {
+	return ZoneSubscription{
+		RatePlan:        ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: p.RatePlanID}},
+		Frequency:       p.Frequency,
+		ComponentValues: p.ComponentValues,
+	}
+}
+
+func (api *API) zoneSubscriptionRequest(ctx context.Context, method, zoneID string, params interface{}) (ZoneSubscription, error) {
+	uri := fmt.Sprintf("/zones/%s/subscription", zoneID)
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return ZoneSubscription{}, err
+	}
+
+	var r ZoneSubscriptionResponse
+	err = json.Unmarshal(res, &r)
+	if err != nil {
+		return ZoneSubscription{}, errors.Wrap(err, errUnmarshalError)
+	}
+	return r.Result, nil
+}
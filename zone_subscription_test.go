@@ -0,0 +1,134 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "506e3185e9c882d175a2d0cb0093d9f2",
+				"state": "Paid",
+				"price": 20,
+				"currency": "USD",
+				"frequency": "monthly",
+				"current_period_start": "2023-01-01T00:00:00Z",
+				"current_period_end": "2023-02-01T00:00:00Z",
+				"rate_plan": {"id": "CF_PRO", "public_name": "Pro Plan", "currency": "USD", "scope": "zone", "is_contract": false, "externally_managed": false},
+				"component_values": [{"name": "page_rules", "value": 20, "default": 20, "price": 0}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", handler)
+
+	start, _ := time.Parse(time.RFC3339, "2023-01-01T00:00:00Z")
+	end, _ := time.Parse(time.RFC3339, "2023-02-01T00:00:00Z")
+	want := ZoneSubscription{
+		ID:                 "506e3185e9c882d175a2d0cb0093d9f2",
+		State:              "Paid",
+		Price:              20,
+		Currency:           "USD",
+		Frequency:          "monthly",
+		CurrentPeriodStart: &start,
+		CurrentPeriodEnd:   &end,
+		RatePlan:           ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: ZoneRatePlanPro, Currency: "USD"}, PublicName: "Pro Plan", Scope: "zone"},
+		ComponentValues:    []ZoneSubscriptionComponent{{Name: "page_rules", Value: 20, Default: 20}},
+	}
+
+	actual, err := client.ZoneSubscription(context.Background(), testZoneID)
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestCreateZoneSubscription(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{
+				"rate_plan": {"id": "CF_BIZ"},
+				"frequency": "yearly",
+				"component_values": [{"name": "page_rules", "value": 50}]
+			}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"id": "506e3185e9c882d175a2d0cb0093d9f2",
+				"frequency": "yearly",
+				"rate_plan": {"id": "CF_BIZ"},
+				"component_values": [{"name": "page_rules", "value": 50}]
+			}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", handler)
+
+	params := ZoneSubscriptionParams{
+		RatePlanID:      ZoneRatePlanBusiness,
+		Frequency:       "yearly",
+		ComponentValues: []ZoneSubscriptionComponent{{Name: "page_rules", Value: 50}},
+	}
+
+	actual, err := client.CreateZoneSubscription(context.Background(), testZoneID, params)
+	if assert.NoError(t, err) {
+		assert.Equal(t, ZoneSubscription{
+			ID:              "506e3185e9c882d175a2d0cb0093d9f2",
+			Frequency:       "yearly",
+			RatePlan:        ZoneRatePlan{ZonePlanCommon: ZonePlanCommon{ID: ZoneRatePlanBusiness}},
+			ComponentValues: []ZoneSubscriptionComponent{{Name: "page_rules", Value: 50}},
+		}, actual)
+	}
+
+	_, err = client.UpdateZoneSubscription(context.Background(), testZoneID, ZoneSubscriptionParams{})
+	assert.EqualError(t, err, "rate plan ID cannot be empty")
+}
+
+func TestZoneUpdatePlan(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"rate_plan": {"id": "CF_PRO"}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprint(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {"rate_plan": {"id": "CF_PRO"}}
+		}`)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/subscription", handler)
+
+	err := client.ZoneUpdatePlan(context.Background(), testZoneID, ZoneRatePlanPro)
+	assert.NoError(t, err)
+}
@@ -2,12 +2,14 @@ package cloudflare
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -1201,3 +1203,118 @@ func TestUpdateZoneDNSSEC(t *testing.T) {
 		assert.Equal(t, z.ModifiedOn, time)
 	}
 }
+
+func TestPurgeCache(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		var body map[string]interface{}
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			assert.Equal(t, map[string]interface{}{
+				"files": []interface{}{
+					"https://example.com/a.css",
+					map[string]interface{}{"url": "https://example.com/b.css", "headers": map[string]interface{}{"Origin": "https://www.example.com"}},
+				},
+				"prefixes": []interface{}{"www.example.com/assets"},
+			}, body)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testZoneID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/purge_cache", handler)
+
+	actual, err := client.PurgeCache(context.Background(), testZoneID, PurgeCacheRequest{
+		Files:            []string{"https://example.com/a.css"},
+		FilesWithHeaders: []PurgeCacheFile{{URL: "https://example.com/b.css", Headers: map[string]string{"Origin": "https://www.example.com"}}},
+		Prefixes:         []string{"www.example.com/assets"},
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Success)
+		assert.Equal(t, testZoneID, actual.Result.ID)
+	}
+
+	_, err = client.PurgeCache(context.Background(), testZoneID, PurgeCacheRequest{})
+	assert.EqualError(t, err, "nothing to purge")
+
+	_, err = client.PurgeCache(context.Background(), testZoneID, PurgeCacheRequest{Everything: true, Tags: []string{"css"}})
+	assert.EqualError(t, err, "purging everything cannot be combined with files, tags, hosts or prefixes")
+}
+
+func TestPurgeCacheBatches(t *testing.T) {
+	setup()
+	defer teardown()
+
+	var batches []PurgeCacheRequest
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		var body PurgeCacheRequest
+		if assert.NoError(t, json.NewDecoder(r.Body).Decode(&body)) {
+			batches = append(batches, body)
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [{"code": 0, "message": "batch %d"}], "result": {"id": "%s"}}`, len(batches), testZoneID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/purge_cache", handler)
+
+	files := make([]string, 65)
+	for i := range files {
+		files[i] = fmt.Sprintf("https://example.com/%d.css", i)
+	}
+
+	actual, err := client.PurgeCache(context.Background(), testZoneID, PurgeCacheRequest{
+		Files: files,
+		Tags:  []string{"css"},
+	})
+	if assert.NoError(t, err) {
+		assert.True(t, actual.Success)
+		assert.Equal(t, testZoneID, actual.Result.ID)
+		assert.Len(t, actual.Messages, 4)
+	}
+
+	if assert.Len(t, batches, 4) {
+		assert.Equal(t, files[0:30], batches[0].Files)
+		assert.Equal(t, files[30:60], batches[1].Files)
+		assert.Equal(t, files[60:], batches[2].Files)
+		assert.Equal(t, PurgeCacheRequest{Tags: []string{"css"}}, batches[3])
+	}
+}
+
+func TestPurgeCacheBatchesPartialFailure(t *testing.T) {
+	setup()
+	defer teardown()
+
+	requests := 0
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		requests++
+		w.Header().Set("content-type", "application/json")
+		if requests == 2 {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"success": false, "errors": [{"code": 1015, "message": "Unable to purge"}], "messages": [], "result": null}`)
+			return
+		}
+		fmt.Fprintf(w, `{"success": true, "errors": [], "messages": [], "result": {"id": "%s"}}`, testZoneID)
+	}
+
+	mux.HandleFunc("/zones/"+testZoneID+"/purge_cache", handler)
+
+	files := make([]string, 65)
+	for i := range files {
+		files[i] = fmt.Sprintf("https://example.com/%d.css", i)
+	}
+
+	_, err := client.PurgeCache(context.Background(), testZoneID, PurgeCacheRequest{Files: files})
+	assert.Equal(t, 2, requests)
+
+	var batchErr *PurgeCacheBatchError
+	if assert.True(t, errors.As(err, &batchErr)) {
+		assert.Equal(t, []PurgeCacheRequest{{Files: files[0:30]}}, batchErr.Purged)
+		assert.Equal(t, PurgeCacheRequest{Files: files[30:60]}, batchErr.Failed)
+		assert.Equal(t, []PurgeCacheRequest{{Files: files[60:]}}, batchErr.Remaining)
+		assert.Contains(t, err.Error(), "purge request 2 of 3 failed: ")
+	}
+}
@@ -0,0 +1,183 @@
+package cloudflare
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RiskLevel represents the severity attached to a risk behavior or user.
+type RiskLevel string
+
+// These constants represent all valid risk levels.
+const (
+	RiskLevelLow    RiskLevel = "low"
+	RiskLevelMedium RiskLevel = "medium"
+	RiskLevelHigh   RiskLevel = "high"
+)
+
+// RiskScoringBehavior represents a behavior that contributes to a user's
+// risk score when enabled.
+type RiskScoringBehavior struct {
+	Name        string    `json:"name,omitempty"`
+	Description string    `json:"description,omitempty"`
+	Enabled     bool      `json:"enabled"`
+	RiskLevel   RiskLevel `json:"risk_level"`
+}
+
+// RiskScoringBehaviors holds every risk behavior keyed by its identifier.
+type RiskScoringBehaviors struct {
+	Behaviors map[string]RiskScoringBehavior `json:"behaviors"`
+}
+
+// RiskScoringBehaviorsResponse is the API response, containing the risk
+// behavior configuration.
+type RiskScoringBehaviorsResponse struct {
+	Response
+	Result RiskScoringBehaviors `json:"result"`
+}
+
+// RiskScoringEvent represents a single event that contributed to a user's
+// risk score.
+type RiskScoringEvent struct {
+	ID           string                 `json:"id"`
+	Name         string                 `json:"name"`
+	RiskLevel    RiskLevel              `json:"risk_level"`
+	Timestamp    *time.Time             `json:"timestamp,omitempty"`
+	EventDetails map[string]interface{} `json:"event_details,omitempty"`
+}
+
+// RiskScoringUser represents the risk score and recent events of a user.
+type RiskScoringUser struct {
+	Name          string             `json:"name"`
+	Email         string             `json:"email"`
+	RiskLevel     RiskLevel          `json:"risk_level,omitempty"`
+	LastResetTime *time.Time         `json:"last_reset_time,omitempty"`
+	Events        []RiskScoringEvent `json:"events"`
+}
+
+// RiskScoringUserResponse is the API response, containing a user's risk
+// details.
+type RiskScoringUserResponse struct {
+	Response
+	Result RiskScoringUser `json:"result"`
+}
+
+// RiskScoringUserSummary is the aggregated risk of a single user.
+type RiskScoringUserSummary struct {
+	UserID       string     `json:"user_id"`
+	Name         string     `json:"name"`
+	Email        string     `json:"email"`
+	MaxRiskLevel RiskLevel  `json:"max_risk_level"`
+	EventCount   int        `json:"event_count"`
+	LastEvent    *time.Time `json:"last_event,omitempty"`
+}
+
+// RiskScoringSummaryResponse is the API response, containing the risk
+// summary of every user in an account.
+type RiskScoringSummaryResponse struct {
+	Response
+	Result struct {
+		Users []RiskScoringUserSummary `json:"users"`
+	} `json:"result"`
+	ResultInfo `json:"result_info"`
+}
+
+// RiskScoringSummary returns the aggregated risk of every user within an
+// account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-risk-scoring-get-risk-score-summary
+func (api *API) RiskScoringSummary(ctx context.Context, accountID string) ([]RiskScoringUserSummary, ResultInfo, error) {
+	uri := fmt.Sprintf("/%s/%s/zt_risk_scoring/summary", AccountRouteRoot, accountID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return []RiskScoringUserSummary{}, ResultInfo{}, err
+	}
+
+	var summaryResponse RiskScoringSummaryResponse
+	err = json.Unmarshal(res, &summaryResponse)
+	if err != nil {
+		return []RiskScoringUserSummary{}, ResultInfo{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return summaryResponse.Result.Users, summaryResponse.ResultInfo, nil
+}
+
+// RiskScoringUser returns the risk level and contributing events of a
+// single user.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-risk-scoring-get-risk-event-information-for-a-user
+func (api *API) RiskScoringUser(ctx context.Context, accountID, userID string) (RiskScoringUser, error) {
+	uri := fmt.Sprintf("/%s/%s/zt_risk_scoring/%s", AccountRouteRoot, accountID, userID)
+
+	res, err := api.makeRequestContext(ctx, http.MethodGet, uri, nil)
+	if err != nil {
+		return RiskScoringUser{}, err
+	}
+
+	var userResponse RiskScoringUserResponse
+	err = json.Unmarshal(res, &userResponse)
+	if err != nil {
+		return RiskScoringUser{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return userResponse.Result, nil
+}
+
+// ResetRiskScoringUser clears the risk score of a user.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-risk-scoring-clear-risk-for-a-user
+func (api *API) ResetRiskScoringUser(ctx context.Context, accountID, userID string) error {
+	if userID == "" {
+		return errors.Errorf("user ID cannot be empty")
+	}
+
+	uri := fmt.Sprintf("/%s/%s/zt_risk_scoring/%s/reset", AccountRouteRoot, accountID, userID)
+
+	_, err := api.makeRequestContext(ctx, http.MethodPost, uri, nil)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// RiskScoringBehaviors returns the risk behavior configuration of an
+// account.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-risk-scoring-get-all-behaviors
+func (api *API) RiskScoringBehaviors(ctx context.Context, accountID string) (RiskScoringBehaviors, error) {
+	uri := fmt.Sprintf("/%s/%s/zt_risk_scoring/behaviors", AccountRouteRoot, accountID)
+
+	return api.riskScoringBehaviorsRequest(ctx, http.MethodGet, uri, nil)
+}
+
+// UpdateRiskScoringBehaviors enables or disables risk behaviors and sets
+// their risk levels.
+//
+// API reference: https://api.cloudflare.com/#zero-trust-risk-scoring-update-behaviors
+func (api *API) UpdateRiskScoringBehaviors(ctx context.Context, accountID string, behaviors RiskScoringBehaviors) (RiskScoringBehaviors, error) {
+	uri := fmt.Sprintf("/%s/%s/zt_risk_scoring/behaviors", AccountRouteRoot, accountID)
+
+	return api.riskScoringBehaviorsRequest(ctx, http.MethodPut, uri, behaviors)
+}
+
+func (api *API) riskScoringBehaviorsRequest(ctx context.Context, method, uri string, params interface{}) (RiskScoringBehaviors, error) {
+	res, err := api.makeRequestContext(ctx, method, uri, params)
+	if err != nil {
+		return RiskScoringBehaviors{}, err
+	}
+
+	var behaviorsResponse RiskScoringBehaviorsResponse
+	err = json.Unmarshal(res, &behaviorsResponse)
+	if err != nil {
+		return RiskScoringBehaviors{}, errors.Wrap(err, errUnmarshalError)
+	}
+
+	return behaviorsResponse.Result, nil
+}
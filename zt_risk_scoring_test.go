@@ -0,0 +1,201 @@
+package cloudflare
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testRiskUserID = "f2108713-1206-4a5c-8a3e-2c5a4a1c3e5f"
+
+func TestRiskScoringSummary(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"users": [
+					{
+						"user_id": "%s",
+						"name": "Jane Doe",
+						"email": "jane@example.com",
+						"max_risk_level": "high",
+						"event_count": 3,
+						"last_event": "2023-05-01T12:00:00Z"
+					}
+				]
+			},
+			"result_info": {
+				"page": 1,
+				"per_page": 20,
+				"count": 1,
+				"total_count": 1
+			}
+		}
+		`, testRiskUserID)
+	}
+
+	lastEvent, _ := time.Parse(time.RFC3339, "2023-05-01T12:00:00Z")
+
+	want := []RiskScoringUserSummary{{
+		UserID:       testRiskUserID,
+		Name:         "Jane Doe",
+		Email:        "jane@example.com",
+		MaxRiskLevel: RiskLevelHigh,
+		EventCount:   3,
+		LastEvent:    &lastEvent,
+	}}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/summary", handler)
+
+	actual, _, err := client.RiskScoringSummary(context.Background(), testAccountID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestRiskScoringUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodGet, r.Method, "Expected method 'GET', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"name": "Jane Doe",
+				"email": "jane@example.com",
+				"last_reset_time": "2023-04-01T00:00:00Z",
+				"risk_level": "medium",
+				"events": [
+					{
+						"id": "event-1",
+						"name": "impossible_travel",
+						"risk_level": "medium",
+						"timestamp": "2023-05-01T12:00:00Z",
+						"event_details": {"country": "US"}
+					}
+				]
+			}
+		}
+		`)
+	}
+
+	lastReset, _ := time.Parse(time.RFC3339, "2023-04-01T00:00:00Z")
+	timestamp, _ := time.Parse(time.RFC3339, "2023-05-01T12:00:00Z")
+
+	want := RiskScoringUser{
+		Name:          "Jane Doe",
+		Email:         "jane@example.com",
+		RiskLevel:     RiskLevelMedium,
+		LastResetTime: &lastReset,
+		Events: []RiskScoringEvent{{
+			ID:           "event-1",
+			Name:         "impossible_travel",
+			RiskLevel:    RiskLevelMedium,
+			Timestamp:    &timestamp,
+			EventDetails: map[string]interface{}{"country": "US"},
+		}},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/"+testRiskUserID, handler)
+
+	actual, err := client.RiskScoringUser(context.Background(), testAccountID, testRiskUserID)
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}
+
+func TestResetRiskScoringUser(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method, "Expected method 'POST', got %s", r.Method)
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": null
+		}
+		`)
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/"+testRiskUserID+"/reset", handler)
+
+	err := client.ResetRiskScoringUser(context.Background(), testAccountID, testRiskUserID)
+	assert.NoError(t, err)
+
+	err = client.ResetRiskScoringUser(context.Background(), testAccountID, "")
+	assert.EqualError(t, err, "user ID cannot be empty")
+}
+
+func TestUpdateRiskScoringBehaviors(t *testing.T) {
+	setup()
+	defer teardown()
+
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPut, r.Method, "Expected method 'PUT', got %s", r.Method)
+		body, err := ioutil.ReadAll(r.Body)
+		if assert.NoError(t, err) {
+			assert.JSONEq(t, `{"behaviors":{"impossible_travel":{"enabled":true,"risk_level":"high"}}}`, string(body))
+		}
+		w.Header().Set("content-type", "application/json")
+		fmt.Fprintf(w, `{
+			"success": true,
+			"errors": [],
+			"messages": [],
+			"result": {
+				"behaviors": {
+					"impossible_travel": {
+						"name": "Impossible travel",
+						"description": "User logged in from two distant locations in a short time",
+						"enabled": true,
+						"risk_level": "high"
+					}
+				}
+			}
+		}
+		`)
+	}
+
+	want := RiskScoringBehaviors{
+		Behaviors: map[string]RiskScoringBehavior{
+			"impossible_travel": {
+				Name:        "Impossible travel",
+				Description: "User logged in from two distant locations in a short time",
+				Enabled:     true,
+				RiskLevel:   RiskLevelHigh,
+			},
+		},
+	}
+
+	mux.HandleFunc("/accounts/"+testAccountID+"/zt_risk_scoring/behaviors", handler)
+
+	actual, err := client.UpdateRiskScoringBehaviors(context.Background(), testAccountID, RiskScoringBehaviors{
+		Behaviors: map[string]RiskScoringBehavior{
+			"impossible_travel": {Enabled: true, RiskLevel: RiskLevelHigh},
+		},
+	})
+
+	if assert.NoError(t, err) {
+		assert.Equal(t, want, actual)
+	}
+}